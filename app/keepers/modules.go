@@ -31,6 +31,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v10/x/epochs"
 	"github.com/osmosis-labs/osmosis/v10/x/gamm"
 	"github.com/osmosis-labs/osmosis/v10/x/incentives"
+	incentivesclient "github.com/osmosis-labs/osmosis/v10/x/incentives/client"
 	"github.com/osmosis-labs/osmosis/v10/x/lockup"
 	"github.com/osmosis-labs/osmosis/v10/x/mint"
 	poolincentives "github.com/osmosis-labs/osmosis/v10/x/pool-incentives"
@@ -58,10 +59,12 @@ var AppModuleBasics = []module.AppModuleBasic{
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
 			poolincentivesclient.UpdatePoolIncentivesHandler,
+			poolincentivesclient.SetPoolLockableDurationsHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
+			incentivesclient.CancelGaugeProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
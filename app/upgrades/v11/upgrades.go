@@ -0,0 +1,61 @@
+package v11
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v10/app/keepers"
+	incentivestypes "github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v10/x/mint/types"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// The lockup module did not have params before v11.
+		keepers.LockupKeeper.SetParams(ctx, lockuptypes.DefaultParams())
+
+		// Claim based distribution is added disabled. Existing locks are checkpointed
+		// in batches at the end of the epochs after governance enables it.
+		incentivesSubspace := keepers.GetSubspace(incentivestypes.ModuleName)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyClaimBasedDistribution, false)
+
+		// Gauges created by users pay the default creation fees from now on.
+		defaultIncentivesParams := incentivestypes.DefaultParams()
+		incentivesSubspace.Set(ctx, incentivestypes.KeyGaugeCreationFee, defaultIncentivesParams.GaugeCreationFee)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyAdditionalDenomFee, defaultIncentivesParams.AdditionalDenomFee)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyMinGaugeValue, defaultIncentivesParams.MinGaugeValue)
+
+		// Volume weighted pool incentives are added disabled.
+		defaultPoolIncentivesParams := poolincentivestypes.DefaultParams()
+		poolIncentivesSubspace := keepers.GetSubspace(poolincentivestypes.ModuleName)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyVolumeWeightedProportion, defaultPoolIncentivesParams.VolumeWeightedProportion)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyVolumeWeightedPools, defaultPoolIncentivesParams.VolumeWeightedPools)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyMaxPoolVolumeShare, defaultPoolIncentivesParams.MaxPoolVolumeShare)
+
+		// Superfluid staking rewards of locks are kept for the default number of epochs.
+		superfluidSubspace := keepers.GetSubspace(superfluidtypes.ModuleName)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyLockRewardHistoryEpochs, superfluidtypes.DefaultParams().LockRewardHistoryEpochs)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyStaleAssetZeroingEpochs, superfluidtypes.DefaultParams().StaleAssetZeroingEpochs)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyAutoCompoundLocksPerEpoch, superfluidtypes.DefaultParams().AutoCompoundLocksPerEpoch)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyMaxValidatorSuperfluidShare, superfluidtypes.DefaultParams().MaxValidatorSuperfluidShare)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyMaxTotalSuperfluidShare, superfluidtypes.DefaultParams().MaxTotalSuperfluidShare)
+		// The superfluid caps are checked against running totals of the osmo superfluid delegated.
+		if err := keepers.SuperfluidKeeper.ResetSuperfluidDelegatedTokens(ctx); err != nil {
+			return nil, err
+		}
+
+		// Mint keeps reducing its provisions periodically, until governance switches the reduction mode.
+		mintSubspace := keepers.GetSubspace(minttypes.ModuleName)
+		mintSubspace.Set(ctx, minttypes.KeyReductionMode, minttypes.ReductionModePeriodic)
+
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -316,9 +316,7 @@ func updatePoolIncentiveGenesis(pooliGenState *poolitypes.GenesisState) {
 		time.Second * 180,
 		time.Second * 240,
 	}
-	pooliGenState.Params = poolitypes.Params{
-		MintedDenom: OsmoDenom,
-	}
+	pooliGenState.Params.MintedDenom = OsmoDenom
 }
 
 func updateIncentivesGenesis(incentivesGenState *incentivestypes.GenesisState) {
@@ -24,6 +24,7 @@ func (k Keeper) UnmarshalPool(bz []byte) (types.PoolI, error) {
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. Prior
 // to returning the pool, the weights of the pool are updated via PokePool.
+// Poking only updates the returned pool to the block time, the stored pool is unchanged.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	store := ctx.KVStore(k.storeKey)
 	poolKey := types.GetKeyPrefixPools(poolId)
@@ -13,10 +13,14 @@ const (
 	FlagEpochs    = "epochs"
 	FlagPerpetual = "perpetual"
 
-	FlagTimestamp = "timestamp"
-	FlagOwner     = "owner"
-	FlagLockIds   = "lock-ids"
-	FlagEndEpoch  = "end-epoch"
+	FlagTimestamp     = "timestamp"
+	FlagNoLock        = "no-lock"
+	FlagOwner         = "owner"
+	FlagLockIds       = "lock-ids"
+	FlagEndEpoch      = "end-epoch"
+	FlagEmissionCurve = "emission-curve"
+	FlagDecayFactor   = "decay-factor"
+	FlagEpochWeights  = "epoch-weights"
 )
 
 // FlagSetCreateGauge returns flags for creating gauge.
@@ -28,5 +32,10 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.String(FlagTimestamp, "", "Distribute to locks that stay locked until at least this timestamp, instead of by duration")
+	fs.Bool(FlagNoLock, false, "Distribute to accounts holding the denom without locking it, instead of to locks")
+	fs.String(FlagEmissionCurve, "", "Curve to emit rewards along: linear, exponential-decay, linear-ramp-down or custom")
+	fs.String(FlagDecayFactor, "", "Ratio of each epoch's rewards to the previous epoch's, for the exponential-decay curve")
+	fs.String(FlagEpochWeights, "", "Comma separated weights of each epoch's rewards, for the custom curve")
 	return fs
 }
@@ -35,6 +35,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdClaimableRewards(),
 	)
 
 	return cmd
@@ -419,3 +420,44 @@ $ %s query incentives rewards-estimation
 
 	return cmd
 }
+
+// GetCmdClaimableRewards returns the claimable rewards of a lock.
+func GetCmdClaimableRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-rewards [lock_id]",
+		Short: "Query the rewards accrued by a lock that can be claimed.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by a lock that can be claimed.
+
+Example:
+$ %s query incentives claimable-rewards 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClaimableRewards(cmd.Context(), &types.ClaimableRewardsRequest{LockId: lockId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -15,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -30,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewCancelGaugeCmd(),
+		NewClaimRewardsCmd(),
 	)
 
 	return cmd
@@ -55,18 +60,12 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
-			var startTime time.Time
 			timeStr, err := cmd.Flags().GetString(FlagStartTime)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
-				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
+			startTime, err := parseTime(timeStr)
+			if err != nil {
 				return errors.New("Invalid start time format")
 			}
 
@@ -96,6 +95,39 @@ func NewCreateGaugeCmd() *cobra.Command {
 				Timestamp:     time.Unix(0, 0), // XXX check
 			}
 
+			// a lock timestamp switches the gauge to reward locks that stay locked until then
+			lockTimeStr, err := cmd.Flags().GetString(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+			if lockTimeStr != "" {
+				lockTime, err := parseTime(lockTimeStr)
+				if err != nil {
+					return errors.New("Invalid lock timestamp format")
+				}
+				distributeTo.LockQueryType = lockuptypes.ByTime
+				distributeTo.Duration = 0
+				distributeTo.Timestamp = lockTime
+			}
+
+			// no lock gauges reward balances of the denom held outside of locks
+			noLock, err := cmd.Flags().GetBool(FlagNoLock)
+			if err != nil {
+				return err
+			}
+			if noLock {
+				if lockTimeStr != "" {
+					return errors.New("no lock gauges can't have a lock timestamp")
+				}
+				distributeTo.LockQueryType = lockuptypes.NoLock
+				distributeTo.Duration = 0
+			}
+
+			emissionSchedule, err := parseEmissionSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateGauge(
 				epochs == 1,
 				clientCtx.GetFromAddress(),
@@ -104,6 +136,7 @@ func NewCreateGaugeCmd() *cobra.Command {
 				startTime,
 				epochs,
 			)
+			msg.EmissionSchedule = emissionSchedule
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
@@ -114,6 +147,67 @@ func NewCreateGaugeCmd() *cobra.Command {
 	return cmd
 }
 
+// emissionCurves maps the emission curve flag values to the emission curves.
+var emissionCurves = map[string]types.EmissionCurve{
+	"linear":            types.Linear,
+	"exponential-decay": types.ExponentialDecay,
+	"linear-ramp-down":  types.LinearRampDown,
+	"custom":            types.Custom,
+}
+
+// parseEmissionSchedule parses the emission schedule flags, returning nil when no curve is given.
+func parseEmissionSchedule(cmd *cobra.Command) (*types.EmissionSchedule, error) {
+	curveStr, err := cmd.Flags().GetString(FlagEmissionCurve)
+	if err != nil {
+		return nil, err
+	}
+	if curveStr == "" {
+		return nil, nil
+	}
+	curve, ok := emissionCurves[curveStr]
+	if !ok {
+		return nil, fmt.Errorf("invalid emission curve: %s", curveStr)
+	}
+	schedule := types.EmissionSchedule{Curve: curve, DecayFactor: sdk.ZeroDec()}
+
+	decayFactorStr, err := cmd.Flags().GetString(FlagDecayFactor)
+	if err != nil {
+		return nil, err
+	}
+	if decayFactorStr != "" {
+		schedule.DecayFactor, err = sdk.NewDecFromStr(decayFactorStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	epochWeightsStr, err := cmd.Flags().GetString(FlagEpochWeights)
+	if err != nil {
+		return nil, err
+	}
+	if epochWeightsStr != "" {
+		for _, weightStr := range strings.Split(epochWeightsStr, ",") {
+			weight, err := sdk.NewDecFromStr(strings.TrimSpace(weightStr))
+			if err != nil {
+				return nil, err
+			}
+			schedule.EpochWeights = append(schedule.EpochWeights, weight)
+		}
+	}
+	return &schedule, nil
+}
+
+// parseTime parses a unix or RFC3339 timestamp, returning unix time zero for an empty string.
+func parseTime(timeStr string) (time.Time, error) {
+	if timeStr == "" {
+		return time.Unix(0, 0), nil
+	}
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil {
+		return time.Unix(timeUnix, 0), nil
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
+
 // NewAddToGaugeCmd broadcast MsgAddToGauge.
 func NewAddToGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -152,3 +246,140 @@ func NewAddToGaugeCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCancelGaugeCmd broadcast MsgCancelGauge.
+func NewCancelGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge [gauge_id] [flags]",
+		Short: "cancel a gauge you created and refund its undistributed coins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelGauge(
+				clientCtx.GetFromAddress(),
+				gaugeId,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimRewardsCmd broadcast MsgClaimRewards.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [flags]",
+		Short: "claim the rewards accrued by your locks",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockIdsCombined, err := cmd.Flags().GetString(FlagLockIds)
+			if err != nil {
+				return err
+			}
+
+			lockIds := []uint64{}
+			if lockIdsCombined != "" {
+				for _, lockIdStr := range strings.Split(lockIdsCombined, ",") {
+					lockId, err := strconv.ParseUint(lockIdStr, 10, 64)
+					if err != nil {
+						return err
+					}
+					lockIds = append(lockIds, lockId)
+				}
+			}
+
+			msg := types.NewMsgClaimRewards(
+				clientCtx.GetFromAddress(),
+				lockIds,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockIds, "", "the lock ids to claim the rewards of, when it is empty, all locks of the sender are claimed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitCancelGaugeProposal implements a command handler for submitting a gauge cancellation proposal.
+func NewCmdSubmitCancelGaugeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge-proposal [gauge_id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a gauge and refund its undistributed coins to the gauge owner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelGaugeProposal(title, description, gaugeId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
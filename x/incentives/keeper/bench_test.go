@@ -66,7 +66,7 @@ func genQueryCondition(
 	}
 }
 
-func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, b *testing.B) {
+func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, claimBased bool, b *testing.B) {
 	// b.ReportAllocs()
 	b.StopTimer()
 
@@ -77,6 +77,11 @@ func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numD
 
 	r := rand.New(rand.NewSource(10))
 
+	params := app.IncentivesKeeper.GetParams(ctx)
+	params.ClaimBasedDistribution = claimBased
+	app.IncentivesKeeper.SetParams(ctx, params)
+	app.IncentivesKeeper.UpdateClaimBasedDistribution(ctx)
+
 	// setup accounts with balances
 	addrs := []sdk.AccAddress{}
 	for i := 0; i < numAccts; i++ {
@@ -169,11 +174,11 @@ func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numD
 }
 
 func BenchmarkDistributionLogicTiny(b *testing.B) {
-	benchmarkDistributionLogic(1, 1, 1, 1, 1, b)
+	benchmarkDistributionLogic(1, 1, 1, 1, 1, false, b)
 }
 
 func BenchmarkDistributionLogicSmall(b *testing.B) {
-	benchmarkDistributionLogic(10, 1, 10, 1000, 100, b)
+	benchmarkDistributionLogic(10, 1, 10, 1000, 100, false, b)
 }
 
 func BenchmarkDistributionLogicMedium(b *testing.B) {
@@ -183,7 +188,7 @@ func BenchmarkDistributionLogicMedium(b *testing.B) {
 	numLockups := 20000
 	numDistrs := 1
 
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicLarge(b *testing.B) {
@@ -193,9 +198,25 @@ func BenchmarkDistributionLogicLarge(b *testing.B) {
 	numLockups := 100000
 	numDistrs := 1
 
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicHuge(b *testing.B) {
-	benchmarkDistributionLogic(1000, 100, 1000, 1000, 30000, b)
+	benchmarkDistributionLogic(1000, 100, 1000, 1000, 30000, false, b)
+}
+
+// The claim based benchmarks run the same setups as above with claim based distribution enabled,
+// where an epoch updates one reward index per gauge instead of sending rewards to every lock.
+func BenchmarkClaimBasedDistributionLogicSmall(b *testing.B) {
+	benchmarkDistributionLogic(10, 1, 10, 1000, 100, true, b)
+}
+
+func BenchmarkClaimBasedDistributionLogicMedium(b *testing.B) {
+	numAccts := 1000
+	numDenoms := 8
+	numGauges := 30
+	numLockups := 20000
+	numDistrs := 1
+
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	db "github.com/tendermint/tm-db"
@@ -94,7 +95,7 @@ func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo l
 		}
 		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
 	case lockuptypes.ByTime:
-		panic("Gauge by time is present!?!? Should have been blocked in ValidateBasic")
+		return k.lk.GetLocksPastTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
 	default:
 	}
 	return []lockuptypes.PeriodLock{}
@@ -112,38 +113,33 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 	}
 
 	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
-	// Remaining epochs is the number of remaining epochs that the gauge will pay out its rewards
-	// For a perpetual gauge, it will pay out everything in the next epoch, and we don't make
-	// an assumption for what rate it will get refilled at.
-	remainEpochs := uint64(1)
-	if !gauge.IsPerpetual {
-		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
-	}
+	// The epoch weights give the share of the remaining coins the gauge pays out in the next epoch,
+	// following its emission schedule. For a perpetual gauge, it will pay out everything in the next
+	// epoch, and we don't make an assumption for what rate it will get refilled at.
 	// TODO: Should this return err
-	if remainEpochs == 0 {
+	if _, remainWeight := gauge.EpochEmissionWeights(); remainWeight.IsZero() {
 		return gauge, sdk.Coins{}, nil
 	}
 
-	remainCoinsPerEpoch := sdk.Coins{}
-	for _, coin := range remainCoins {
-		// distribution amount per epoch = gauge_size / (remain_epochs)
-		amt := coin.Amount.QuoRaw(int64(remainEpochs))
-		remainCoinsPerEpoch = remainCoinsPerEpoch.Add(sdk.NewCoin(coin.Denom, amt))
-	}
+	// distribution amount per epoch = gauge_size * epoch_weight / remain_weight
+	remainCoinsPerEpoch := gauge.EpochEmissionCoins(remainCoins)
 
 	// Now we compute the filtered coins
 	filteredDistrCoins := sdk.Coins{}
 	if len(filteredLocks) == 0 {
 		// If were doing no filtering, we want to calculate the total amount to distributed in
 		// the next epoch.
-		// distribution in next epoch = gauge_size  / (remain_epochs)
+		// distribution in next epoch = gauge_size * epoch_weight / remain_weight
 		filteredDistrCoins = remainCoinsPerEpoch
 	}
 	for _, lock := range filteredLocks {
+		// locks that unlock before the gauge's timestamp don't earn from time based gauges
+		if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime && !isLockedPastTime(ctx, lock, gauge.DistributeTo.Timestamp) {
+			continue
+		}
 		denomLockAmt := lock.Coins.AmountOf(gauge.DistributeTo.Denom)
 
 		for _, coin := range remainCoinsPerEpoch {
-			// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
 			// distribution amount = gauge_size_per_epoch * denom_lock_amount / total_denom_lock_amount
 			amt := coin.Amount.Mul(denomLockAmt).Quo(TotalAmtLocked)
 			filteredDistrCoins = filteredDistrCoins.Add(sdk.NewCoin(coin.Denom, amt))
@@ -157,40 +153,55 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 	return gauge, filteredDistrCoins, nil
 }
 
+// isLockedPastTime returns true if the lock stays locked after timestamp, assuming
+// a lock that hasn't started unlocking begins unlocking at the current block time.
+// This matches the selection made by lockup's GetLocksPastTimeDenom.
+func isLockedPastTime(ctx sdk.Context, lock lockuptypes.PeriodLock, timestamp time.Time) bool {
+	if lock.IsUnlocking() {
+		return lock.EndTime.After(timestamp)
+	}
+	return !ctx.BlockTime().Add(lock.Duration).Before(timestamp)
+}
+
 // distributionInfo stores all of the information for pent up sends for rewards distributions.
 // This enables us to lower the number of events and calls to back.
 type distributionInfo struct {
-	nextID            int
-	lockOwnerAddrToID map[string]int
-	idToBech32Addr    []string
-	idToDecodedAddr   []sdk.AccAddress
-	idToDistrCoins    []sdk.Coins
+	nextID           int
+	receiverAddrToID map[string]int
+	idToBech32Addr   []string
+	idToDecodedAddr  []sdk.AccAddress
+	idToDistrCoins   []sdk.Coins
+	// lockRewards are only tracked when trackLockRewards is set.
+	trackLockRewards bool
+	lockRewards      []types.LockRewards
 }
 
-func newDistributionInfo() distributionInfo {
+func newDistributionInfo(trackLockRewards bool) distributionInfo {
 	return distributionInfo{
-		nextID:            0,
-		lockOwnerAddrToID: make(map[string]int),
-		idToBech32Addr:    []string{},
-		idToDecodedAddr:   []sdk.AccAddress{},
-		idToDistrCoins:    []sdk.Coins{},
+		nextID:           0,
+		receiverAddrToID: make(map[string]int),
+		idToBech32Addr:   []string{},
+		idToDecodedAddr:  []sdk.AccAddress{},
+		idToDistrCoins:   []sdk.Coins{},
+		trackLockRewards: trackLockRewards,
+		lockRewards:      []types.LockRewards{},
 	}
 }
 
-func (d *distributionInfo) addLockRewards(owner string, rewards sdk.Coins) error {
-	if id, ok := d.lockOwnerAddrToID[owner]; ok {
+func (d *distributionInfo) addLockRewards(receiver string, rewards sdk.Coins) error {
+	if id, ok := d.receiverAddrToID[receiver]; ok {
 		oldDistrCoins := d.idToDistrCoins[id]
 		d.idToDistrCoins[id] = rewards.Add(oldDistrCoins...)
 	} else {
 		id := d.nextID
 		d.nextID += 1
-		d.lockOwnerAddrToID[owner] = id
-		decodedOwnerAddr, err := sdk.AccAddressFromBech32(owner)
+		d.receiverAddrToID[receiver] = id
+		decodedReceiverAddr, err := sdk.AccAddressFromBech32(receiver)
 		if err != nil {
 			return err
 		}
-		d.idToBech32Addr = append(d.idToBech32Addr, owner)
-		d.idToDecodedAddr = append(d.idToDecodedAddr, decodedOwnerAddr)
+		d.idToBech32Addr = append(d.idToBech32Addr, receiver)
+		d.idToDecodedAddr = append(d.idToDecodedAddr, decodedReceiverAddr)
 		d.idToDistrCoins = append(d.idToDistrCoins, rewards)
 	}
 	return nil
@@ -274,18 +285,15 @@ func (k Keeper) distributeInternal(
 		return nil, nil
 	}
 
-	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
-	remainEpochs := uint64(1)
-	if !gauge.IsPerpetual { // set remain epochs when it's not perpetual gauge
-		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
-	}
+	// epoch amount = gauge_size * epoch_weight / remain_weight
+	epochCoins := gauge.EpochEmissionCoins(gauge.Coins.Sub(gauge.DistributedCoins))
 
 	for _, lock := range locks {
 		distrCoins := sdk.Coins{}
-		for _, coin := range remainCoins {
-			// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
+		for _, coin := range epochCoins {
+			// distribution amount = epoch_amount * denom_lock_amount / total_denom_lock_amount
 			denomLockAmt := lock.Coins.AmountOfNoDenomValidation(denom)
-			amt := coin.Amount.Mul(denomLockAmt).Quo(lockSum.Mul(sdk.NewInt(int64(remainEpochs))))
+			amt := coin.Amount.Mul(denomLockAmt).Quo(lockSum)
 			if amt.IsPositive() {
 				newlyDistributedCoin := sdk.Coin{Denom: coin.Denom, Amount: amt}
 				distrCoins = distrCoins.Add(newlyDistributedCoin)
@@ -295,11 +303,111 @@ func (k Keeper) distributeInternal(
 		if distrCoins.Empty() {
 			continue
 		}
-		// Update the amount for that address
-		err := distrInfo.addLockRewards(lock.Owner, distrCoins)
+		// Update the amount for the reward receiver of the lock, which defaults to the lock owner
+		err := distrInfo.addLockRewards(lock.RewardReceiver(), distrCoins)
 		if err != nil {
 			return nil, err
 		}
+		if distrInfo.trackLockRewards {
+			distrInfo.lockRewards = append(distrInfo.lockRewards, types.LockRewards{GaugeId: gauge.Id, LockId: lock.ID, Coins: distrCoins})
+		}
+
+		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+	}
+
+	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
+// denomHolder is an account rewarded by no lock gauges, weighted by the shares it held times the time held.
+type denomHolder struct {
+	address string
+	amount  sdk.Int
+}
+
+// getNoLockDenoms returns the denoms rewarded by the no lock gauges among the given gauges.
+func getNoLockDenoms(gauges []types.Gauge) map[string]bool {
+	denoms := map[string]bool{}
+	for _, gauge := range gauges {
+		if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+			denoms[gauge.DistributeTo.Denom] = true
+		}
+	}
+	return denoms
+}
+
+// getDenomHolders returns the holders of each of the given pool share denoms, weighted by their
+// share time since the last distribution to the denom, and starts a new share time period for them.
+// Shares held since a holder's last update only count up to its current balance, since shares
+// transferred away are not tracked. This is done once per distribution for all no lock gauges.
+func (k Keeper) getDenomHolders(ctx sdk.Context, denoms map[string]bool) map[string][]denomHolder {
+	holders := make(map[string][]denomHolder, len(denoms))
+	for _, denom := range sortedDenoms(denoms) {
+		for _, holder := range k.GetShareHoldersByDenom(ctx, denom) {
+			address, err := sdk.AccAddressFromBech32(holder.Address)
+			if err != nil {
+				panic(err)
+			}
+			balance := k.bk.GetBalance(ctx, address, denom).Amount
+			holder.Shares = sdk.MinInt(holder.Shares, balance)
+			accrueShareTime(ctx, &holder)
+			if holder.ShareTime.IsPositive() {
+				holders[denom] = append(holders[denom], denomHolder{address: holder.Address, amount: holder.ShareTime})
+			}
+
+			holder.Shares = balance
+			holder.ShareTime = sdk.ZeroInt()
+			k.SetShareHolder(ctx, holder)
+		}
+	}
+	return holders
+}
+
+// sortedDenoms returns the given denoms in a deterministic order.
+func sortedDenoms(denoms map[string]bool) []string {
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// distributeNoLockInternal runs the distribution logic for a no lock gauge, rewarding the holders
+// of its denom pro-rata to their share time, and adds the sends to the distrInfo computed.
+// It also updates the gauge for the distribution.
+func (k Keeper) distributeNoLockInternal(
+	ctx sdk.Context, gauge types.Gauge, holders []denomHolder, distrInfo *distributionInfo,
+) (sdk.Coins, error) {
+	totalDistrCoins := sdk.NewCoins()
+	holderSum := sdk.ZeroInt()
+	for _, holder := range holders {
+		holderSum = holderSum.Add(holder.amount)
+	}
+
+	if holderSum.IsZero() {
+		return nil, nil
+	}
+
+	// epoch amount = gauge_size * epoch_weight / remain_weight
+	epochCoins := gauge.EpochEmissionCoins(gauge.Coins.Sub(gauge.DistributedCoins))
+
+	for _, holder := range holders {
+		distrCoins := sdk.Coins{}
+		for _, coin := range epochCoins {
+			// distribution amount = epoch_amount * holder_share_time / total_share_time
+			amt := coin.Amount.Mul(holder.amount).Quo(holderSum)
+			if amt.IsPositive() {
+				distrCoins = distrCoins.Add(sdk.Coin{Denom: coin.Denom, Amount: amt})
+			}
+		}
+		distrCoins = distrCoins.Sort()
+		if distrCoins.Empty() {
+			continue
+		}
+		if err := distrInfo.addLockRewards(holder.address, distrCoins); err != nil {
+			return nil, err
+		}
 
 		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
 	}
@@ -323,9 +431,13 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 	if gauge.Coins.Empty() {
 		return []lockuptypes.PeriodLock{}
 	}
+	// locks past a timestamp can't be derived from the per-denom duration cache,
+	// so time based gauges query them directly. These are never synthetic.
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		return k.lk.GetLocksPastTimeDenom(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Timestamp)
+	}
 	// TODO: FIXME!!!
 	// Confusingly, there is no way to get all synthetic lockups. Thus we use a separate method `distributeSyntheticInternal` to separately get lockSum for synthetic lockups.
-	// All gauges have a precondition of being ByDuration.
 	distributeBaseDenom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
 	if _, ok := cache[distributeBaseDenom]; !ok {
 		cache[distributeBaseDenom] = k.getLocksToDistributionWithMaxDuration(
@@ -339,34 +451,59 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 
 // Distribute coins from gauge according to its conditions.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
-	distrInfo := newDistributionInfo()
+	distributedCoins, _, err := k.distribute(ctx, gauges, false)
+	return distributedCoins, err
+}
+
+// DistributeWithLockRewards distributes coins from gauges like Distribute, and also returns the rewards
+// each gauge sent to each lock.
+// Rewards sent to unlocked balances or accrued for claims are not returned.
+func (k Keeper) DistributeWithLockRewards(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, []types.LockRewards, error) {
+	return k.distribute(ctx, gauges, true)
+}
+
+func (k Keeper) distribute(ctx sdk.Context, gauges []types.Gauge, trackLockRewards bool) (sdk.Coins, []types.LockRewards, error) {
+	distrInfo := newDistributionInfo(trackLockRewards)
 
 	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.Coins{}
+	claimBasedDistribution := k.IsClaimBasedDistributionActive(ctx)
+	var holdersByDenom map[string][]denomHolder
 	for _, gauge := range gauges {
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var gaugeDistributedCoins sdk.Coins
 		var err error
-		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+		switch {
+		// reward unlocked balances, holders of all no lock gauges are gathered in a single pass
+		case gauge.DistributeTo.LockQueryType == lockuptypes.NoLock:
+			if holdersByDenom == nil {
+				holdersByDenom = k.getDenomHolders(ctx, getNoLockDenoms(gauges))
+			}
+			gaugeDistributedCoins, err = k.distributeNoLockInternal(ctx, gauge, holdersByDenom[gauge.DistributeTo.Denom], &distrInfo)
+		// accrue into the reward index without touching the locks, owners claim their share later on
+		case claimBasedDistribution && isClaimBasedGauge(gauge):
+			gaugeDistributedCoins, err = k.accrueGaugeRewards(ctx, gauge)
+		// send based on synthetic lockup coins if it's distributing to synthetic lockups
+		case lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom):
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
-		} else {
+		default:
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
 			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
 	}
 
 	err := k.doDistributionSends(ctx, &distrInfo)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	k.hooks.AfterEpochDistribution(ctx)
 
 	k.checkFinishDistribution(ctx, gauges)
-	return totalDistributedCoins, nil
+	return totalDistributedCoins, distrInfo.lockRewards, nil
 }
 
 func (k Keeper) checkFinishDistribution(ctx sdk.Context, gauges []types.Gauge) {
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/osmosis-labs/osmosis/v10/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 
@@ -82,6 +84,265 @@ func (suite *KeeperTestSuite) TestDistribute() {
 	// TODO: test distribution for synthetic lockup as well
 }
 
+// TestDistributeToRewardReceiver tests that rewards for a lock with a reward
+// receiver set are paid to the receiver instead of the lock owner, for both
+// regular and synthetic (superfluid) gauges.
+func (suite *KeeperTestSuite) TestDistributeToRewardReceiver() {
+	// superfluid gauges distribute to synthetic denoms suffixed with a validator address
+	synthDenom := defaultLPDenom + "/superbonding/" + sdk.ValAddress([]byte("addrval-------------")).String()
+	tests := []struct {
+		name       string
+		distrDenom string
+		synthetic  bool
+	}{
+		{name: "regular lock gauge", distrDenom: defaultLPDenom},
+		{name: "synthetic lock gauge", distrDenom: synthDenom, synthetic: true},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			rewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+			owner := suite.setupAddr(0, "owner", defaultLPTokens)
+			receiver := suite.setupAddr(1, "receiver", sdk.Coins{})
+
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, defaultLPTokens, defaultLockDuration)
+			suite.Require().NoError(err)
+			if tc.synthetic {
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, synthDenom, defaultLockDuration, false)
+				suite.Require().NoError(err)
+			}
+			err = suite.App.LockupKeeper.SetLockRewardReceiver(suite.Ctx, lock.ID, owner, receiver)
+			suite.Require().NoError(err)
+
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         tc.distrDenom,
+				Duration:      defaultLockDuration,
+			}
+			_, gauge := suite.CreateGauge(true, suite.setupAddr(2, "creator", sdk.Coins{}), rewardCoins, distrTo, suite.Ctx.BlockTime(), 1)
+			_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(rewardCoins.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, receiver).String())
+			suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner).AmountOf(defaultRewardDenom).IsZero())
+		})
+	}
+}
+
+// TestDistributeWithLockRewards tests that the rewards each gauge sends to each lock are returned.
+func (suite *KeeperTestSuite) TestDistributeWithLockRewards() {
+	suite.SetupTest()
+	synthDenom := defaultLPDenom + "/superbonding/" + sdk.ValAddress([]byte("addrval-------------")).String()
+	rewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+
+	lockIds := []uint64{}
+	for i, lockAmount := range []int64{10, 20} {
+		lockCoins := sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, lockAmount)}
+		owner := suite.setupAddr(i, "owner", lockCoins)
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, lockCoins, defaultLockDuration)
+		suite.Require().NoError(err)
+		err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, synthDenom, defaultLockDuration, false)
+		suite.Require().NoError(err)
+		lockIds = append(lockIds, lock.ID)
+	}
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         synthDenom,
+		Duration:      defaultLockDuration,
+	}
+	_, gauge := suite.CreateGauge(true, suite.setupAddr(2, "creator", sdk.Coins{}), rewardCoins, distrTo, suite.Ctx.BlockTime(), 1)
+	distributedCoins, lockRewards, err := suite.App.IncentivesKeeper.DistributeWithLockRewards(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewardCoins, distributedCoins)
+	suite.Require().Equal([]types.LockRewards{
+		{GaugeId: gauge.Id, LockId: lockIds[0], Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}},
+		{GaugeId: gauge.Id, LockId: lockIds[1], Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}},
+	}, lockRewards)
+}
+
+// TestDistributeByTime tests that a gauge with a time query condition only
+// estimates and distributes rewards to locks that stay locked past its timestamp.
+func (suite *KeeperTestSuite) TestDistributeByTime() {
+	suite.SetupTest()
+	rewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	shortLocker := suite.setupAddr(0, "short", defaultLPTokens)
+	longLocker := suite.setupAddr(1, "long", defaultLPTokens)
+	_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, shortLocker, defaultLPTokens, time.Second)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.CreateLock(suite.Ctx, longLocker, defaultLPTokens, time.Hour)
+	suite.Require().NoError(err)
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     suite.Ctx.BlockTime().Add(10 * time.Minute),
+	}
+	_, gauge := suite.CreateGauge(true, suite.setupAddr(2, "creator", sdk.Coins{}), rewardCoins, distrTo, suite.Ctx.BlockTime(), 1)
+
+	// only the lock that outlives the timestamp earns rewards
+	endEpoch := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).CurrentEpoch + 1
+	rewardsEst := suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, longLocker, []lockuptypes.PeriodLock{}, endEpoch)
+	suite.Require().Equal(rewardCoins.String(), rewardsEst.String())
+	rewardsEst = suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, shortLocker, []lockuptypes.PeriodLock{}, endEpoch)
+	suite.Require().True(rewardsEst.Empty())
+
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewardCoins.String(), distrCoins.String())
+	suite.Require().Equal(rewardCoins.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, longLocker).String())
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, shortLocker).Empty())
+}
+
+// TestDistributeEmissionSchedule tests that non-perpetual gauges distribute their coins along their
+// emission schedule, and that the rewards estimation follows the same schedule.
+func (suite *KeeperTestSuite) TestDistributeEmissionSchedule() {
+	tests := []struct {
+		name             string
+		emissionSchedule *types.EmissionSchedule
+		rewards          int64
+		expectedRewards  []int64
+	}{
+		{
+			name:            "flat schedule",
+			rewards:         3000,
+			expectedRewards: []int64{1000, 1000, 1000},
+		},
+		{
+			name:             "exponential decay",
+			emissionSchedule: &types.EmissionSchedule{Curve: types.ExponentialDecay, DecayFactor: sdk.NewDecWithPrec(5, 1)},
+			rewards:          7000,
+			expectedRewards:  []int64{4000, 2000, 1000},
+		},
+		{
+			name:             "linear ramp down",
+			emissionSchedule: &types.EmissionSchedule{Curve: types.LinearRampDown},
+			rewards:          6000,
+			expectedRewards:  []int64{3000, 2000, 1000},
+		},
+		{
+			name:             "custom weights",
+			emissionSchedule: &types.EmissionSchedule{Curve: types.Custom, EpochWeights: []sdk.Dec{sdk.NewDec(1), sdk.ZeroDec(), sdk.NewDec(3)}},
+			rewards:          4000,
+			expectedRewards:  []int64{1000, 0, 3000},
+		},
+		{
+			name: "large custom weights",
+			emissionSchedule: &types.EmissionSchedule{Curve: types.Custom, EpochWeights: []sdk.Dec{
+				sdk.NewDec(10).Power(55), sdk.ZeroDec(), sdk.NewDec(10).Power(55).MulInt64(3),
+			}},
+			rewards:         4000,
+			expectedRewards: []int64{1000, 0, 3000},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			rewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, tc.rewards)}
+			owner := suite.setupAddr(0, "owner", defaultLPTokens)
+			_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, defaultLPTokens, time.Second)
+			suite.Require().NoError(err)
+			suite.FundAcc(gaugeCreationAddr, rewardCoins)
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         defaultLPDenom,
+				Duration:      time.Second,
+			}
+			gaugeID, err := suite.App.IncentivesKeeper.CreateGaugeWithEmissionSchedule(
+				suite.Ctx, false, gaugeCreationAddr, rewardCoins, distrTo, suite.Ctx.BlockTime(), uint64(len(tc.expectedRewards)), tc.emissionSchedule)
+			suite.Require().NoError(err)
+
+			// the estimation for the next epoch and for the whole schedule follows the curve
+			currentEpoch := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).CurrentEpoch
+			rewardsEst := suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, owner, []lockuptypes.PeriodLock{}, currentEpoch)
+			suite.Require().Equal(sdk.NewInt(tc.expectedRewards[0]), rewardsEst.AmountOf(defaultRewardDenom))
+			rewardsEst = suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, owner, []lockuptypes.PeriodLock{}, currentEpoch+int64(len(tc.expectedRewards)))
+			suite.Require().Equal(sdk.NewInt(tc.rewards), rewardsEst.AmountOf(defaultRewardDenom))
+
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+			suite.Require().NoError(err)
+			for _, expected := range tc.expectedRewards {
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(expected), distrCoins.AmountOf(defaultRewardDenom))
+			}
+			suite.requireRewardBalance(owner, tc.rewards)
+		})
+	}
+}
+
+// TestDistributeNoLock tests that a no lock gauge distributes rewards to the holders of its pool shares
+// pro-rata to the shares they held times the time they held them, and nothing to locks.
+func (suite *KeeperTestSuite) TestDistributeNoLock() {
+	suite.SetupTest()
+	poolID := suite.PrepareBalancerPool()
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	creator := suite.TestAccs[0]
+	joiner := suite.TestAccs[1]
+	receiver := suite.TestAccs[2]
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         shareDenom,
+	}
+	rewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	_, gauge := suite.CreateGauge(false, suite.setupAddr(3, "creator", sdk.Coins{}), rewardCoins.Add(rewardCoins...), distrTo, suite.Ctx.BlockTime(), 2)
+	err := suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	// the pool creator holds 100 shares for two hours, the joiner 50 shares for the last hour
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	suite.FundAcc(joiner, apptesting.DefaultAcctFunds)
+	err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, joiner, poolID, gammtypes.OneShare.MulRaw(50), apptesting.DefaultAcctFunds)
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	// shares transferred away only count up to the remaining balance since the last update,
+	// and shares received by transfer are not tracked
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, creator, receiver, sdk.Coins{sdk.NewCoin(shareDenom, gammtypes.OneShare.MulRaw(50))})
+	suite.Require().NoError(err)
+
+	// locks don't earn from no lock gauges
+	endEpoch := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).CurrentEpoch + 1
+	rewardsEst := suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, joiner, []lockuptypes.PeriodLock{}, endEpoch)
+	suite.Require().True(rewardsEst.Empty())
+
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewardCoins.String(), distrCoins.String())
+	suite.Require().Equal(sdk.NewInt(2000), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, defaultRewardDenom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, receiver, defaultRewardDenom).IsZero())
+
+	// share times start over after a distribution, with the current balances
+	holder, found := suite.App.IncentivesKeeper.GetShareHolder(suite.Ctx, shareDenom, creator.String())
+	suite.Require().True(found)
+	suite.Require().True(holder.ShareTime.IsZero())
+	suite.Require().Equal(gammtypes.OneShare.MulRaw(50), holder.Shares)
+
+	// the joiner exits the pool half way through the next period
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, joiner, poolID, gammtypes.OneShare.MulRaw(50), sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(4000), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(2000), suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, defaultRewardDenom).Amount)
+
+	// holders without shares are dropped from the index
+	_, found = suite.App.IncentivesKeeper.GetShareHolder(suite.Ctx, shareDenom, joiner.String())
+	suite.Require().False(found)
+}
+
 // TODO: Make this test table driven, or move whatever it tests into
 // the much simpler TestDistribute
 func (suite *KeeperTestSuite) TestGetModuleToDistributeCoins() {
@@ -185,6 +446,7 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -230,6 +492,7 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -98,6 +98,21 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 
 // CreateGauge create a gauge and send coins to the gauge.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.CreateGaugeWithEmissionSchedule(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, nil)
+}
+
+// CreateGaugeWithEmissionSchedule create a gauge emitting its coins along the given emission schedule
+// and send coins to the gauge. A nil schedule emits the same amount every epoch.
+func (k Keeper) CreateGaugeWithEmissionSchedule(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, emissionSchedule *types.EmissionSchedule) (uint64, error) {
+	if emissionSchedule != nil {
+		if isPerpetual {
+			return 0, fmt.Errorf("perpetual gauges can't have an emission schedule")
+		}
+		if err := emissionSchedule.Validate(numEpochsPaidOver); err != nil {
+			return 0, err
+		}
+	}
+
 	// Ensure that this gauge's duration is one of the allowed durations on chain
 	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
@@ -125,6 +140,8 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		Owner:             owner.String(),
+		EmissionSchedule:  emissionSchedule,
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -149,12 +166,51 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	return gauge.Id, nil
 }
 
+// chargeGaugeCreationFee checks that the coins of a gauge are worth at least the minimum gauge value,
+// and sends the gauge creation fee, plus the additional denom fee for every reward denom beyond the first,
+// from the owner to the community pool.
+func (k Keeper) chargeGaugeCreationFee(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if params.MinGaugeValue.IsPositive() {
+		value := k.getGaugeValue(ctx, coins)
+		if value.LT(params.MinGaugeValue) {
+			return fmt.Errorf("gauge value %s is less than the minimum gauge value %s", value, params.MinGaugeValue)
+		}
+	}
+
+	fee := params.GaugeCreationFee
+	for i := 1; i < len(coins); i++ {
+		fee = fee.Add(params.AdditionalDenomFee...)
+	}
+	if fee.Empty() {
+		return nil
+	}
+	return k.dk.FundCommunityPool(ctx, fee, owner)
+}
+
+// getGaugeValue returns the value of the coins in the txfees base denom.
+// Coins that aren't fee tokens can't be priced and aren't counted.
+func (k Keeper) getGaugeValue(ctx sdk.Context, coins sdk.Coins) sdk.Int {
+	value := sdk.ZeroInt()
+	for _, coin := range coins {
+		baseCoin, err := k.tk.ConvertToBaseToken(ctx, coin)
+		if err != nil {
+			continue
+		}
+		value = value.Add(baseCoin.Amount)
+	}
+	return value
+}
+
 // AddToGauge add coins to gauge.
 func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error {
 	gauge, err := k.GetGaugeByID(ctx, gaugeID)
 	if err != nil {
 		return err
 	}
+	if k.IsFinishedGauge(ctx, *gauge) {
+		return fmt.Errorf("gauge with ID %d has already finished", gaugeID)
+	}
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
 		return err
 	}
@@ -168,6 +224,65 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// CancelGauge stops a gauge that has not finished distributing, refunding its undistributed
+// coins to the gauge owner and moving it to the finished gauges. Gauges created before gauges had
+// owners refund the community pool instead. Authorization is left to the caller.
+func (k Keeper) CancelGauge(ctx sdk.Context, gaugeID uint64) (sdk.Coins, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	var owner sdk.AccAddress
+	if gauge.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(gauge.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the gauge must still be referenced by the upcoming or the active queue
+	timeKey := getTimeKey(gauge.StartTime)
+	upcomingKey := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
+	activeKey := combineKeys(types.KeyPrefixActiveGauges, timeKey)
+	switch {
+	case findIndex(k.getGaugeRefs(ctx, upcomingKey), gaugeID) > -1:
+		err = k.deleteGaugeRefByKey(ctx, upcomingKey, gaugeID)
+	case findIndex(k.getGaugeRefs(ctx, activeKey), gaugeID) > -1:
+		err = k.deleteGaugeRefByKey(ctx, activeKey, gaugeID)
+	default:
+		return nil, fmt.Errorf("gauge with ID %d has already finished", gaugeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixFinishedGauges, timeKey), gaugeID); err != nil {
+		return nil, err
+	}
+	if err := k.deleteGaugeIDForDenom(ctx, gaugeID, gauge.DistributeTo.Denom); err != nil {
+		return nil, err
+	}
+
+	refund := gauge.Coins.Sub(gauge.DistributedCoins)
+	if !refund.Empty() {
+		if owner.Empty() {
+			err = k.dk.FundCommunityPool(ctx, refund, k.ak.GetModuleAddress(types.ModuleName))
+		} else {
+			err = k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, refund)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the gauge only keeps the coins it actually paid out
+	gauge.Coins = gauge.DistributedCoins
+	if err := k.setGauge(ctx, gauge); err != nil {
+		return nil, err
+	}
+	k.hooks.AfterFinishDistribution(ctx, gaugeID)
+	return refund, nil
+}
+
 // GetGaugeByID Returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -247,6 +362,10 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 			if err != nil {
 				return sdk.Coins{}
 			}
+			// locks don't earn from gauges rewarding unlocked balances
+			if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+				continue
+			}
 			gauges = append(gauges, *gauge)
 		}
 	}
@@ -281,3 +400,10 @@ func (k Keeper) GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo {
 	params := k.GetParams(ctx)
 	return k.ek.GetEpochInfo(ctx, params.DistrEpochIdentifier)
 }
+
+// IsFinishedGauge returns whether the gauge was moved to the finished gauges, either after its last
+// distribution or by a cancellation.
+func (k Keeper) IsFinishedGauge(ctx sdk.Context, gauge types.Gauge) bool {
+	finishedKey := combineKeys(types.KeyPrefixFinishedGauges, getTimeKey(gauge.StartTime))
+	return findIndex(k.getGaugeRefs(ctx, finishedKey), gauge.Id) > -1
+}
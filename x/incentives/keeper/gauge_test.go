@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/keeper"
 	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func (suite *KeeperTestSuite) TestInvalidDurationGaugeCreationValidation() {
@@ -43,6 +45,50 @@ func (suite *KeeperTestSuite) TestNonExistentDenomGaugeCreation() {
 	suite.Require().NoError(err)
 }
 
+// TestGaugeCreationFee tests that gauges created with MsgCreateGauge must be worth the minimum gauge value
+// and pay the creation fees to the community pool, while gauges created by other modules are exempt.
+func (suite *KeeperTestSuite) TestGaugeCreationFee() {
+	suite.SetupTest()
+	baseDenom, err := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	suite.Require().NoError(err)
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.GaugeCreationFee = sdk.Coins{sdk.NewInt64Coin(baseDenom, 50)}
+	params.AdditionalDenomFee = sdk.Coins{sdk.NewInt64Coin(baseDenom, 10)}
+	params.MinGaugeValue = sdk.NewInt(1000)
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+
+	owner := suite.setupAddr(0, "owner", sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2000), sdk.NewInt64Coin("foo", 20000)))
+	suite.setupAddr(1, "lp", defaultLPTokens)
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      defaultLockDuration,
+	}
+	msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+	createGauge := func(coins sdk.Coins) error {
+		msg := types.NewMsgCreateGauge(false, owner, distrTo, coins, suite.Ctx.BlockTime(), 1)
+		_, err := msgServer.CreateGauge(sdk.WrapSDKContext(suite.Ctx), msg)
+		return err
+	}
+
+	// denoms that aren't fee tokens don't count towards the gauge value
+	err = createGauge(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500), sdk.NewInt64Coin("foo", 10000)))
+	suite.Require().Error(err)
+
+	// the second reward denom pays the additional denom fee
+	prevCommunityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	err = createGauge(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("foo", 10000)))
+	suite.Require().NoError(err)
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	suite.Require().Equal(prevCommunityPool.Add(sdk.NewDecCoin(baseDenom, sdk.NewInt(60))), communityPool)
+	suite.Require().Equal(sdk.NewInt(940), suite.App.BankKeeper.GetBalance(suite.Ctx, owner, baseDenom).Amount)
+
+	// gauges created by modules don't pay fees nor need the minimum value
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, owner, sdk.Coins{}, distrTo, suite.Ctx.BlockTime(), 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(communityPool, suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+}
+
 // TODO: Make this test table driven
 // OR if it needs to be script based,
 // remove lots of boilerplate so this can actually be followed
@@ -93,6 +139,7 @@ func (suite *KeeperTestSuite) TestNonPerpetualGaugeOperations() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -173,6 +220,7 @@ func (suite *KeeperTestSuite) TestPerpetualGaugeOperations() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -196,6 +244,7 @@ func (suite *KeeperTestSuite) TestPerpetualGaugeOperations() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -254,6 +303,123 @@ func (suite *KeeperTestSuite) TestPerpetualGaugeOperations() {
 	suite.Require().Equal(sdk.Coins(nil), rewardsEst)
 }
 
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	tests := []struct {
+		name           string
+		distribute     bool
+		byGov          bool
+		nonOwner       bool
+		noOwner        bool
+		cancelTwice    bool
+		expectedRefund sdk.Coins
+		expectErr      bool
+	}{
+		{
+			name:           "owner cancels upcoming gauge",
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		},
+		{
+			name:           "owner cancels active gauge after a distribution",
+			distribute:     true,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+		},
+		{
+			name:           "governance cancels any gauge",
+			byGov:          true,
+			distribute:     true,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+		},
+		{
+			name:           "governance cancels gauge without owner into the community pool",
+			byGov:          true,
+			noOwner:        true,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		},
+		{
+			name:      "non owner cannot cancel gauge",
+			nonOwner:  true,
+			expectErr: true,
+		},
+		{
+			name:        "finished gauge cannot be cancelled",
+			cancelTwice: true,
+			expectErr:   true,
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+			_, gaugeID, _, startTime := suite.SetupLockAndGauge(false)
+			if tc.noOwner {
+				// gauges created before gauges had owners
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				gauge.Owner = ""
+				suite.Require().NoError(suite.App.IncentivesKeeper.SetGauge(suite.Ctx, gauge))
+			}
+
+			if tc.distribute {
+				suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+				suite.Require().NoError(err)
+				_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+				suite.Require().NoError(err)
+			}
+
+			sender := gaugeCreationAddr
+			if tc.nonOwner {
+				sender = sdk.AccAddress([]byte("addr1---------------"))
+			}
+			cancel := func() error {
+				if tc.byGov {
+					return suite.App.IncentivesKeeper.HandleCancelGaugeProposal(suite.Ctx, &types.CancelGaugeProposal{GaugeId: gaugeID})
+				}
+				_, err := msgServer.CancelGauge(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelGauge(sender, gaugeID))
+				return err
+			}
+
+			refundAddr := gaugeCreationAddr
+			if tc.noOwner {
+				refundAddr = suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+			}
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, refundAddr)
+			err := cancel()
+			if tc.cancelTwice {
+				suite.Require().NoError(err)
+				err = cancel()
+			}
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// undistributed coins are refunded to the gauge owner
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, refundAddr)
+			suite.Require().Equal(tc.expectedRefund.String(), balanceAfter.Sub(balanceBefore).String())
+
+			// the gauge is finished and only keeps its distributed coins
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(gauge.DistributedCoins, gauge.Coins)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetNotFinishedGauges(suite.Ctx), 0)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, "lptoken"), 0)
+
+			// a cancelled gauge cannot be refilled
+			addr := sdk.AccAddress([]byte("addrx---------------"))
+			coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+			suite.FundAcc(addr, coins)
+			err = suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, addr, coins, gaugeID)
+			suite.Require().Error(err)
+			suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGaugesByDenom() {
 	// TODO: This is not a good test. We should refactor it to be table driven,
 	// specifying a list of gauges to define, and then the expected result of gauges by denom
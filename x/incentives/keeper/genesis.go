@@ -17,13 +17,30 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, index := range genState.RewardIndexes {
+		k.SetRewardIndex(ctx, index)
+	}
+	for _, checkpoint := range genState.LockRewardCheckpoints {
+		k.SetLockRewardCheckpoint(ctx, checkpoint)
+	}
+	k.setClaimBasedDistributionActive(ctx, genState.ClaimBasedDistributionActive)
+	k.setLockRewardCheckpointCursor(ctx, genState.LockRewardCheckpointCursor)
+	for _, holder := range genState.ShareHolders {
+		k.SetShareHolder(ctx, holder)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
+		Params:                k.GetParams(ctx),
+		LockableDurations:     k.GetLockableDurations(ctx),
+		Gauges:                k.GetNotFinishedGauges(ctx),
+		RewardIndexes:         k.GetAllRewardIndexes(ctx),
+		LockRewardCheckpoints: k.GetAllLockRewardCheckpoints(ctx),
+
+		ClaimBasedDistributionActive: k.IsClaimBasedDistributionActive(ctx),
+		LockRewardCheckpointCursor:   k.GetLockRewardCheckpointCursor(ctx),
+		ShareHolders:                 k.GetAllShareHolders(ctx),
 	}
 }
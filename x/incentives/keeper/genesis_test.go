@@ -54,6 +54,7 @@ func TestIncentivesExportGenesis(t *testing.T) {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins(nil),
 		StartTime:         startTime.UTC(),
+		Owner:             addr.String(),
 	})
 }
 
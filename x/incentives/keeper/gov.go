@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+)
+
+// HandleCancelGaugeProposal cancels any gauge that has not finished distributing, regardless of its owner.
+func (k Keeper) HandleCancelGaugeProposal(ctx sdk.Context, p *types.CancelGaugeProposal) error {
+	refunded, err := k.CancelGauge(ctx, p.GaugeId)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(p.GaugeId)),
+			sdk.NewAttribute(types.AttributeAmount, refunded.String()),
+		),
+	})
+	return nil
+}
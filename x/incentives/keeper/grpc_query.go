@@ -174,6 +174,22 @@ func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDu
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// ClaimableRewards returns the rewards accrued by a lock that can be claimed.
+func (q Querier) ClaimableRewards(goCtx context.Context, req *types.ClaimableRewardsRequest) (*types.ClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	coins, err := q.Keeper.GetClaimableRewards(ctx, req.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClaimableRewardsResponse{Coins: coins}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from gauge id json bytes.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
@@ -41,6 +41,7 @@ func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(res.Gauge.String(), expectedGauge.String())
 }
@@ -73,6 +74,7 @@ func (suite *KeeperTestSuite) TestGRPCGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -118,6 +120,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -168,6 +171,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -220,6 +224,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -268,6 +273,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             gaugeCreationAddr.String(),
 	}
 	suite.Require().Equal(res.UpcomingGauges[0].String(), expectedGauge.String())
 
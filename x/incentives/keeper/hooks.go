@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v10/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 
@@ -24,6 +28,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			}
 		}
 
+		k.updateClaimBasedDistribution(ctx)
+
 		// distribute due to epoch event
 		ctx.EventManager().IncreaseCapacity(2e6)
 		gauges = k.GetActiveGauges(ctx)
@@ -52,7 +58,11 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks  = Hooks{}
+	_ lockuptypes.LockupHooks = Hooks{}
+	_ gammtypes.GammHooks     = Hooks{}
+)
 
 // Return the wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -67,3 +77,64 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// lockup hooks
+// Lockup hooks are called after the lock has changed, so rewards accrued up to now are claimed
+// based on the lock's checkpoint before checkpointing it again with its new state.
+func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+}
+
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+}
+
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+}
+
+// the lock has been deleted by now, so its rewards are paid out and its checkpoint removed.
+// This also applies while claim based distribution is disabled, since the checkpoint holds rewards accrued before.
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	if _, err := h.k.claimUnlockedLockRewards(ctx, lockID); err != nil {
+		h.k.Logger(ctx).Error(fmt.Sprintf("failed to claim rewards of unlocked lock %d: %s", lockID, err.Error()))
+	}
+}
+
+func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+}
+
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+}
+
+// the split lock starts accruing from the split on, the original lock is claimed with its coins before the split.
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+	h.k.claimLockRewardsOnLockChange(ctx, splitLockID)
+}
+
+// rewards accrued so far are paid out to the previous reward receiver.
+func (h Hooks) OnRewardReceiverChange(ctx sdk.Context, lockID uint64, rewardReceiver sdk.AccAddress) {
+	h.k.claimLockRewardsOnLockChange(ctx, lockID)
+}
+
+func (h Hooks) OnSyntheticLockupDeleted(ctx sdk.Context, lockID uint64, synthDenom string) {}
+
+// gamm hooks
+// Gamm hooks track the pool shares of accounts, so that no lock gauges reward them by the time they were held.
+func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.k.updateShareHolder(ctx, sender, gammtypes.GetPoolShareDenom(poolId))
+}
+
+func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	h.k.updateShareHolder(ctx, sender, gammtypes.GetPoolShareDenom(poolId))
+}
+
+func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	h.k.updateShareHolder(ctx, sender, gammtypes.GetPoolShareDenom(poolId))
+}
+
+func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
@@ -30,7 +30,13 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	// gauges created by other modules, such as pool-incentives, don't go through the msg server
+	// and aren't charged the gauge creation fee
+	if err := server.keeper.chargeGaugeCreationFee(ctx, owner, msg.Coins); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, err.Error())
+	}
+
+	gaugeID, err := server.keeper.CreateGaugeWithEmissionSchedule(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver, msg.EmissionSchedule)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -65,3 +71,63 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gauge, err := server.keeper.GetGaugeByID(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if gauge.Owner != msg.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of gauge %d", msg.Owner, msg.GaugeId)
+	}
+
+	refunded, err := server.keeper.CancelGauge(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(msg.GaugeId)),
+			sdk.NewAttribute(types.AttributeAmount, refunded.String()),
+		),
+	})
+
+	return &types.MsgCancelGaugeResponse{Refunded: refunded}, nil
+}
+
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lockIDs := msg.LockIds
+	if len(lockIDs) == 0 {
+		for _, lock := range server.keeper.lk.GetAccountPeriodLocks(ctx, owner) {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+	}
+
+	claimed := sdk.Coins{}
+	for _, lockID := range lockIDs {
+		lock, err := server.keeper.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if lock.Owner != msg.Owner {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of lock %d", msg.Owner, lockID)
+		}
+
+		lockClaimed, err := server.keeper.ClaimLockRewards(ctx, lockID)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		claimed = claimed.Add(lockClaimed...)
+	}
+
+	return &types.MsgClaimRewardsResponse{Claimed: claimed}, nil
+}
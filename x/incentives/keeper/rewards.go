@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v10/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rewardIndexDenomPrefix returns the prefix of all reward indexes of the given denom.
+func rewardIndexDenomPrefix(denom string) []byte {
+	return combineKeys(types.KeyPrefixRewardIndex, []byte(denom), []byte{})
+}
+
+// rewardIndexStoreKey returns the store key of the reward index of the given denom and duration.
+func rewardIndexStoreKey(denom string, duration time.Duration) []byte {
+	return combineKeys(types.KeyPrefixRewardIndex, []byte(denom), sdk.Uint64ToBigEndian(uint64(duration)))
+}
+
+// lockRewardCheckpointStoreKey returns the store key of the reward checkpoint of the given lock.
+func lockRewardCheckpointStoreKey(lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockRewardCheckpoint, sdk.Uint64ToBigEndian(lockID))
+}
+
+// GetRewardIndex returns the reward index of the given denom and duration.
+// An index that has not accrued any rewards yet is returned empty.
+func (k Keeper) GetRewardIndex(ctx sdk.Context, denom string, duration time.Duration) types.RewardIndex {
+	index := types.RewardIndex{Denom: denom, Duration: duration}
+	bz := ctx.KVStore(k.storeKey).Get(rewardIndexStoreKey(denom, duration))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &index)
+	}
+	return index
+}
+
+// GetRewardIndexesByDenom returns the reward indexes of the given denom, ordered by duration.
+func (k Keeper) GetRewardIndexesByDenom(ctx sdk.Context, denom string) []types.RewardIndex {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), rewardIndexDenomPrefix(denom))
+	defer iterator.Close()
+
+	indexes := []types.RewardIndex{}
+	for ; iterator.Valid(); iterator.Next() {
+		index := types.RewardIndex{}
+		k.cdc.MustUnmarshal(iterator.Value(), &index)
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// GetAllRewardIndexes returns all reward indexes.
+func (k Keeper) GetAllRewardIndexes(ctx sdk.Context) []types.RewardIndex {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRewardIndex)
+	defer iterator.Close()
+
+	indexes := []types.RewardIndex{}
+	for ; iterator.Valid(); iterator.Next() {
+		index := types.RewardIndex{}
+		k.cdc.MustUnmarshal(iterator.Value(), &index)
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// SetRewardIndex stores the given reward index.
+func (k Keeper) SetRewardIndex(ctx sdk.Context, index types.RewardIndex) {
+	ctx.KVStore(k.storeKey).Set(rewardIndexStoreKey(index.Denom, index.Duration), k.cdc.MustMarshal(&index))
+}
+
+// GetLockRewardCheckpoint returns the reward checkpoint of the given lock, if any.
+func (k Keeper) GetLockRewardCheckpoint(ctx sdk.Context, lockID uint64) (types.LockRewardCheckpoint, bool) {
+	checkpoint := types.LockRewardCheckpoint{}
+	bz := ctx.KVStore(k.storeKey).Get(lockRewardCheckpointStoreKey(lockID))
+	if bz == nil {
+		return checkpoint, false
+	}
+	k.cdc.MustUnmarshal(bz, &checkpoint)
+	return checkpoint, true
+}
+
+// GetAllLockRewardCheckpoints returns the reward checkpoints of all locks.
+func (k Keeper) GetAllLockRewardCheckpoints(ctx sdk.Context) []types.LockRewardCheckpoint {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixLockRewardCheckpoint)
+	defer iterator.Close()
+
+	checkpoints := []types.LockRewardCheckpoint{}
+	for ; iterator.Valid(); iterator.Next() {
+		checkpoint := types.LockRewardCheckpoint{}
+		k.cdc.MustUnmarshal(iterator.Value(), &checkpoint)
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints
+}
+
+// SetLockRewardCheckpoint stores the given lock reward checkpoint.
+func (k Keeper) SetLockRewardCheckpoint(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) {
+	ctx.KVStore(k.storeKey).Set(lockRewardCheckpointStoreKey(checkpoint.LockId), k.cdc.MustMarshal(&checkpoint))
+}
+
+func (k Keeper) deleteLockRewardCheckpoint(ctx sdk.Context, lockID uint64) {
+	ctx.KVStore(k.storeKey).Delete(lockRewardCheckpointStoreKey(lockID))
+}
+
+// IsClaimBasedDistributionActive returns true if gauges accrue rewards for claims. Claim based distribution
+// becomes active once all locks have been checkpointed after governance enabled it.
+func (k Keeper) IsClaimBasedDistributionActive(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyClaimBasedDistributionActive)
+}
+
+func (k Keeper) setClaimBasedDistributionActive(ctx sdk.Context, active bool) {
+	store := ctx.KVStore(k.storeKey)
+	if active {
+		store.Set(types.KeyClaimBasedDistributionActive, []byte{1})
+	} else {
+		store.Delete(types.KeyClaimBasedDistributionActive)
+	}
+}
+
+// GetLockRewardCheckpointCursor returns the next lock ID to checkpoint while claim based distribution is
+// being activated, or zero if no activation is in progress.
+func (k Keeper) GetLockRewardCheckpointCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLockRewardCheckpointCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setLockRewardCheckpointCursor(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if lockID == 0 {
+		store.Delete(types.KeyLockRewardCheckpointCursor)
+	} else {
+		store.Set(types.KeyLockRewardCheckpointCursor, sdk.Uint64ToBigEndian(lockID))
+	}
+}
+
+// updateClaimBasedDistribution follows the claim based distribution param at the end of a distribution epoch,
+// and returns whether claim based distribution is active for this epoch's distribution.
+// Lockup hooks only maintain checkpoints while the param is enabled, so once governance enables it, existing
+// locks are checkpointed in batches of types.LockRewardCheckpointsPerEpoch over the next epochs, and gauges
+// keep sending rewards directly until all of them are. Checkpoints left from a previous activation are claimed,
+// which pays out the rewards accrued until it was disabled, since the reward indexes do not grow in between.
+func (k Keeper) updateClaimBasedDistribution(ctx sdk.Context) bool {
+	if !k.GetParams(ctx).ClaimBasedDistribution {
+		k.setClaimBasedDistributionActive(ctx, false)
+		k.setLockRewardCheckpointCursor(ctx, 0)
+		return false
+	}
+	if k.IsClaimBasedDistributionActive(ctx) {
+		return true
+	}
+
+	cursor := k.GetLockRewardCheckpointCursor(ctx)
+	if cursor == 0 {
+		cursor = 1
+	}
+	lastLockID := k.lk.GetLastLockID(ctx)
+	end := cursor + types.LockRewardCheckpointsPerEpoch
+	for ; cursor < end && cursor <= lastLockID; cursor++ {
+		if _, err := k.lk.GetLockByID(ctx, cursor); err != nil {
+			// the lock no longer exists
+			continue
+		}
+		k.claimLockRewardsFromHook(ctx, cursor)
+	}
+
+	if cursor <= lastLockID {
+		k.setLockRewardCheckpointCursor(ctx, cursor)
+		return false
+	}
+	k.setLockRewardCheckpointCursor(ctx, 0)
+	k.setClaimBasedDistributionActive(ctx, true)
+	return true
+}
+
+// isClaimBasedGauge returns true if the rewards of the gauge accrue into reward indexes
+// when claim based distribution is enabled, instead of being sent to the locks directly.
+// Only gauges distributing to native lock durations can be claim based.
+func isClaimBasedGauge(gauge types.Gauge) bool {
+	return gauge.DistributeTo.LockQueryType == lockuptypes.ByDuration &&
+		!lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom)
+}
+
+// accrueGaugeRewards adds this epoch's rewards of a gauge to the reward index of its denom and duration,
+// to be claimed by the eligible locks later on. It also updates the gauge for the distribution.
+func (k Keeper) accrueGaugeRewards(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	lockSum := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	if !lockSum.IsPositive() {
+		return nil, nil
+	}
+
+	distrCoins := gauge.EpochEmissionCoins(gauge.Coins.Sub(gauge.DistributedCoins))
+	rewardPerShare := sdk.NewDecCoinsFromCoins(distrCoins...).QuoDecTruncate(lockSum.ToDec())
+
+	index := k.GetRewardIndex(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration)
+	index.RewardPerShare = index.RewardPerShare.Add(rewardPerShare...)
+	k.SetRewardIndex(ctx, index)
+
+	if !distrCoins.Empty() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtAccrueRewards,
+				sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(gauge.Id)),
+				sdk.NewAttribute(types.AttributeLockedDenom, gauge.DistributeTo.Denom),
+				sdk.NewAttribute(types.AttributeLockedDuration, gauge.DistributeTo.Duration.String()),
+				sdk.NewAttribute(types.AttributeAmount, distrCoins.String()),
+				sdk.NewAttribute(types.AttributeRewardPerShare, rewardPerShare.String()),
+			),
+		})
+	}
+
+	err := k.updateGaugePostDistribute(ctx, gauge, distrCoins)
+	return distrCoins, err
+}
+
+// newLockRewardCheckpoint snapshots the reward indexes the given lock is currently eligible for.
+func (k Keeper) newLockRewardCheckpoint(ctx sdk.Context, lock lockuptypes.PeriodLock, accrued sdk.DecCoins) types.LockRewardCheckpoint {
+	indexes := []types.RewardIndex{}
+	for _, coin := range lock.Coins {
+		for _, index := range k.GetRewardIndexesByDenom(ctx, coin.Denom) {
+			if index.Duration > lock.Duration {
+				break
+			}
+			indexes = append(indexes, index)
+		}
+	}
+
+	return types.LockRewardCheckpoint{
+		LockId:         lock.ID,
+		RewardReceiver: lock.RewardReceiver(),
+		Duration:       lock.Duration,
+		Coins:          lock.Coins,
+		Indexes:        indexes,
+		AccruedRewards: accrued,
+	}
+}
+
+// pendingLockRewards returns the rewards accrued by a lock since its checkpoint.
+// Rewards are computed from the coins and duration recorded at the checkpoint,
+// since those are the ones the lock held while the indexes grew.
+func (k Keeper) pendingLockRewards(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) sdk.DecCoins {
+	snapshots := make(map[string]sdk.DecCoins, len(checkpoint.Indexes))
+	for _, index := range checkpoint.Indexes {
+		snapshots[string(rewardIndexStoreKey(index.Denom, index.Duration))] = index.RewardPerShare
+	}
+
+	rewards := checkpoint.AccruedRewards
+	for _, coin := range checkpoint.Coins {
+		for _, index := range k.GetRewardIndexesByDenom(ctx, coin.Denom) {
+			if index.Duration > checkpoint.Duration {
+				break
+			}
+			// indexes created after the checkpoint were empty at the time of the checkpoint
+			snapshot := snapshots[string(rewardIndexStoreKey(index.Denom, index.Duration))]
+			rewards = rewards.Add(index.RewardPerShare.Sub(snapshot).MulDecTruncate(coin.Amount.ToDec())...)
+		}
+	}
+	return rewards
+}
+
+// GetClaimableRewards returns the rewards the given lock would receive when claiming now.
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, lockID uint64) (sdk.Coins, error) {
+	if _, err := k.lk.GetLockByID(ctx, lockID); err != nil {
+		return nil, err
+	}
+	checkpoint, found := k.GetLockRewardCheckpoint(ctx, lockID)
+	if !found {
+		return sdk.Coins{}, nil
+	}
+	claimable, _ := k.pendingLockRewards(ctx, checkpoint).TruncateDecimal()
+	return claimable, nil
+}
+
+// ClaimLockRewards pays out the rewards accrued by the given lock to the reward receiver
+// recorded at its last checkpoint, and checkpoints the lock in its current state.
+// Decimal remainders are carried over to the next claim.
+// Authorization is left to the caller.
+func (k Keeper) ClaimLockRewards(ctx sdk.Context, lockID uint64) (sdk.Coins, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, remainder := sdk.Coins{}, sdk.DecCoins{}
+	if checkpoint, found := k.GetLockRewardCheckpoint(ctx, lockID); found {
+		claimed, remainder, err = k.payoutLockRewards(ctx, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetLockRewardCheckpoint(ctx, k.newLockRewardCheckpoint(ctx, *lock, remainder))
+	return claimed, nil
+}
+
+// claimUnlockedLockRewards pays out the rewards accrued by a lock that no longer exists
+// and removes its checkpoint. Decimal remainders stay in the module.
+func (k Keeper) claimUnlockedLockRewards(ctx sdk.Context, lockID uint64) (sdk.Coins, error) {
+	checkpoint, found := k.GetLockRewardCheckpoint(ctx, lockID)
+	if !found {
+		return sdk.Coins{}, nil
+	}
+
+	claimed, _, err := k.payoutLockRewards(ctx, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	k.deleteLockRewardCheckpoint(ctx, lockID)
+	return claimed, nil
+}
+
+// payoutLockRewards sends the whole pending rewards of a checkpoint to its reward receiver
+// and returns the sent coins together with the decimal remainder.
+func (k Keeper) payoutLockRewards(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) (sdk.Coins, sdk.DecCoins, error) {
+	claimed, remainder := k.pendingLockRewards(ctx, checkpoint).TruncateDecimal()
+	if claimed.Empty() {
+		return claimed, remainder, nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(checkpoint.RewardReceiver)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, claimed); err != nil {
+		return nil, nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimRewards,
+			sdk.NewAttribute(types.AttributeLockID, utils.Uint64ToString(checkpoint.LockId)),
+			sdk.NewAttribute(types.AttributeReceiver, checkpoint.RewardReceiver),
+			sdk.NewAttribute(types.AttributeAmount, claimed.String()),
+		),
+	})
+
+	return claimed, remainder, nil
+}
+
+// claimLockRewardsOnLockChange claims the rewards of a lock on a lockup hook, while the claim based
+// distribution param is enabled. Locks changed while it is disabled are checkpointed again on activation.
+func (k Keeper) claimLockRewardsOnLockChange(ctx sdk.Context, lockID uint64) {
+	if !k.GetParams(ctx).ClaimBasedDistribution {
+		return
+	}
+	k.claimLockRewardsFromHook(ctx, lockID)
+}
+
+// claimLockRewardsFromHook claims the rewards of a lock from a hook.
+// Hooks cannot fail, so errors are logged instead.
+func (k Keeper) claimLockRewardsFromHook(ctx sdk.Context, lockID uint64) {
+	if _, err := k.ClaimLockRewards(ctx, lockID); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to claim rewards of lock %d: %s", lockID, err.Error()))
+	}
+}
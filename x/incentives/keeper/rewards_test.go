@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) enableClaimBasedDistribution() {
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.ClaimBasedDistribution = true
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+	suite.Require().True(suite.App.IncentivesKeeper.UpdateClaimBasedDistribution(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) createClaimBasedGauge(rewards sdk.Coins, duration time.Duration) types.Gauge {
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      duration,
+	}
+	_, gauge := suite.CreateGauge(true, gaugeCreationAddr, rewards, distrTo, suite.Ctx.BlockTime(), 1)
+	return *gauge
+}
+
+func (suite *KeeperTestSuite) distributeGauge(gaugeID uint64) {
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) requireRewardBalance(addr sdk.AccAddress, expected int64) {
+	suite.Require().Equal(sdk.NewInt(expected), suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) requireClaimable(lockID uint64, expected int64) {
+	claimable, err := suite.App.IncentivesKeeper.GetClaimableRewards(suite.Ctx, lockID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(expected), claimable.AmountOf(defaultRewardDenom))
+}
+
+// TestClaimBasedDistribution tests that gauges accrue rewards into the reward indexes
+// when claim based distribution is enabled, and that lock owners claim their share.
+func (suite *KeeperTestSuite) TestClaimBasedDistribution() {
+	suite.SetupTest()
+	suite.enableClaimBasedDistribution()
+	shortOwner := suite.setupAddr(0, "short", defaultLPTokens)
+	longOwner := suite.setupAddr(1, "long", defaultLPTokens.Add(defaultLPTokens...))
+	shortLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, shortOwner, defaultLPTokens, time.Second)
+	suite.Require().NoError(err)
+	longLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, longOwner, defaultLPTokens, time.Hour)
+	suite.Require().NoError(err)
+
+	// both locks are eligible for the short gauge, only the long lock for the long one
+	shortGauge := suite.createClaimBasedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, time.Second)
+	longGauge := suite.createClaimBasedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, time.Hour)
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{shortGauge, longGauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, distributed)
+
+	// nothing is sent until claimed
+	suite.requireRewardBalance(shortOwner, 0)
+	suite.requireRewardBalance(longOwner, 0)
+	suite.requireClaimable(shortLock.ID, 1500)
+	suite.requireClaimable(longLock.ID, 2500)
+
+	// claiming without lock ids claims all locks of the owner
+	msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+	res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(shortOwner, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1500)}, res.Claimed)
+	suite.requireRewardBalance(shortOwner, 1500)
+	suite.requireClaimable(shortLock.ID, 0)
+
+	// only the owner can claim the rewards of a lock
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(shortOwner, []uint64{longLock.ID}))
+	suite.Require().Error(err)
+
+	// adding tokens to a lock claims its rewards before it starts accruing with the new amount
+	_, err = suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, longLock.ID, longOwner, defaultLPTokens[0])
+	suite.Require().NoError(err)
+	suite.requireRewardBalance(longOwner, 2500)
+	suite.requireClaimable(longLock.ID, 0)
+
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, shortGauge.Id)
+	suite.distributeGauge(shortGauge.Id)
+	suite.requireClaimable(shortLock.ID, 1000)
+	suite.requireClaimable(longLock.ID, 2000)
+}
+
+// TestClaimRewardsOnLockChanges tests that the rewards of a lock are claimed to its reward
+// receiver whenever the lock is split, unlocked or gets a new reward receiver.
+func (suite *KeeperTestSuite) TestClaimRewardsOnLockChanges() {
+	suite.SetupTest()
+	suite.enableClaimBasedDistribution()
+	owner := suite.setupAddr(0, "owner", defaultLPTokens)
+	receiver := suite.setupAddr(1, "receiver", sdk.Coins{})
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, defaultLPTokens, time.Second)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.SetLockRewardReceiver(suite.Ctx, lock.ID, owner, receiver)
+	suite.Require().NoError(err)
+	gauge := suite.createClaimBasedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, time.Second)
+	suite.distributeGauge(gauge.Id)
+
+	// a partial unlock splits the lock, claiming the rewards accrued by the original lock
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 4)})
+	suite.Require().NoError(err)
+	suite.requireRewardBalance(receiver, 1000)
+	splitLockID := suite.App.LockupKeeper.GetLastLockID(suite.Ctx)
+	suite.requireClaimable(lock.ID, 0)
+	suite.requireClaimable(splitLockID, 0)
+
+	// both locks accrue with their coins after the split
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, gauge.Id)
+	suite.distributeGauge(gauge.Id)
+	suite.requireClaimable(lock.ID, 600)
+	suite.requireClaimable(splitLockID, 400)
+
+	// unlocking pays out the rewards of the unlocked lock and drops its checkpoint
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	err = suite.App.LockupKeeper.UnlockMaturedLock(suite.Ctx, splitLockID)
+	suite.Require().NoError(err)
+	suite.requireRewardBalance(receiver, 1400)
+	_, found := suite.App.IncentivesKeeper.GetLockRewardCheckpoint(suite.Ctx, splitLockID)
+	suite.Require().False(found)
+
+	// changing the reward receiver pays out the rewards accrued so far to the previous receiver
+	err = suite.App.LockupKeeper.SetLockRewardReceiver(suite.Ctx, lock.ID, owner, owner)
+	suite.Require().NoError(err)
+	suite.requireRewardBalance(receiver, 2000)
+	checkpoint, found := suite.App.IncentivesKeeper.GetLockRewardCheckpoint(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(owner.String(), checkpoint.RewardReceiver)
+}
+
+// TestClaimBasedDistributionDisabled tests that gauges keep sending rewards to locks
+// on distribution while claim based distribution is disabled.
+func (suite *KeeperTestSuite) TestClaimBasedDistributionDisabled() {
+	suite.SetupTest()
+	owner := suite.setupAddr(0, "owner", defaultLPTokens)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, defaultLPTokens, time.Second)
+	suite.Require().NoError(err)
+	gauge := suite.createClaimBasedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, time.Second)
+	suite.distributeGauge(gauge.Id)
+
+	suite.requireRewardBalance(owner, 1000)
+	suite.requireClaimable(lock.ID, 0)
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetAllRewardIndexes(suite.Ctx))
+}
+
+// TestClaimBasedDistributionActivation tests that locks changed while claim based distribution is disabled
+// are not checkpointed, and that enabling it checkpoints existing locks in batches before gauges accrue rewards.
+func (suite *KeeperTestSuite) TestClaimBasedDistributionActivation() {
+	suite.SetupTest()
+	numLocks := int(types.LockRewardCheckpointsPerEpoch) + 1
+	owner := suite.setupAddr(0, "owner", sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, int64(numLocks))})
+	for i := 0; i < numLocks; i++ {
+		_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 1)}, time.Second)
+		suite.Require().NoError(err)
+	}
+	lastLockID := suite.App.LockupKeeper.GetLastLockID(suite.Ctx)
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx))
+
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.ClaimBasedDistribution = true
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+
+	// the first epoch checkpoints a batch of locks, gauges keep sending rewards directly
+	suite.Require().False(suite.App.IncentivesKeeper.UpdateClaimBasedDistribution(suite.Ctx))
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx), int(types.LockRewardCheckpointsPerEpoch))
+	suite.Require().Equal(lastLockID, suite.App.IncentivesKeeper.GetLockRewardCheckpointCursor(suite.Ctx))
+	gauge := suite.createClaimBasedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, int64(numLocks))}, time.Second)
+	suite.distributeGauge(gauge.Id)
+	suite.requireRewardBalance(owner, int64(numLocks))
+
+	// the second epoch checkpoints the remaining locks and activates claim based distribution
+	suite.Require().True(suite.App.IncentivesKeeper.UpdateClaimBasedDistribution(suite.Ctx))
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx), numLocks)
+	suite.Require().Equal(uint64(0), suite.App.IncentivesKeeper.GetLockRewardCheckpointCursor(suite.Ctx))
+	suite.Require().True(suite.App.IncentivesKeeper.IsClaimBasedDistributionActive(suite.Ctx))
+
+	// disabling it again stops checkpointing locks on changes
+	params.ClaimBasedDistribution = false
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+	suite.Require().False(suite.App.IncentivesKeeper.UpdateClaimBasedDistribution(suite.Ctx))
+	suite.Require().False(suite.App.IncentivesKeeper.IsClaimBasedDistributionActive(suite.Ctx))
+	_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1)}, time.Second)
+	suite.Require().NoError(err)
+	_, found := suite.App.IncentivesKeeper.GetLockRewardCheckpoint(suite.Ctx, lastLockID+1)
+	suite.Require().False(found)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// shareHolderDenomPrefix returns the prefix of all share holders of the given denom.
+func shareHolderDenomPrefix(denom string) []byte {
+	return combineKeys(types.KeyPrefixShareHolder, []byte(denom), []byte{})
+}
+
+// shareHolderStoreKey returns the store key of the given holder of the given denom.
+func shareHolderStoreKey(denom string, address string) []byte {
+	return combineKeys(types.KeyPrefixShareHolder, []byte(denom), []byte(address))
+}
+
+// GetShareHolder returns the given holder of the given pool share denom, if any.
+func (k Keeper) GetShareHolder(ctx sdk.Context, denom string, address string) (types.ShareHolder, bool) {
+	holder := types.ShareHolder{}
+	bz := ctx.KVStore(k.storeKey).Get(shareHolderStoreKey(denom, address))
+	if bz == nil {
+		return holder, false
+	}
+	k.cdc.MustUnmarshal(bz, &holder)
+	return holder, true
+}
+
+// GetShareHoldersByDenom returns the holders of the given pool share denom.
+func (k Keeper) GetShareHoldersByDenom(ctx sdk.Context, denom string) []types.ShareHolder {
+	return k.getShareHoldersFromPrefix(ctx, shareHolderDenomPrefix(denom))
+}
+
+// GetAllShareHolders returns the holders of all pool share denoms.
+func (k Keeper) GetAllShareHolders(ctx sdk.Context) []types.ShareHolder {
+	return k.getShareHoldersFromPrefix(ctx, types.KeyPrefixShareHolder)
+}
+
+func (k Keeper) getShareHoldersFromPrefix(ctx sdk.Context, prefix []byte) []types.ShareHolder {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	holders := []types.ShareHolder{}
+	for ; iterator.Valid(); iterator.Next() {
+		holder := types.ShareHolder{}
+		k.cdc.MustUnmarshal(iterator.Value(), &holder)
+		holders = append(holders, holder)
+	}
+	return holders
+}
+
+// SetShareHolder stores the given share holder, or removes it once it neither holds shares
+// nor has share time left to be rewarded.
+func (k Keeper) SetShareHolder(ctx sdk.Context, holder types.ShareHolder) {
+	store := ctx.KVStore(k.storeKey)
+	key := shareHolderStoreKey(holder.Denom, holder.Address)
+	if holder.Shares.IsZero() && holder.ShareTime.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&holder))
+}
+
+// accrueShareTime adds the time the holder held its shares since its last update to its share time.
+func accrueShareTime(ctx sdk.Context, holder *types.ShareHolder) {
+	elapsed := ctx.BlockTime().Sub(holder.LastUpdate)
+	if elapsed > 0 {
+		holder.ShareTime = holder.ShareTime.Add(holder.Shares.MulRaw(int64(elapsed)))
+	}
+	holder.LastUpdate = ctx.BlockTime()
+}
+
+// updateShareHolder accrues the share time of an account for the shares it held so far,
+// and tracks its current balance of the given pool share denom from now on.
+// It is called by gamm hooks whenever an account gets or returns pool shares.
+// Module accounts are skipped, so that shares held on behalf of others don't earn from no lock gauges.
+func (k Keeper) updateShareHolder(ctx sdk.Context, address sdk.AccAddress, denom string) {
+	if _, ok := k.ak.GetAccount(ctx, address).(authtypes.ModuleAccountI); ok {
+		return
+	}
+
+	holder, found := k.GetShareHolder(ctx, denom, address.String())
+	if !found {
+		holder = types.ShareHolder{
+			Denom:      denom,
+			Address:    address.String(),
+			Shares:     sdk.ZeroInt(),
+			ShareTime:  sdk.ZeroInt(),
+			LastUpdate: ctx.BlockTime(),
+		}
+	}
+	accrueShareTime(ctx, &holder)
+	holder.Shares = k.bk.GetBalance(ctx, address, denom).Amount
+	k.SetShareHolder(ctx, holder)
+}
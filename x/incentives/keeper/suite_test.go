@@ -12,11 +12,12 @@ import (
 )
 
 var (
-	defaultLPDenom      string        = "lptoken"
-	defaultLPTokens     sdk.Coins     = sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}
-	defaultLiquidTokens sdk.Coins     = sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
-	defaultLockDuration time.Duration = time.Second
-	oneLockupUser       userLocks     = userLocks{
+	defaultLPDenom      string         = "lptoken"
+	defaultLPTokens     sdk.Coins      = sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}
+	defaultLiquidTokens sdk.Coins      = sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	defaultLockDuration time.Duration  = time.Second
+	gaugeCreationAddr   sdk.AccAddress = sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	oneLockupUser       userLocks      = userLocks{
 		lockDurations: []time.Duration{time.Second},
 		lockAmounts:   []sdk.Coins{defaultLPTokens},
 	}
@@ -104,7 +105,7 @@ func (suite *KeeperTestSuite) LockTokens(addr sdk.AccAddress, coins sdk.Coins, d
 func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins sdk.Coins, duration time.Duration) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := gaugeCreationAddr
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
@@ -132,7 +133,7 @@ func (suite *KeeperTestSuite) SetupNewGauge(isPerpetual bool, coins sdk.Coins) (
 func (suite *KeeperTestSuite) setupNewGaugeWithDenom(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := gaugeCreationAddr
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
@@ -0,0 +1,26 @@
+package incentives
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+)
+
+func NewIncentivesProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CancelGaugeProposal:
+			return handleCancelGaugeProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized incentives proposal content type: %T", c)
+		}
+	}
+}
+
+func handleCancelGaugeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CancelGaugeProposal) error {
+	return k.HandleCancelGaugeProposal(ctx, p)
+}
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/osmosis-labs/osmosis/v10/x/incentives/types"
@@ -30,6 +31,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	incentivesGenesis := types.GenesisState{
 		Params: types.Params{
 			DistrEpochIdentifier: distrEpochIdentifier,
+			GaugeCreationFee:     sdk.Coins{},
+			AdditionalDenomFee:   sdk.Coins{},
+			MinGaugeValue:        sdk.ZeroInt(),
 		},
 		// Gauges: gauges,
 		LockableDurations: []time.Duration{
@@ -2,12 +2,18 @@ package types
 
 // event types.
 const (
-	TypeEvtCreateGauge  = "create_gauge"
-	TypeEvtAddToGauge   = "add_to_gauge"
-	TypeEvtDistribution = "distribution"
+	TypeEvtCreateGauge   = "create_gauge"
+	TypeEvtAddToGauge    = "add_to_gauge"
+	TypeEvtCancelGauge   = "cancel_gauge"
+	TypeEvtDistribution  = "distribution"
+	TypeEvtClaimRewards  = "claim_rewards"
+	TypeEvtAccrueRewards = "accrue_rewards"
 
-	AttributeGaugeID     = "gauge_id"
-	AttributeLockedDenom = "denom"
-	AttributeReceiver    = "receiver"
-	AttributeAmount      = "amount"
+	AttributeGaugeID        = "gauge_id"
+	AttributeLockID         = "lock_id"
+	AttributeLockedDenom    = "denom"
+	AttributeLockedDuration = "duration"
+	AttributeRewardPerShare = "reward_per_share"
+	AttributeReceiver       = "receiver"
+	AttributeAmount         = "amount"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+	"fmt"
 	time "time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
@@ -35,3 +37,104 @@ func (gauge Gauge) IsActiveGauge(curTime time.Time) bool {
 func (gauge Gauge) IsFinishedGauge(curTime time.Time) bool {
 	return !gauge.IsUpcomingGauge(curTime) && !gauge.IsActiveGauge(curTime)
 }
+
+// Validate checks that the emission schedule can be used by a non-perpetual gauge paid over numEpochsPaidOver epochs.
+func (s EmissionSchedule) Validate(numEpochsPaidOver uint64) error {
+	switch s.Curve {
+	case Linear, LinearRampDown:
+		return nil
+	case ExponentialDecay:
+		if s.DecayFactor.IsNil() || !s.DecayFactor.IsPositive() || s.DecayFactor.GT(sdk.OneDec()) {
+			return fmt.Errorf("decay factor should be in (0, 1], got %s", s.DecayFactor)
+		}
+		return nil
+	case Custom:
+		if uint64(len(s.EpochWeights)) != numEpochsPaidOver {
+			return fmt.Errorf("expected %d epoch weights, got %d", numEpochsPaidOver, len(s.EpochWeights))
+		}
+		for _, weight := range s.EpochWeights {
+			if weight.IsNil() || weight.IsNegative() {
+				return fmt.Errorf("epoch weights should be non-negative, got %s", weight)
+			}
+		}
+		// the last epoch distributes everything left in the gauge
+		if !s.EpochWeights[len(s.EpochWeights)-1].IsPositive() {
+			return errors.New("last epoch weight should be positive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid emission curve: %d", s.Curve)
+	}
+}
+
+// EpochEmissionWeights returns the weight of the gauge's next epoch and the total weight of its remaining epochs,
+// so that the next epoch distributes weight / totalWeight of the remaining coins. The total weight is zero when
+// no epochs remain.
+func (gauge Gauge) EpochEmissionWeights() (weight, totalWeight sdk.Dec) {
+	// perpetual gauges distribute everything they hold on each epoch
+	if gauge.IsPerpetual {
+		return sdk.OneDec(), sdk.OneDec()
+	}
+	if gauge.FilledEpochs >= gauge.NumEpochsPaidOver {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	remainEpochs := gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	if gauge.EmissionSchedule == nil {
+		return sdk.OneDec(), sdk.NewDecFromInt(sdk.NewIntFromUint64(remainEpochs))
+	}
+
+	switch gauge.EmissionSchedule.Curve {
+	case ExponentialDecay:
+		// the remaining epochs emit 1, f, f^2, ..., f^(n-1) times the next epoch's emission,
+		// which sum up to (1 - f^n) / (1 - f)
+		factor := gauge.EmissionSchedule.DecayFactor
+		if factor.Equal(sdk.OneDec()) {
+			return sdk.OneDec(), sdk.NewDecFromInt(sdk.NewIntFromUint64(remainEpochs))
+		}
+		total := sdk.OneDec().Sub(factor.Power(remainEpochs)).Quo(sdk.OneDec().Sub(factor))
+		return sdk.OneDec(), total
+	case LinearRampDown:
+		// the remaining epochs emit n, n-1, ..., 1 times the last epoch's emission
+		remain := sdk.NewIntFromUint64(remainEpochs)
+		return sdk.NewDecFromInt(remain), sdk.NewDecFromInt(remain.Mul(remain.AddRaw(1)).QuoRaw(2))
+	case Custom:
+		weights := gauge.EmissionSchedule.EpochWeights[gauge.FilledEpochs:]
+		total := sdk.ZeroDec()
+		for _, w := range weights {
+			total = total.Add(w)
+		}
+		return weights[0], total
+	default:
+		return sdk.OneDec(), sdk.NewDecFromInt(sdk.NewIntFromUint64(remainEpochs))
+	}
+}
+
+// EpochEmissionCoins returns the coins the gauge distributes in its next epoch out of the given remaining coins,
+// following its emission schedule. Flat schedules distribute remaining_coins / remaining_epochs, rounded down.
+// The amounts are computed as decimals, so that large weights can't overflow the coin amounts.
+func (gauge Gauge) EpochEmissionCoins(remainCoins sdk.Coins) sdk.Coins {
+	weight, totalWeight := gauge.EpochEmissionWeights()
+	if !totalWeight.IsPositive() {
+		return sdk.Coins{}
+	}
+	// the last epoch distributes everything left, regardless of rounding
+	if weight.Equal(totalWeight) {
+		return remainCoins
+	}
+
+	epochCoins := sdk.Coins{}
+	for _, coin := range remainCoins {
+		amt := coin.Amount.ToDec().Mul(weight).Quo(totalWeight).TruncateInt()
+		if amt.IsPositive() {
+			epochCoins = epochCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return epochCoins
+}
+
+// LockRewards are the rewards a gauge distributed to a lock.
+type LockRewards struct {
+	GaugeId uint64
+	LockId  uint64
+	Coins   sdk.Coins
+}
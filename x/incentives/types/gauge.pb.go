@@ -31,6 +31,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EmissionCurve is the shape of the emission schedule of a non-perpetual
+// gauge
+type EmissionCurve int32
+
+const (
+	// the same amount is emitted every epoch
+	Linear EmissionCurve = 0
+	// each epoch emits decay_factor times the amount of the previous epoch
+	ExponentialDecay EmissionCurve = 1
+	// the emission decreases linearly to the last epoch, which emits
+	// 1 / num_epochs_paid_over of the first one
+	LinearRampDown EmissionCurve = 2
+	// each epoch emits proportionally to its weight in epoch_weights
+	Custom EmissionCurve = 3
+)
+
+var EmissionCurve_name = map[int32]string{
+	0: "Linear",
+	1: "ExponentialDecay",
+	2: "LinearRampDown",
+	3: "Custom",
+}
+
+var EmissionCurve_value = map[string]int32{
+	"Linear":           0,
+	"ExponentialDecay": 1,
+	"LinearRampDown":   2,
+	"Custom":           3,
+}
+
+func (x EmissionCurve) String() string {
+	return proto.EnumName(EmissionCurve_name, int32(x))
+}
+
+func (EmissionCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{0}
+}
+
 type Gauge struct {
 	// unique ID of a Gauge
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -51,6 +89,12 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// already distributed coins
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// address that created the gauge, which may cancel it and receives the
+	// refund of undistributed coins
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// curve the gauge's coins are emitted along over its epochs, a flat
+	// schedule when unset
+	EmissionSchedule *EmissionSchedule `protobuf:"bytes,10,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty" yaml:"emission_schedule"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -142,6 +186,72 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetEmissionSchedule() *EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return nil
+}
+
+// EmissionSchedule describes how a non-perpetual gauge's coins are spread
+// over its epochs. Each epoch distributes the share of the remaining coins
+// given by its weight over the weight of the remaining epochs, so coins added
+// to the gauge follow the same curve.
+type EmissionSchedule struct {
+	Curve EmissionCurve `protobuf:"varint,1,opt,name=curve,proto3,enum=osmosis.incentives.EmissionCurve" json:"curve,omitempty"`
+	// ratio of each epoch's emission to the previous one, for ExponentialDecay
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// weight of each epoch, for Custom
+	EpochWeights []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=epoch_weights,json=epochWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_weights" yaml:"epoch_weights"`
+}
+
+func (m *EmissionSchedule) Reset()         { *m = EmissionSchedule{} }
+func (m *EmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*EmissionSchedule) ProtoMessage()    {}
+func (*EmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *EmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSchedule.Merge(m, src)
+}
+func (m *EmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionSchedule proto.InternalMessageInfo
+
+func (m *EmissionSchedule) GetCurve() EmissionCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return Linear
+}
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -150,7 +260,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,49 +297,65 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 }
 
 func init() {
+	proto.RegisterEnum("osmosis.incentives.EmissionCurve", EmissionCurve_name, EmissionCurve_value)
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*EmissionSchedule)(nil), "osmosis.incentives.EmissionSchedule")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0x5e, 0x87, 0xc4, 0x81, 0x63, 0x07, 0x42, 0x93, 0x90, 0x28, 0xd5, 0x0e, 0x88, 0x4b,
-	0xe4, 0x24, 0x6e, 0x66, 0x35, 0xc9, 0x2f, 0x8a, 0x9d, 0x6a, 0x7d, 0x03, 0x8e, 0x13, 0x27, 0x9e,
-	0x81, 0x27, 0xd9, 0x71, 0x47, 0x4e, 0x1b, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xb1, 0x13, 0xb5, 0x2a,
-	0x57, 0x4e, 0x8e, 0x7f, 0xdf, 0xdf, 0xbf, 0xef, 0x47, 0x0e, 0x72, 0x40, 0xc6, 0x20, 0x85, 0xa4,
-	0x22, 0xf1, 0x79, 0xa2, 0xc4, 0x82, 0x4b, 0x1a, 0xb2, 0x3c, 0xe4, 0x24, 0xcd, 0x40, 0x01, 0xc6,
-	0xa5, 0x4e, 0x36, 0x7a, 0xf7, 0x38, 0x84, 0x10, 0xb4, 0x4c, 0x8b, 0x2f, 0x93, 0xd9, 0x75, 0x42,
-	0x80, 0x30, 0xe2, 0x54, 0xdf, 0xbc, 0x7c, 0x46, 0x83, 0x3c, 0x63, 0x4a, 0x40, 0x52, 0xea, 0xbd,
-	0x5d, 0x5d, 0x89, 0x98, 0x4b, 0xc5, 0xe2, 0xb4, 0x6a, 0xe0, 0xeb, 0x59, 0xd4, 0x63, 0x92, 0xd3,
-	0xc5, 0xc8, 0xe3, 0x8a, 0x8d, 0xa8, 0x0f, 0xa2, 0x6a, 0xd0, 0xa9, 0x56, 0x8d, 0xc0, 0x9f, 0xe7,
-	0xa9, 0x3e, 0x8c, 0x34, 0xf8, 0x5e, 0x47, 0x8d, 0x0f, 0xc5, 0xd6, 0xf8, 0x09, 0xda, 0x13, 0x81,
-	0x6d, 0xf5, 0xad, 0x61, 0x7d, 0xba, 0x27, 0x02, 0xfc, 0x02, 0xb5, 0x84, 0x74, 0x53, 0x9e, 0xa5,
-	0x5c, 0xe5, 0x2c, 0xb2, 0xf7, 0xfa, 0xd6, 0xf0, 0x70, 0xda, 0x14, 0x72, 0x52, 0x85, 0xf0, 0x05,
-	0x3a, 0x0a, 0x84, 0x54, 0x99, 0xf0, 0x72, 0xc5, 0x5d, 0x05, 0xf6, 0x7e, 0xdf, 0x1a, 0x36, 0xcf,
-	0x1c, 0x52, 0x59, 0x37, 0xf3, 0xc8, 0xe7, 0x9c, 0x67, 0xcb, 0x73, 0x48, 0x02, 0x51, 0xb8, 0x1a,
-	0xd7, 0x6f, 0xef, 0x7b, 0xb5, 0x69, 0x6b, 0x53, 0x7a, 0x09, 0x98, 0xa1, 0x46, 0xb1, 0xb0, 0xb4,
-	0xeb, 0xfd, 0xfd, 0x61, 0xf3, 0xac, 0x43, 0x8c, 0x25, 0x52, 0x58, 0x22, 0xa5, 0x25, 0x72, 0x0e,
-	0x22, 0x19, 0x9f, 0x16, 0xd5, 0x3f, 0x1f, 0x7a, 0xc3, 0x50, 0xa8, 0xab, 0xdc, 0x23, 0x3e, 0xc4,
-	0xb4, 0xf4, 0x6f, 0x8e, 0x13, 0x19, 0xcc, 0xa9, 0x5a, 0xa6, 0x5c, 0xea, 0x02, 0x39, 0x35, 0x9d,
-	0xf1, 0x17, 0x84, 0xa4, 0x62, 0x99, 0x72, 0x0b, 0x7c, 0x76, 0x43, 0xaf, 0xda, 0x25, 0x86, 0x2d,
-	0xa9, 0xd8, 0x92, 0xcb, 0x8a, 0xed, 0xf8, 0x79, 0x31, 0xe8, 0xcf, 0x7d, 0xaf, 0xbd, 0x64, 0x71,
-	0xf4, 0x76, 0xb0, 0xa9, 0x1d, 0xdc, 0x3c, 0xf4, 0xac, 0xe9, 0x63, 0x1d, 0x28, 0xd2, 0x31, 0x45,
-	0xc7, 0x49, 0x1e, 0xbb, 0x3c, 0x05, 0xff, 0x4a, 0xba, 0x29, 0x13, 0x81, 0x0b, 0x0b, 0x9e, 0xd9,
-	0x07, 0x1a, 0x66, 0x3b, 0xc9, 0xe3, 0xf7, 0x5a, 0x9a, 0x30, 0x11, 0x7c, 0x5a, 0xf0, 0x0c, 0xbf,
-	0x44, 0x47, 0x33, 0x11, 0x45, 0x3c, 0x28, 0x6b, 0xec, 0x47, 0x3a, 0xb3, 0x65, 0x82, 0x26, 0x19,
-	0x5f, 0xa3, 0xf6, 0x06, 0x51, 0xe0, 0x1a, 0x3c, 0x87, 0xff, 0x1f, 0xcf, 0xd3, 0xad, 0x29, 0x3a,
-	0x32, 0xf8, 0x66, 0xa1, 0x67, 0x1f, 0xc1, 0x9f, 0x33, 0x2f, 0xe2, 0xef, 0xca, 0xb7, 0x28, 0x2f,
-	0x92, 0x19, 0x60, 0x40, 0x38, 0x2a, 0x05, 0xb7, 0x7a, 0xa5, 0xd2, 0xb6, 0xca, 0xa5, 0x76, 0x59,
-	0x56, 0xb5, 0xe3, 0x57, 0x25, 0xca, 0x8e, 0x41, 0xf9, 0x6f, 0x8b, 0xc1, 0x8f, 0x02, 0x69, 0x3b,
-	0xda, 0x1d, 0x3a, 0x9e, 0xdc, 0xae, 0x1c, 0xeb, 0x6e, 0xe5, 0x58, 0xbf, 0x57, 0x8e, 0x75, 0xb3,
-	0x76, 0x6a, 0x77, 0x6b, 0xa7, 0xf6, 0x6b, 0xed, 0xd4, 0xbe, 0xbe, 0xd9, 0x32, 0x58, 0xbe, 0xb7,
-	0x93, 0x88, 0x79, 0xb2, 0xba, 0xd0, 0xc5, 0xe8, 0x94, 0x5e, 0x6f, 0xff, 0x9d, 0xda, 0xb4, 0x77,
-	0xa0, 0xd7, 0x7b, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x31, 0x6e, 0xbd, 0xc0, 0x03, 0x00,
-	0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x93, 0xcd, 0xd2, 0x4c, 0x92, 0x95, 0x33, 0x04, 0xc9, 0x1b, 0x81, 0x9d, 0x1a, 0xa8,
+	0x22, 0xa4, 0xda, 0xdd, 0x45, 0x02, 0x89, 0xa3, 0x93, 0x14, 0x55, 0xaa, 0xc4, 0x62, 0x2a, 0x15,
+	0x71, 0xb1, 0xc6, 0xf6, 0xc4, 0x19, 0xc5, 0xf6, 0x58, 0x9e, 0x71, 0x76, 0xf3, 0x0f, 0x7a, 0xec,
+	0x91, 0x33, 0xdc, 0xe0, 0x8f, 0xf4, 0xd8, 0x23, 0xe2, 0x90, 0xa2, 0xdd, 0x7f, 0x90, 0x5f, 0x80,
+	0x3c, 0x63, 0x2b, 0x21, 0x2b, 0x21, 0x90, 0x7a, 0x4a, 0xde, 0xfb, 0xbe, 0xf7, 0xbd, 0x79, 0x9f,
+	0xdf, 0x0c, 0xd0, 0x29, 0x4b, 0x28, 0x23, 0xcc, 0x26, 0x69, 0x80, 0x53, 0x4e, 0xd6, 0x98, 0xd9,
+	0x11, 0x2a, 0x22, 0x6c, 0x65, 0x39, 0xe5, 0x14, 0xc2, 0x0a, 0xb7, 0xf6, 0xf8, 0x68, 0x18, 0xd1,
+	0x88, 0x0a, 0xd8, 0x2e, 0xff, 0x49, 0xe6, 0x48, 0x8f, 0x28, 0x8d, 0x62, 0x6c, 0x8b, 0xc8, 0x2f,
+	0x16, 0x76, 0x58, 0xe4, 0x88, 0x13, 0x9a, 0x56, 0xb8, 0x71, 0x8c, 0x73, 0x92, 0x60, 0xc6, 0x51,
+	0x92, 0xd5, 0x02, 0x81, 0xe8, 0x65, 0xfb, 0x88, 0x61, 0x7b, 0x7d, 0xe1, 0x63, 0x8e, 0x2e, 0xec,
+	0x80, 0x92, 0x5a, 0xe0, 0xbc, 0x3e, 0x6a, 0x4c, 0x83, 0x55, 0x91, 0x89, 0x1f, 0x09, 0x99, 0xbf,
+	0xb7, 0x41, 0xfb, 0xdb, 0xf2, 0xd4, 0xf0, 0x0c, 0x34, 0x49, 0xa8, 0x29, 0x63, 0x65, 0x72, 0xe2,
+	0x36, 0x49, 0x08, 0x1f, 0x82, 0x1e, 0x61, 0x5e, 0x86, 0xf3, 0x0c, 0xf3, 0x02, 0xc5, 0x5a, 0x73,
+	0xac, 0x4c, 0x1e, 0xb8, 0x5d, 0xc2, 0xae, 0xea, 0x14, 0x7c, 0x06, 0xfa, 0x21, 0x61, 0x3c, 0x27,
+	0x7e, 0xc1, 0xb1, 0xc7, 0xa9, 0xd6, 0x1a, 0x2b, 0x93, 0xee, 0xa5, 0x6e, 0xd5, 0xa3, 0xcb, 0x7e,
+	0xd6, 0xf7, 0x05, 0xce, 0x37, 0x53, 0x9a, 0x86, 0xa4, 0x9c, 0xca, 0x39, 0x79, 0xb3, 0x35, 0x1a,
+	0x6e, 0x6f, 0x5f, 0xfa, 0x82, 0x42, 0x04, 0xda, 0xe5, 0x81, 0x99, 0x76, 0x32, 0x6e, 0x4d, 0xba,
+	0x97, 0xe7, 0x96, 0x1c, 0xc9, 0x2a, 0x47, 0xb2, 0xaa, 0x91, 0xac, 0x29, 0x25, 0xa9, 0xf3, 0xa4,
+	0xac, 0xfe, 0xed, 0x9d, 0x31, 0x89, 0x08, 0x5f, 0x16, 0xbe, 0x15, 0xd0, 0xc4, 0xae, 0xe6, 0x97,
+	0x3f, 0x8f, 0x59, 0xb8, 0xb2, 0xf9, 0x26, 0xc3, 0x4c, 0x14, 0x30, 0x57, 0x2a, 0xc3, 0x1f, 0x01,
+	0x60, 0x1c, 0xe5, 0xdc, 0x2b, 0xed, 0xd3, 0xda, 0xe2, 0xa8, 0x23, 0x4b, 0x7a, 0x6b, 0xd5, 0xde,
+	0x5a, 0x2f, 0x6a, 0x6f, 0x9d, 0x4f, 0xca, 0x46, 0xbb, 0xad, 0x31, 0xd8, 0xa0, 0x24, 0xfe, 0xc6,
+	0xdc, 0xd7, 0x9a, 0xaf, 0xdf, 0x19, 0x8a, 0xdb, 0x11, 0x89, 0x92, 0x0e, 0x6d, 0x30, 0x4c, 0x8b,
+	0xc4, 0xc3, 0x19, 0x0d, 0x96, 0xcc, 0xcb, 0x10, 0x09, 0x3d, 0xba, 0xc6, 0xb9, 0x76, 0x2a, 0xcc,
+	0x1c, 0xa4, 0x45, 0x32, 0x17, 0xd0, 0x15, 0x22, 0xe1, 0x77, 0x6b, 0x9c, 0xc3, 0x4f, 0x41, 0x7f,
+	0x41, 0xe2, 0x18, 0x87, 0x55, 0x8d, 0xf6, 0x81, 0x60, 0xf6, 0x64, 0x52, 0x92, 0xe1, 0x0d, 0x18,
+	0xec, 0x2d, 0x0a, 0x3d, 0x69, 0xcf, 0x83, 0xf7, 0x6f, 0x8f, 0x7a, 0xd0, 0x45, 0x64, 0xe0, 0x23,
+	0xd0, 0xa6, 0xd7, 0x29, 0xce, 0xb5, 0xce, 0x58, 0x99, 0x74, 0x1c, 0x75, 0xb7, 0x35, 0x7a, 0xd2,
+	0x04, 0x91, 0x36, 0x5d, 0x09, 0x43, 0x0a, 0x06, 0x38, 0x21, 0x8c, 0x11, 0x9a, 0x7a, 0x2c, 0x58,
+	0xe2, 0xb0, 0x88, 0xb1, 0x06, 0x84, 0xb1, 0x9f, 0x59, 0xf7, 0xd7, 0xdf, 0x9a, 0x57, 0xe4, 0x1f,
+	0x2a, 0xae, 0xf3, 0xf1, 0x6e, 0x6b, 0x68, 0x52, 0xf9, 0x9e, 0x90, 0xe9, 0xaa, 0xf8, 0x88, 0x6f,
+	0xfe, 0xd2, 0x04, 0xea, 0xb1, 0x08, 0xfc, 0x1a, 0xb4, 0x83, 0x22, 0x5f, 0x63, 0xb1, 0xbb, 0x67,
+	0x97, 0x0f, 0xff, 0xad, 0xf3, 0xb4, 0x24, 0xba, 0x92, 0x0f, 0x97, 0xa0, 0x17, 0xe2, 0x00, 0x6d,
+	0xbc, 0x05, 0x0a, 0x38, 0xcd, 0xc5, 0x86, 0x77, 0x9c, 0x79, 0x69, 0xe0, 0x9f, 0x5b, 0xe3, 0xd1,
+	0x7f, 0x30, 0x70, 0x86, 0x83, 0xdd, 0xd6, 0xf8, 0x50, 0x4e, 0x70, 0xa8, 0x65, 0xba, 0x5d, 0x11,
+	0x3e, 0x15, 0x11, 0x5c, 0x81, 0xbe, 0xf8, 0xd0, 0xde, 0x35, 0x26, 0xd1, 0x92, 0x33, 0xad, 0x35,
+	0x6e, 0x4d, 0x3a, 0xce, 0xd3, 0xff, 0xdd, 0x6a, 0x58, 0x99, 0x75, 0x28, 0x66, 0xba, 0x3d, 0x11,
+	0xbf, 0xac, 0xc2, 0x57, 0x0a, 0xf8, 0xe8, 0x39, 0x0d, 0x56, 0xc8, 0x8f, 0xf1, 0xac, 0x7a, 0x49,
+	0xd8, 0xb3, 0x74, 0x41, 0x21, 0x05, 0x30, 0xae, 0x00, 0xaf, 0x7e, 0x63, 0x98, 0xa6, 0x54, 0x2b,
+	0x75, 0x7c, 0x13, 0xea, 0x5a, 0xe7, 0xf3, 0xea, 0x22, 0x9c, 0xcb, 0xe6, 0xf7, 0x25, 0xcc, 0x9f,
+	0xcb, 0x0b, 0x31, 0x88, 0x8f, 0x9b, 0x7e, 0xf1, 0x12, 0xf4, 0xff, 0xe1, 0x3c, 0x04, 0xe0, 0xf4,
+	0x39, 0x49, 0x31, 0xca, 0xd5, 0x06, 0x1c, 0x02, 0x75, 0x7e, 0x93, 0xd1, 0xb4, 0xfc, 0x46, 0x28,
+	0x9e, 0x95, 0x76, 0xa9, 0x0a, 0x84, 0xe0, 0x4c, 0x32, 0x5c, 0x94, 0x64, 0x33, 0x7a, 0x9d, 0xaa,
+	0xcd, 0xb2, 0x6a, 0x5a, 0x30, 0x4e, 0x13, 0xb5, 0x35, 0x3a, 0x79, 0xf5, 0xab, 0xde, 0x70, 0xae,
+	0xde, 0xdc, 0xea, 0xca, 0xdb, 0x5b, 0x5d, 0xf9, 0xeb, 0x56, 0x57, 0x5e, 0xdf, 0xe9, 0x8d, 0xb7,
+	0x77, 0x7a, 0xe3, 0x8f, 0x3b, 0xbd, 0xf1, 0xd3, 0x57, 0x07, 0x5e, 0x56, 0x8b, 0xf0, 0x38, 0x46,
+	0x3e, 0xab, 0x03, 0x7b, 0x7d, 0xf1, 0xc4, 0xbe, 0x39, 0x7c, 0xb4, 0x85, 0xbf, 0xfe, 0xa9, 0x98,
+	0xfb, 0xcb, 0xbf, 0x07, 0x00, 0x39, 0x0a, 0x88, 0xc9, 0xd7, 0x05, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -252,6 +378,25 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionSchedule != nil {
+		{
+			size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -276,12 +421,12 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGauge(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -326,6 +471,58 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochWeights) > 0 {
+		for iNdEx := len(m.EpochWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpochWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.EpochWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Curve != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -406,6 +603,34 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.EmissionSchedule != nil {
+		l = m.EmissionSchedule.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	return n
+}
+
+func (m *EmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		n += 1 + sovGauge(uint64(m.Curve))
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.EpochWeights) > 0 {
+		for _, e := range m.EpochWeights {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -670,6 +895,213 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionSchedule == nil {
+				m.EmissionSchedule = &EmissionSchedule{}
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= EmissionCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.EpochWeights = append(m.EpochWeights, v)
+			if err := m.EpochWeights[len(m.EpochWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
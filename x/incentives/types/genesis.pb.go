@@ -30,10 +30,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the incentives module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module
-	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Gauges            []Gauge         `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
-	LockableDurations []time.Duration `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
-	LastGaugeId       uint64          `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Gauges                []Gauge                `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
+	LockableDurations     []time.Duration        `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
+	LastGaugeId           uint64                 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	RewardIndexes         []RewardIndex          `protobuf:"bytes,5,rep,name=reward_indexes,json=rewardIndexes,proto3" json:"reward_indexes" yaml:"reward_indexes"`
+	LockRewardCheckpoints []LockRewardCheckpoint `protobuf:"bytes,6,rep,name=lock_reward_checkpoints,json=lockRewardCheckpoints,proto3" json:"lock_reward_checkpoints" yaml:"lock_reward_checkpoints"`
+	// claim_based_distribution_active is true once all locks have been
+	// checkpointed after claim based distribution was enabled
+	ClaimBasedDistributionActive bool `protobuf:"varint,7,opt,name=claim_based_distribution_active,json=claimBasedDistributionActive,proto3" json:"claim_based_distribution_active,omitempty" yaml:"claim_based_distribution_active"`
+	// lock_reward_checkpoint_cursor is the next lock ID to checkpoint while
+	// claim based distribution is being activated
+	LockRewardCheckpointCursor uint64        `protobuf:"varint,8,opt,name=lock_reward_checkpoint_cursor,json=lockRewardCheckpointCursor,proto3" json:"lock_reward_checkpoint_cursor,omitempty" yaml:"lock_reward_checkpoint_cursor"`
+	ShareHolders               []ShareHolder `protobuf:"bytes,9,rep,name=share_holders,json=shareHolders,proto3" json:"share_holders" yaml:"share_holders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +106,41 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRewardIndexes() []RewardIndex {
+	if m != nil {
+		return m.RewardIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewardCheckpoints() []LockRewardCheckpoint {
+	if m != nil {
+		return m.LockRewardCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimBasedDistributionActive() bool {
+	if m != nil {
+		return m.ClaimBasedDistributionActive
+	}
+	return false
+}
+
+func (m *GenesisState) GetLockRewardCheckpointCursor() uint64 {
+	if m != nil {
+		return m.LockRewardCheckpointCursor
+	}
+	return 0
+}
+
+func (m *GenesisState) GetShareHolders() []ShareHolder {
+	if m != nil {
+		return m.ShareHolders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -104,29 +148,42 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xfb, 0x30,
-	0x10, 0xc6, 0x93, 0xb6, 0xea, 0x90, 0xfe, 0xff, 0x03, 0x16, 0x43, 0xda, 0xc1, 0x89, 0x22, 0x21,
-	0x75, 0xc1, 0x86, 0x22, 0x01, 0x62, 0xac, 0x90, 0x2a, 0xb6, 0xaa, 0x6c, 0x2c, 0x95, 0xd3, 0x1a,
-	0x63, 0x91, 0xc4, 0x55, 0xcf, 0xa9, 0xe8, 0x5b, 0x30, 0x21, 0x1e, 0xa9, 0x63, 0x47, 0xa6, 0x82,
-	0xda, 0x37, 0xe0, 0x09, 0x50, 0x9c, 0x58, 0x20, 0xb5, 0x9b, 0xcf, 0xdf, 0xef, 0xbe, 0xfb, 0xee,
-	0xbc, 0x50, 0x41, 0xaa, 0x40, 0x02, 0x95, 0xd9, 0x84, 0x67, 0x5a, 0x2e, 0x38, 0x50, 0xc1, 0x33,
-	0x0e, 0x12, 0xc8, 0x6c, 0xae, 0xb4, 0x42, 0xa8, 0x22, 0xc8, 0x2f, 0xd1, 0x39, 0x16, 0x4a, 0x28,
-	0x23, 0xd3, 0xe2, 0x55, 0x92, 0x1d, 0x2c, 0x94, 0x12, 0x09, 0xa7, 0xa6, 0x8a, 0xf3, 0x47, 0x3a,
-	0xcd, 0xe7, 0x4c, 0x4b, 0x95, 0x55, 0x7a, 0x70, 0x60, 0xd6, 0x8c, 0xcd, 0x59, 0x0a, 0xd6, 0xe0,
-	0x50, 0x18, 0x96, 0x0b, 0x5e, 0xea, 0xd1, 0x5b, 0xcd, 0xfb, 0x37, 0x28, 0xc3, 0xdd, 0x6b, 0xa6,
-	0x39, 0xba, 0xf6, 0x9a, 0xa5, 0x81, 0xef, 0x86, 0x6e, 0xb7, 0xd5, 0xeb, 0x90, 0xfd, 0xb0, 0x64,
-	0x68, 0x88, 0x7e, 0x63, 0xb5, 0x09, 0x9c, 0x51, 0xc5, 0xa3, 0x2b, 0xaf, 0x69, 0x9c, 0xc1, 0xaf,
-	0x85, 0xf5, 0x6e, 0xab, 0xd7, 0x3e, 0xd4, 0x39, 0x28, 0x08, 0xdb, 0x58, 0xe2, 0x48, 0x79, 0x28,
-	0x51, 0x93, 0x67, 0x16, 0x27, 0x7c, 0x6c, 0xf7, 0x03, 0xbf, 0x5e, 0x99, 0x94, 0x17, 0x20, 0xf6,
-	0x02, 0xe4, 0xb6, 0x22, 0xfa, 0x27, 0x85, 0xc9, 0xf7, 0x26, 0x68, 0x2f, 0x59, 0x9a, 0xdc, 0x44,
-	0xfb, 0x16, 0xd1, 0xfb, 0x67, 0xe0, 0x8e, 0x8e, 0xac, 0x60, 0x1b, 0x01, 0x45, 0xde, 0xff, 0x84,
-	0x81, 0x1e, 0x9b, 0xf9, 0x63, 0x39, 0xf5, 0x1b, 0xa1, 0xdb, 0x6d, 0x8c, 0x5a, 0xc5, 0xa7, 0x09,
-	0x78, 0x37, 0xed, 0x0f, 0x57, 0x5b, 0xec, 0xae, 0xb7, 0xd8, 0xfd, 0xda, 0x62, 0xf7, 0x75, 0x87,
-	0x9d, 0xf5, 0x0e, 0x3b, 0x1f, 0x3b, 0xec, 0x3c, 0x5c, 0x0a, 0xa9, 0x9f, 0xf2, 0x98, 0x4c, 0x54,
-	0x4a, 0xab, 0x0d, 0x4f, 0x13, 0x16, 0x83, 0x2d, 0xe8, 0xe2, 0xfc, 0x8c, 0xbe, 0xfc, 0x3d, 0xb8,
-	0x5e, 0xce, 0x38, 0xc4, 0x4d, 0xb3, 0xc2, 0xc5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0xb4,
-	0xd0, 0x4a, 0x20, 0x02, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xe3, 0xbf, 0xfd, 0x43, 0x99, 0x36, 0x48, 0x8c, 0x5a, 0xe1, 0x46, 0xad, 0x1d, 0x59,
+	0x50, 0x59, 0x48, 0xd8, 0x50, 0x24, 0x40, 0xec, 0x70, 0x2b, 0x95, 0x4a, 0x2c, 0x2a, 0x77, 0xc7,
+	0xc6, 0x1a, 0xdb, 0x83, 0x33, 0x8a, 0xe3, 0x09, 0x73, 0xed, 0xd2, 0xbe, 0x01, 0x4b, 0x96, 0x3c,
+	0x52, 0x17, 0x2c, 0xba, 0x64, 0x15, 0x50, 0xf2, 0x06, 0x79, 0x02, 0x34, 0xe3, 0x31, 0x69, 0x55,
+	0x03, 0x3b, 0x8f, 0xef, 0x77, 0xcf, 0x39, 0xf7, 0x8e, 0x8d, 0x06, 0x1c, 0xc6, 0x1c, 0x18, 0xf8,
+	0xac, 0x48, 0x68, 0x51, 0xb2, 0x33, 0x0a, 0x7e, 0x46, 0x0b, 0x0a, 0x0c, 0xbc, 0x89, 0xe0, 0x25,
+	0xc7, 0x58, 0x13, 0xde, 0x92, 0xe8, 0x6f, 0x66, 0x3c, 0xe3, 0xaa, 0xec, 0xcb, 0xa7, 0x9a, 0xec,
+	0x5b, 0x19, 0xe7, 0x59, 0x4e, 0x7d, 0x75, 0x8a, 0xab, 0x0f, 0x7e, 0x5a, 0x09, 0x52, 0x32, 0x5e,
+	0xe8, 0xba, 0xdd, 0xe2, 0x35, 0x21, 0x82, 0x8c, 0xa1, 0x11, 0x68, 0x0b, 0x43, 0xaa, 0x8c, 0xea,
+	0x7a, 0x5b, 0x58, 0x41, 0x3f, 0x11, 0x91, 0x6a, 0x05, 0xe7, 0x5b, 0x17, 0x6d, 0x1c, 0xd5, 0xf1,
+	0x4f, 0x4b, 0x52, 0x52, 0xfc, 0x0a, 0x75, 0x6b, 0x0b, 0xd3, 0x18, 0x18, 0xee, 0xfa, 0x7e, 0xdf,
+	0xbb, 0x3d, 0x8e, 0x77, 0xa2, 0x88, 0x60, 0xf5, 0x72, 0x6a, 0x77, 0x42, 0xcd, 0xe3, 0x97, 0xa8,
+	0xab, 0xbc, 0xc1, 0xfc, 0x6f, 0xb0, 0xe2, 0xae, 0xef, 0x6f, 0xb7, 0x75, 0x1e, 0x49, 0xa2, 0x69,
+	0xac, 0x71, 0xcc, 0x11, 0xce, 0x79, 0x32, 0x22, 0x71, 0x4e, 0xa3, 0x66, 0x03, 0x60, 0xae, 0x68,
+	0x91, 0x7a, 0x47, 0x5e, 0xb3, 0x23, 0xef, 0x50, 0x13, 0xc1, 0x23, 0x29, 0xb2, 0x98, 0xda, 0xdb,
+	0x17, 0x64, 0x9c, 0xbf, 0x76, 0x6e, 0x4b, 0x38, 0x5f, 0x7f, 0xd8, 0x46, 0x78, 0xbf, 0x29, 0x34,
+	0x8d, 0x80, 0x1d, 0xd4, 0xcb, 0x09, 0x94, 0x91, 0xf2, 0x8f, 0x58, 0x6a, 0xae, 0x0e, 0x0c, 0x77,
+	0x35, 0x5c, 0x97, 0x2f, 0x55, 0xc0, 0xe3, 0x14, 0x53, 0x74, 0xaf, 0xde, 0x54, 0xc4, 0x8a, 0x94,
+	0x9e, 0x53, 0x30, 0xff, 0x57, 0x81, 0xec, 0xb6, 0xa9, 0x42, 0x45, 0x1e, 0x4b, 0x30, 0xd8, 0xd5,
+	0xb1, 0xb6, 0xea, 0x58, 0x37, 0x45, 0x9c, 0xb0, 0x27, 0x96, 0x2c, 0x05, 0xfc, 0xd9, 0x40, 0x0f,
+	0x64, 0xc0, 0x48, 0x73, 0xc9, 0x90, 0x26, 0xa3, 0x09, 0x67, 0x45, 0x09, 0x66, 0x57, 0x19, 0xba,
+	0x6d, 0x86, 0xef, 0x78, 0x32, 0xaa, 0x4d, 0x0f, 0x7e, 0x37, 0x04, 0x7b, 0xda, 0xd9, 0x5a, 0x2e,
+	0xa4, 0x45, 0xd6, 0x09, 0xb7, 0xf2, 0x96, 0x6e, 0xc0, 0x1f, 0x91, 0x9d, 0xe4, 0x84, 0x8d, 0xa3,
+	0x98, 0x00, 0x4d, 0xa3, 0x94, 0x41, 0x29, 0x58, 0x5c, 0xc9, 0x95, 0x45, 0x24, 0x91, 0xbe, 0xe6,
+	0x9d, 0x81, 0xe1, 0xae, 0x05, 0x8f, 0x17, 0x53, 0x7b, 0xaf, 0xf6, 0xf8, 0x47, 0x83, 0x13, 0xee,
+	0x28, 0x22, 0x90, 0xc0, 0xe1, 0xb5, 0xfa, 0x1b, 0x55, 0xc6, 0x23, 0xb4, 0xdb, 0x9e, 0x32, 0x4a,
+	0x2a, 0x01, 0x5c, 0x98, 0x6b, 0xf2, 0x62, 0x02, 0x77, 0x31, 0xb5, 0x1f, 0xfe, 0x6d, 0x28, 0x8d,
+	0x3b, 0x61, 0xbf, 0x6d, 0xb4, 0x03, 0x55, 0xc4, 0x31, 0xea, 0xc1, 0x90, 0x08, 0x1a, 0x0d, 0x79,
+	0x9e, 0x52, 0x01, 0xe6, 0xdd, 0x3f, 0x5f, 0xe8, 0xa9, 0x04, 0xdf, 0x2a, 0x2e, 0xd8, 0xd1, 0x6b,
+	0xdd, 0xac, 0x13, 0xdc, 0xd0, 0x70, 0xc2, 0x0d, 0x58, 0xa2, 0x10, 0x9c, 0x5c, 0xce, 0x2c, 0xe3,
+	0x6a, 0x66, 0x19, 0x3f, 0x67, 0x96, 0xf1, 0x65, 0x6e, 0x75, 0xae, 0xe6, 0x56, 0xe7, 0xfb, 0xdc,
+	0xea, 0xbc, 0x7f, 0x91, 0xb1, 0x72, 0x58, 0xc5, 0x5e, 0xc2, 0xc7, 0xbe, 0x36, 0x7c, 0x92, 0x93,
+	0x18, 0x9a, 0x83, 0x7f, 0xf6, 0xec, 0xa9, 0x7f, 0x7e, 0xfd, 0x47, 0x2d, 0x2f, 0x26, 0x14, 0xe2,
+	0xae, 0xfa, 0xf0, 0x9f, 0xff, 0x1a, 0x00, 0x9c, 0xed, 0x3a, 0x13, 0x78, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -149,6 +206,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShareHolders) > 0 {
+		for iNdEx := len(m.ShareHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LockRewardCheckpointCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LockRewardCheckpointCursor))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ClaimBasedDistributionActive {
+		i--
+		if m.ClaimBasedDistributionActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for iNdEx := len(m.LockRewardCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -227,6 +341,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for _, e := range m.LockRewardCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ClaimBasedDistributionActive {
+		n += 2
+	}
+	if m.LockRewardCheckpointCursor != 0 {
+		n += 1 + sovGenesis(uint64(m.LockRewardCheckpointCursor))
+	}
+	if len(m.ShareHolders) > 0 {
+		for _, e := range m.ShareHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -385,6 +523,147 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardCheckpoints = append(m.LockRewardCheckpoints, LockRewardCheckpoint{})
+			if err := m.LockRewardCheckpoints[len(m.LockRewardCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimBasedDistributionActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimBasedDistributionActive = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardCheckpointCursor", wireType)
+			}
+			m.LockRewardCheckpointCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockRewardCheckpointCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareHolders = append(m.ShareHolders, ShareHolder{})
+			if err := m.ShareHolders[len(m.ShareHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
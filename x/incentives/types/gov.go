@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeCancelGauge = "CancelGauge"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelGauge)
+	govtypes.RegisterProposalTypeCodec(&CancelGaugeProposal{}, "osmosis/CancelGaugeProposal")
+}
+
+var _ govtypes.Content = &CancelGaugeProposal{}
+
+func NewCancelGaugeProposal(title, description string, gaugeId uint64) govtypes.Content {
+	return &CancelGaugeProposal{
+		Title:       title,
+		Description: description,
+		GaugeId:     gaugeId,
+	}
+}
+
+func (p *CancelGaugeProposal) GetTitle() string { return p.Title }
+
+func (p *CancelGaugeProposal) GetDescription() string { return p.Description }
+
+func (p *CancelGaugeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CancelGaugeProposal) ProposalType() string {
+	return ProposalTypeCancelGauge
+}
+
+func (p *CancelGaugeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.GaugeId == 0 {
+		return errors.New("gauge id should be set")
+	}
+	return nil
+}
+
+func (p CancelGaugeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Gauge Proposal:
+  Title:       %s
+  Description: %s
+  Gauge ID:    %d
+`, p.Title, p.Description, p.GaugeId))
+	return b.String()
+}
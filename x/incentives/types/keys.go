@@ -40,6 +40,23 @@ var (
 	// KeyPrefixGaugesByDenom defines prefix key for storing indexes of gauge IDs by denomination.
 	KeyPrefixGaugesByDenom = []byte{0x05}
 
+	// KeyPrefixRewardIndex defines prefix key for storing reward indexes by denom and duration.
+	KeyPrefixRewardIndex = []byte{0x08}
+
+	// KeyPrefixLockRewardCheckpoint defines prefix key for storing reward checkpoints of locks.
+	KeyPrefixLockRewardCheckpoint = []byte{0x09}
+
+	// KeyLockRewardCheckpointCursor defines key for storing the next lock ID to checkpoint while claim based
+	// distribution is being activated.
+	KeyLockRewardCheckpointCursor = []byte{0x0A}
+
+	// KeyClaimBasedDistributionActive defines key for marking claim based distribution as active, once all
+	// locks have been checkpointed.
+	KeyClaimBasedDistributionActive = []byte{0x0B}
+
+	// KeyPrefixShareHolder defines prefix key for storing the pool share holders of a denom rewarded by no lock gauges.
+	KeyPrefixShareHolder = []byte{0x0C}
+
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
@@ -50,3 +67,7 @@ var (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// LockRewardCheckpointsPerEpoch is the maximum number of lock IDs checkpointed at the end of an epoch
+// while claim based distribution is being activated.
+const LockRewardCheckpointsPerEpoch uint64 = 1000
@@ -2,8 +2,10 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,8 +13,10 @@ import (
 
 // constants.
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
+	TypeMsgCreateGauge  = "create_gauge"
+	TypeMsgAddToGauge   = "add_to_gauge"
+	TypeMsgCancelGauge  = "cancel_gauge"
+	TypeMsgClaimRewards = "claim_rewards"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -51,13 +55,51 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.EmissionSchedule != nil {
+		if m.IsPerpetual {
+			return errors.New("emission schedule is not supported for perpetual gauge")
+		}
+		if err := m.EmissionSchedule.Validate(m.NumEpochsPaidOver); err != nil {
+			return err
+		}
+	}
+
+	if m.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		if m.DistributeTo.Timestamp.Equal(time.Time{}) {
+			return errors.New("lock timestamp should be set for time query condition")
+		}
+		// synthetic lockups are only indexed by duration
+		if lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+			return errors.New("time query condition is not supported for synthetic denoms")
+		}
+	}
+
+	if m.DistributeTo.LockQueryType == lockuptypes.NoLock {
+		// synthetic denoms only exist as lockups
+		if lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+			return errors.New("no lock query condition is not supported for synthetic denoms")
+		}
+		if m.DistributeTo.Duration != 0 {
+			return errors.New("lock duration should not be set for no lock query condition")
+		}
+		// share holders are tracked through pool joins and exits
+		if !isPoolShareDenom(m.DistributeTo.Denom) {
+			return errors.New("no lock query condition is only supported for pool share denoms")
+		}
 	}
 
 	return nil
 }
 
+// isPoolShareDenom returns true if the given denom is the share denom of a pool.
+func isPoolShareDenom(denom string) bool {
+	var poolId uint64
+	if _, err := fmt.Sscanf(denom, "gamm/pool/%d", &poolId); err != nil {
+		return false
+	}
+	return gammtypes.GetPoolShareDenom(poolId) == denom
+}
+
 func (m MsgCreateGauge) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
@@ -99,3 +141,69 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel a gauge.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeId uint64) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:   owner.String(),
+		GaugeId: gaugeId,
+	}
+}
+
+func (m MsgCancelGauge) Route() string { return RouterKey }
+func (m MsgCancelGauge) Type() string  { return TypeMsgCancelGauge }
+func (m MsgCancelGauge) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if m.GaugeId == 0 {
+		return errors.New("gauge id should be set")
+	}
+
+	return nil
+}
+
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the rewards of locks.
+func NewMsgClaimRewards(owner sdk.AccAddress, lockIds []uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner:   owner.String(),
+		LockIds: lockIds,
+	}
+}
+
+func (m MsgClaimRewards) Route() string { return RouterKey }
+func (m MsgClaimRewards) Type() string  { return TypeMsgClaimRewards }
+func (m MsgClaimRewards) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	for _, lockId := range m.LockIds {
+		if lockId == 0 {
+			return errors.New("lock id should be set")
+		}
+	}
+
+	return nil
+}
+
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
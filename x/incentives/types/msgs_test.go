@@ -97,6 +97,73 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "proper time query condition",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now().Add(time.Hour)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty time query condition timestamp",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Time{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "time query condition for synthetic denom",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Denom = "lptoken/superbonding"
+				msg.DistributeTo.Timestamp = time.Now().Add(time.Hour)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "proper no lock query condition",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = "gamm/pool/1"
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no lock query condition for non pool share denom",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no lock query condition with duration",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = "gamm/pool/1"
+				msg.DistributeTo.Duration = time.Hour
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no lock query condition for synthetic denom",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = "lptoken/superbonding"
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid distribution start time",
 			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
@@ -131,6 +198,56 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "proper exponential decay emission schedule",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.EmissionSchedule = &EmissionSchedule{Curve: ExponentialDecay, DecayFactor: sdk.NewDecWithPrec(5, 1)}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "exponential decay emission schedule with decay factor above one",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.EmissionSchedule = &EmissionSchedule{Curve: ExponentialDecay, DecayFactor: sdk.NewDec(2)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "proper custom emission schedule",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.EmissionSchedule = &EmissionSchedule{Curve: Custom, EpochWeights: []sdk.Dec{sdk.NewDec(3), sdk.NewDec(1)}}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "custom emission schedule with a weight per epoch missing",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.EmissionSchedule = &EmissionSchedule{Curve: Custom, EpochWeights: []sdk.Dec{sdk.NewDec(3)}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "custom emission schedule with a zero last weight",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.EmissionSchedule = &EmissionSchedule{Curve: Custom, EpochWeights: []sdk.Dec{sdk.NewDec(3), sdk.ZeroDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "emission schedule for perpetual gauge",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.NumEpochsPaidOver = 1
+				msg.IsPerpetual = true
+				msg.EmissionSchedule = &EmissionSchedule{Curve: LinearRampDown}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -204,3 +321,61 @@ func TestMsgAddToGauge(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCancelGauge(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	createMsg := func(after func(msg MsgCancelGauge) MsgCancelGauge) MsgCancelGauge {
+		properMsg := *NewMsgCancelGauge(addr1, 1)
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "cancel_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        MsgCancelGauge
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty gauge id",
+			msg: createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+				msg.GaugeId = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
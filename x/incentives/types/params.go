@@ -1,14 +1,22 @@
 package types
 
 import (
+	"fmt"
+
+	appparams "github.com/osmosis-labs/osmosis/v10/app/params"
 	epochtypes "github.com/osmosis-labs/osmosis/v10/x/epochs/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
+	KeyDistrEpochIdentifier   = []byte("DistrEpochIdentifier")
+	KeyClaimBasedDistribution = []byte("ClaimBasedDistribution")
+	KeyGaugeCreationFee       = []byte("GaugeCreationFee")
+	KeyAdditionalDenomFee     = []byte("AdditionalDenomFee")
+	KeyMinGaugeValue          = []byte("MinGaugeValue")
 )
 
 // ParamTable for minting module.
@@ -16,16 +24,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(distrEpochIdentifier string) Params {
+func NewParams(distrEpochIdentifier string, claimBasedDistribution bool, gaugeCreationFee, additionalDenomFee sdk.Coins, minGaugeValue sdk.Int) Params {
 	return Params{
-		DistrEpochIdentifier: distrEpochIdentifier,
+		DistrEpochIdentifier:   distrEpochIdentifier,
+		ClaimBasedDistribution: claimBasedDistribution,
+		GaugeCreationFee:       gaugeCreationFee,
+		AdditionalDenomFee:     additionalDenomFee,
+		MinGaugeValue:          minGaugeValue,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		DistrEpochIdentifier: "week",
+		DistrEpochIdentifier:   "week",
+		ClaimBasedDistribution: false,
+		GaugeCreationFee:       sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 50_000_000)}, // 50 OSMO
+		AdditionalDenomFee:     sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000)}, // 10 OSMO
+		MinGaugeValue:          sdk.ZeroInt(),
 	}
 }
 
@@ -34,6 +50,54 @@ func (p Params) Validate() error {
 	if err := epochtypes.ValidateEpochIdentifierInterface(p.DistrEpochIdentifier); err != nil {
 		return err
 	}
+	if err := validateClaimBasedDistribution(p.ClaimBasedDistribution); err != nil {
+		return err
+	}
+	if err := validateFee(p.GaugeCreationFee); err != nil {
+		return err
+	}
+	if err := validateFee(p.AdditionalDenomFee); err != nil {
+		return err
+	}
+	if err := validateMinGaugeValue(p.MinGaugeValue); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateClaimBasedDistribution(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return fmt.Errorf("invalid fee: %+v", i)
+	}
+
+	return nil
+}
+
+func validateMinGaugeValue(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset value is stored as zero
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("min gauge value must be non-negative: %s", v)
+	}
+
 	return nil
 }
 
@@ -41,5 +105,9 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyClaimBasedDistribution, &p.ClaimBasedDistribution, validateClaimBasedDistribution),
+		paramtypes.NewParamSetPair(KeyGaugeCreationFee, &p.GaugeCreationFee, validateFee),
+		paramtypes.NewParamSetPair(KeyAdditionalDenomFee, &p.AdditionalDenomFee, validateFee),
+		paramtypes.NewParamSetPair(KeyMinGaugeValue, &p.MinGaugeValue, validateMinGaugeValue),
 	}
 }
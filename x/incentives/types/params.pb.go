@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +29,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// distribution epoch identifier
 	DistrEpochIdentifier string `protobuf:"bytes,1,opt,name=distr_epoch_identifier,json=distrEpochIdentifier,proto3" json:"distr_epoch_identifier,omitempty" yaml:"distr_epoch_identifier"`
+	// when enabled, gauges distributing to native lock durations accrue their
+	// rewards into per share indexes that lock owners claim, instead of sending
+	// the rewards to every lock on each epoch
+	ClaimBasedDistribution bool `protobuf:"varint,2,opt,name=claim_based_distribution,json=claimBasedDistribution,proto3" json:"claim_based_distribution,omitempty" yaml:"claim_based_distribution"`
+	// fee charged for creating a gauge with MsgCreateGauge, sent to the
+	// community pool
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee" yaml:"gauge_creation_fee"`
+	// fee charged on top of the gauge creation fee for each reward denom of
+	// a gauge beyond the first, sent to the community pool
+	AdditionalDenomFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=additional_denom_fee,json=additionalDenomFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_denom_fee" yaml:"additional_denom_fee"`
+	// minimum value of the coins a gauge is created with, in the txfees base
+	// denom. Coins that aren't fee tokens don't count towards it.
+	MinGaugeValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_gauge_value,json=minGaugeValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_gauge_value" yaml:"min_gauge_value"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +84,27 @@ func (m *Params) GetDistrEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetClaimBasedDistribution() bool {
+	if m != nil {
+		return m.ClaimBasedDistribution
+	}
+	return false
+}
+
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetAdditionalDenomFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalDenomFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -76,20 +112,36 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a,
-	0x89, 0x5c, 0x6c, 0x01, 0x60, 0x9d, 0x42, 0xe1, 0x5c, 0x62, 0x29, 0x99, 0xc5, 0x25, 0x45, 0xf1,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0x20, 0x8d, 0x69, 0x99, 0xa9, 0x45, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x8a, 0x9f, 0xee, 0xc9, 0xcb, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0x61, 0x57, 0xa7, 0x14, 0x24, 0x02, 0x96, 0x70, 0x05, 0x89, 0x7b, 0xc2, 0x85, 0x9d, 0x02, 0x4e,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x62, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf,
-	0xcc, 0xd0, 0x40, 0xbf, 0x02, 0xd9, 0x97, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb7,
-	0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xad, 0x8e, 0x18, 0x08, 0x01, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x6b, 0x36, 0x26, 0x08, 0x42, 0x20, 0xab, 0xaa, 0xb2, 0x21, 0xe2, 0x12, 0x24, 0xd4,
+	0xcb, 0x62, 0x0a, 0x12, 0x07, 0x8e, 0xd9, 0xf8, 0xb3, 0x03, 0x62, 0xea, 0x01, 0x24, 0x24, 0x64,
+	0x39, 0x89, 0x97, 0x59, 0xc4, 0x76, 0x14, 0xbb, 0x15, 0xfb, 0x16, 0x9c, 0x38, 0xf1, 0x09, 0xf8,
+	0x24, 0x3b, 0xee, 0x88, 0x38, 0x04, 0xd4, 0xde, 0x38, 0xf6, 0x13, 0x20, 0xdb, 0x81, 0x55, 0x50,
+	0x04, 0x9c, 0x92, 0xf7, 0x7d, 0x9e, 0xfc, 0xde, 0x27, 0x6f, 0x9c, 0x00, 0x29, 0x2d, 0x94, 0xe6,
+	0x1a, 0x73, 0x99, 0x33, 0x69, 0xf8, 0x8c, 0x69, 0x5c, 0xd3, 0x86, 0x0a, 0x9d, 0xd4, 0x8d, 0x32,
+	0x0a, 0xc2, 0xce, 0x90, 0x9c, 0x1b, 0x76, 0xfa, 0xa5, 0x2a, 0x95, 0x93, 0xb1, 0xbd, 0xf3, 0xce,
+	0x9d, 0x28, 0x77, 0x56, 0x9c, 0x51, 0xcd, 0xf0, 0x6c, 0x9c, 0x31, 0x43, 0xc7, 0x38, 0x57, 0x5c,
+	0x7a, 0x3d, 0xfe, 0xb6, 0x19, 0x6c, 0x1d, 0x3a, 0x34, 0x7c, 0x19, 0x0c, 0x0a, 0xae, 0x4d, 0x43,
+	0x58, 0xad, 0xf2, 0x63, 0xc2, 0x0b, 0x4b, 0x3e, 0xe2, 0xac, 0x09, 0xc1, 0x10, 0x8c, 0x2e, 0xa7,
+	0xb7, 0x96, 0x2d, 0xba, 0x79, 0x42, 0x45, 0xf5, 0x30, 0x5e, 0xef, 0x8b, 0x27, 0x7d, 0x27, 0x3c,
+	0xb2, 0xfd, 0x83, 0x9f, 0x6d, 0xf8, 0x3a, 0x08, 0xf3, 0x8a, 0x72, 0x41, 0x6c, 0x88, 0x82, 0x38,
+	0x0f, 0xcf, 0xa6, 0x86, 0x2b, 0x19, 0x5e, 0x18, 0x82, 0xd1, 0xa5, 0xf4, 0xf6, 0xb2, 0x45, 0xc8,
+	0xa3, 0xff, 0xe4, 0x8c, 0x27, 0x03, 0x27, 0xa5, 0x56, 0xd9, 0x5f, 0x11, 0xe0, 0x7b, 0x10, 0xc0,
+	0x92, 0x4e, 0x4b, 0x46, 0xf2, 0x86, 0x51, 0xdb, 0x22, 0x47, 0x8c, 0x85, 0x1b, 0xc3, 0x8d, 0xd1,
+	0x95, 0x7b, 0xdb, 0x89, 0x5f, 0x40, 0x62, 0x89, 0x49, 0xb7, 0x80, 0x64, 0x4f, 0x71, 0x99, 0x3e,
+	0x3b, 0x6d, 0x51, 0x6f, 0xd9, 0xa2, 0x6d, 0x3f, 0xf8, 0x77, 0x44, 0xfc, 0xf1, 0x0b, 0x1a, 0x95,
+	0xdc, 0x1c, 0x4f, 0xb3, 0x24, 0x57, 0x02, 0x77, 0xab, 0xf4, 0x97, 0x5d, 0x5d, 0xbc, 0xc1, 0xe6,
+	0xa4, 0x66, 0xda, 0xd1, 0xf4, 0xe4, 0xba, 0x03, 0xec, 0x75, 0xcf, 0x3f, 0x66, 0x0c, 0x7e, 0x00,
+	0x41, 0x9f, 0x16, 0x05, 0xb7, 0x35, 0xad, 0x48, 0xc1, 0xa4, 0x12, 0x2e, 0xda, 0xe6, 0xdf, 0xa2,
+	0x3d, 0xef, 0xa2, 0xdd, 0xf0, 0xd1, 0xd6, 0x41, 0xfe, 0x2f, 0x1c, 0x3c, 0x47, 0xec, 0x5b, 0x82,
+	0x8d, 0x57, 0x07, 0xd7, 0x04, 0x97, 0xc4, 0xbf, 0xf7, 0x8c, 0x56, 0x53, 0x16, 0x5e, 0x74, 0x1f,
+	0xfa, 0xa9, 0x9d, 0xfe, 0xb9, 0x45, 0x77, 0xfe, 0x01, 0x7f, 0x20, 0xcd, 0xb2, 0x45, 0x03, 0x9f,
+	0xf3, 0x17, 0x5c, 0x3c, 0xb9, 0x2a, 0xb8, 0x7c, 0x62, 0x1b, 0x2f, 0x6c, 0x9d, 0x1e, 0x9e, 0xce,
+	0x23, 0x70, 0x36, 0x8f, 0xc0, 0xd7, 0x79, 0x04, 0xde, 0x2d, 0xa2, 0xde, 0xd9, 0x22, 0xea, 0x7d,
+	0x5a, 0x44, 0xbd, 0x57, 0x0f, 0x56, 0x46, 0x75, 0x67, 0x7b, 0xb7, 0xa2, 0x99, 0xfe, 0x51, 0xe0,
+	0xd9, 0xf8, 0x2e, 0x7e, 0xbb, 0xfa, 0x3f, 0xb8, 0xf1, 0xd9, 0x96, 0x3b, 0xc5, 0xf7, 0xbf, 0x0f,
+	0x00, 0xea, 0xd7, 0xd9, 0x2e, 0x32, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +164,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinGaugeValue.Size()
+		i -= size
+		if _, err := m.MinGaugeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AdditionalDenomFee) > 0 {
+		for iNdEx := len(m.AdditionalDenomFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalDenomFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ClaimBasedDistribution {
+		i--
+		if m.ClaimBasedDistribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.DistrEpochIdentifier) > 0 {
 		i -= len(m.DistrEpochIdentifier)
 		copy(dAtA[i:], m.DistrEpochIdentifier)
@@ -143,6 +243,23 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.ClaimBasedDistribution {
+		n += 2
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AdditionalDenomFee) > 0 {
+		for _, e := range m.AdditionalDenomFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinGaugeValue.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -213,6 +330,128 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DistrEpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimBasedDistribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimBasedDistribution = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDenomFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalDenomFee = append(m.AdditionalDenomFee, types.Coin{})
+			if err := m.AdditionalDenomFee[len(m.AdditionalDenomFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGaugeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGaugeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
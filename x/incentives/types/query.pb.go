@@ -973,6 +973,94 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type ClaimableRewardsRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *ClaimableRewardsRequest) Reset()         { *m = ClaimableRewardsRequest{} }
+func (m *ClaimableRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimableRewardsRequest) ProtoMessage()    {}
+func (*ClaimableRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{20}
+}
+func (m *ClaimableRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableRewardsRequest.Merge(m, src)
+}
+func (m *ClaimableRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableRewardsRequest proto.InternalMessageInfo
+
+func (m *ClaimableRewardsRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type ClaimableRewardsResponse struct {
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *ClaimableRewardsResponse) Reset()         { *m = ClaimableRewardsResponse{} }
+func (m *ClaimableRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimableRewardsResponse) ProtoMessage()    {}
+func (*ClaimableRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{21}
+}
+func (m *ClaimableRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableRewardsResponse.Merge(m, src)
+}
+func (m *ClaimableRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableRewardsResponse proto.InternalMessageInfo
+
+func (m *ClaimableRewardsResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -994,82 +1082,88 @@ func init() {
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*ClaimableRewardsRequest)(nil), "osmosis.incentives.ClaimableRewardsRequest")
+	proto.RegisterType((*ClaimableRewardsResponse)(nil), "osmosis.incentives.ClaimableRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0x33, 0x4e, 0xdc, 0x5f, 0xf3, 0xfc, 0xda, 0xd0, 0x0c, 0xa1, 0x24, 0x6e, 0xbb, 0x36,
-	0xab, 0x36, 0x75, 0x53, 0xb2, 0x1b, 0xdb, 0x6d, 0x8a, 0xf8, 0x27, 0x61, 0x5c, 0x4a, 0x25, 0x90,
-	0xc2, 0x0a, 0x84, 0x84, 0x84, 0x56, 0x6b, 0xef, 0xb0, 0x5d, 0xc5, 0xde, 0x71, 0x3d, 0xbb, 0x09,
-	0x56, 0x94, 0x0b, 0x70, 0xae, 0x40, 0x44, 0x15, 0x87, 0xbe, 0x02, 0x8e, 0x20, 0x71, 0x83, 0x03,
-	0xa7, 0x1e, 0x2b, 0x71, 0xe1, 0x94, 0xa2, 0x84, 0x57, 0xd0, 0x57, 0x80, 0x76, 0x66, 0xd6, 0x7f,
-	0xd7, 0x6b, 0x07, 0xd1, 0x28, 0x27, 0x67, 0xf2, 0xfc, 0xfb, 0x3c, 0x8f, 0xc7, 0xf3, 0x7d, 0x40,
-	0xa1, 0xac, 0x41, 0x99, 0xcb, 0x74, 0xd7, 0xab, 0x11, 0xcf, 0x77, 0xb7, 0x08, 0xd3, 0xef, 0x07,
-	0xa4, 0xd5, 0xd6, 0x9a, 0x2d, 0xea, 0x53, 0x8c, 0xa5, 0x5d, 0xeb, 0xda, 0x33, 0x0b, 0x0e, 0x75,
-	0x28, 0x37, 0xeb, 0xe1, 0x5f, 0xc2, 0x33, 0x73, 0xd1, 0xa1, 0xd4, 0xa9, 0x13, 0xdd, 0x6a, 0xba,
-	0xba, 0xe5, 0x79, 0xd4, 0xb7, 0x7c, 0x97, 0x7a, 0x4c, 0x5a, 0x15, 0x69, 0xe5, 0xa7, 0x6a, 0xf0,
-	0x85, 0x6e, 0x07, 0x2d, 0xee, 0x10, 0xd9, 0x6b, 0xbc, 0x90, 0x5e, 0xb5, 0x18, 0xd1, 0xb7, 0x0a,
-	0x55, 0xe2, 0x5b, 0x05, 0xbd, 0x46, 0xdd, 0xc8, 0xbe, 0xd2, 0x6b, 0xe7, 0x80, 0x1d, 0xaf, 0xa6,
-	0xe5, 0xb8, 0x5e, 0x5f, 0xae, 0x98, 0x9e, 0x1c, 0x2b, 0x70, 0x88, 0xb4, 0x2f, 0x45, 0xf6, 0x3a,
-	0xad, 0x6d, 0x06, 0x4d, 0xfe, 0x21, 0x4c, 0x6a, 0x0e, 0x94, 0x0f, 0xa9, 0x1d, 0xd4, 0xc9, 0xc7,
-	0xb4, 0xe2, 0x32, 0xbf, 0xe5, 0x56, 0x03, 0x9f, 0xbc, 0x4b, 0x5d, 0x8f, 0x19, 0xe4, 0x7e, 0x40,
-	0x98, 0xaf, 0x7e, 0x83, 0x20, 0x3b, 0xd2, 0x85, 0x35, 0xa9, 0xc7, 0x08, 0xb6, 0x20, 0x1d, 0xa2,
-	0xb3, 0x45, 0x94, 0x9b, 0xce, 0xff, 0xbf, 0xb8, 0xa4, 0x09, 0x78, 0x2d, 0x84, 0xd7, 0x24, 0xb6,
-	0x16, 0x86, 0x94, 0xd7, 0x1e, 0xef, 0x67, 0xa7, 0x7e, 0x7c, 0x9a, 0xcd, 0x3b, 0xae, 0x7f, 0x2f,
-	0xa8, 0x6a, 0x35, 0xda, 0xd0, 0x65, 0xa7, 0xe2, 0x63, 0x95, 0xd9, 0x9b, 0xba, 0xdf, 0x6e, 0x12,
-	0xa6, 0x89, 0x1a, 0x22, 0xb3, 0x9a, 0x85, 0x4b, 0x82, 0xa2, 0xcb, 0x60, 0xf7, 0x71, 0x7e, 0x8d,
-	0xa2, 0x56, 0x86, 0x3d, 0x8e, 0x0f, 0x53, 0x85, 0x73, 0x77, 0xc2, 0xc9, 0x97, 0xdb, 0x77, 0x2b,
-	0x92, 0x0c, 0xcf, 0x41, 0xca, 0xb5, 0x17, 0x51, 0x0e, 0xe5, 0x67, 0x8c, 0x94, 0x6b, 0xab, 0x15,
-	0x98, 0xef, 0xf1, 0x91, 0x6c, 0x3a, 0xa4, 0xf9, 0x57, 0xc6, 0xfd, 0x42, 0xb6, 0xe1, 0x7b, 0xa8,
-	0xf1, 0x28, 0x43, 0xf8, 0xa9, 0x9f, 0xc2, 0x59, 0x7e, 0x8e, 0x06, 0x80, 0xdf, 0x03, 0xe8, 0xde,
-	0x0c, 0x99, 0x66, 0xb9, 0xaf, 0x45, 0x71, 0xcf, 0xa3, 0x46, 0x37, 0x2c, 0x87, 0xc8, 0x58, 0xa3,
-	0x27, 0x52, 0x7d, 0x80, 0x60, 0x2e, 0xca, 0x2c, 0xe1, 0x4a, 0x30, 0x63, 0x5b, 0xbe, 0xd5, 0x99,
-	0xdb, 0x28, 0xb6, 0xf2, 0x4c, 0x38, 0x37, 0x83, 0x3b, 0xe3, 0x3b, 0x7d, 0x3c, 0x29, 0xce, 0x73,
-	0x75, 0x2c, 0x8f, 0xa8, 0xd8, 0x07, 0xf4, 0x39, 0xbc, 0xf8, 0x4e, 0x2d, 0xac, 0xf2, 0x7c, 0xfa,
-	0xdd, 0x43, 0xb0, 0xd0, 0x9f, 0xff, 0x44, 0x74, 0xbd, 0x03, 0x17, 0x7a, 0xa9, 0x36, 0x48, 0xab,
-	0x42, 0x3c, 0xda, 0x88, 0xba, 0x5f, 0x80, 0xb4, 0x1d, 0x9e, 0x79, 0xe3, 0xb3, 0x86, 0x38, 0x0c,
-	0xcc, 0x24, 0xf5, 0xaf, 0x67, 0xf2, 0x08, 0xc1, 0xc5, 0xf8, 0xea, 0x27, 0x62, 0x36, 0x26, 0xbc,
-	0xf4, 0x49, 0xb3, 0x46, 0x1b, 0xae, 0xe7, 0x3c, 0x9f, 0x3b, 0xf1, 0x10, 0xc1, 0xf9, 0xc1, 0x0a,
-	0x27, 0xa2, 0xf3, 0x5d, 0xb8, 0xd4, 0xcf, 0x75, 0xbc, 0xf7, 0xe2, 0x67, 0x04, 0xca, 0xa8, 0xfa,
-	0x72, 0x3e, 0xef, 0xc3, 0x0b, 0x81, 0xf4, 0x30, 0xf9, 0x4b, 0xc5, 0x26, 0x1d, 0xd5, 0x5c, 0xd0,
-	0x97, 0xf9, 0xbf, 0x1b, 0x1a, 0x83, 0x79, 0x83, 0x6c, 0x5b, 0x2d, 0x9b, 0xdd, 0x66, 0x7e, 0x34,
-	0xa8, 0x65, 0x48, 0xd3, 0x6d, 0x8f, 0xb4, 0xc4, 0xa0, 0xca, 0xe7, 0x9e, 0xed, 0x67, 0xcf, 0xb4,
-	0xad, 0x46, 0xfd, 0x75, 0x95, 0xff, 0x5b, 0x35, 0x84, 0x19, 0x2f, 0xc1, 0xe9, 0x50, 0x2f, 0x4d,
-	0xd7, 0x66, 0x8b, 0xa9, 0xdc, 0x74, 0x7e, 0xc6, 0xf8, 0x5f, 0x78, 0xbe, 0x6b, 0x33, 0x7c, 0x01,
-	0x66, 0x89, 0x67, 0x9b, 0xa4, 0x49, 0x6b, 0xf7, 0x16, 0xa7, 0x73, 0x28, 0x3f, 0x6d, 0x9c, 0x26,
-	0x9e, 0x7d, 0x3b, 0x3c, 0xab, 0xdb, 0x80, 0x7b, 0x8b, 0x1e, 0xab, 0x52, 0x7e, 0x14, 0xce, 0xe5,
-	0x03, 0x5a, 0xdb, 0xb4, 0xaa, 0x75, 0x52, 0x91, 0x8b, 0x47, 0x47, 0x29, 0xbf, 0x43, 0xa0, 0x8c,
-	0xf2, 0x90, 0x98, 0x14, 0x70, 0x5d, 0x1a, 0xcd, 0x68, 0x71, 0xe9, 0x32, 0x8b, 0xd5, 0x46, 0x8b,
-	0x56, 0x1b, 0x2d, 0x8a, 0x2f, 0x5f, 0x09, 0x99, 0x9f, 0xed, 0x67, 0x97, 0xc4, 0x20, 0x87, 0x53,
-	0xa8, 0x3f, 0x3c, 0xcd, 0x22, 0x63, 0xbe, 0x3e, 0x58, 0xb8, 0xf8, 0xf0, 0x2c, 0xa4, 0x39, 0x13,
-	0xfe, 0x1d, 0xc1, 0xcb, 0x23, 0xf6, 0x0d, 0x5c, 0x8c, 0xbb, 0x42, 0xc9, 0xfb, 0x4b, 0xa6, 0x74,
-	0xa4, 0x18, 0xd1, 0xbf, 0xfa, 0xf6, 0x57, 0x7f, 0xfc, 0xfd, 0x7d, 0xea, 0x35, 0xbc, 0xae, 0xc7,
-	0xac, 0x56, 0xd1, 0x1e, 0xd6, 0xe0, 0x49, 0x4c, 0x9f, 0x9a, 0x76, 0x27, 0x8d, 0xc9, 0xbf, 0x03,
-	0xfc, 0x2b, 0x82, 0xf3, 0xf1, 0xcb, 0x08, 0x2e, 0x8c, 0xe6, 0x19, 0xb1, 0xda, 0x64, 0x8a, 0x47,
-	0x09, 0x91, 0x1d, 0xbc, 0xc9, 0x3b, 0x58, 0xc7, 0x37, 0x26, 0xe8, 0xa0, 0x8b, 0x6f, 0x4b, 0xfe,
-	0x07, 0x08, 0x66, 0x3b, 0x3b, 0x0a, 0xbe, 0x3c, 0xfa, 0x97, 0xdb, 0x5d, 0x73, 0x32, 0x57, 0xc6,
-	0x78, 0x49, 0xb0, 0x1b, 0x1c, 0x4c, 0xc3, 0xaf, 0x26, 0x81, 0xf1, 0x87, 0xc3, 0xac, 0xb6, 0x4d,
-	0xd7, 0xd6, 0x77, 0x5c, 0x7b, 0x17, 0xef, 0xc0, 0x29, 0xf9, 0x2a, 0xbc, 0x32, 0xb2, 0x4c, 0x67,
-	0x5e, 0x6a, 0x92, 0x8b, 0xc4, 0x58, 0xe1, 0x18, 0x97, 0xb1, 0x3a, 0x16, 0x83, 0xe1, 0x3d, 0x04,
-	0x67, 0x7a, 0xd5, 0x10, 0x5f, 0x8d, 0x2b, 0x10, 0xb3, 0xa3, 0x64, 0xf2, 0xe3, 0x1d, 0x25, 0x4f,
-	0x81, 0xf3, 0x5c, 0xc7, 0xd7, 0x92, 0x78, 0x2c, 0x1e, 0x29, 0x9f, 0x55, 0xfc, 0xcb, 0xc0, 0xe2,
-	0x12, 0x3d, 0xc5, 0x58, 0x1f, 0x57, 0x75, 0x40, 0x34, 0x32, 0x6b, 0x93, 0x07, 0x48, 0xdc, 0x37,
-	0x38, 0xee, 0x4d, 0x5c, 0x9a, 0x18, 0xd7, 0x6c, 0x92, 0x96, 0x29, 0xd4, 0xe8, 0x11, 0x82, 0xb9,
-	0x7e, 0x15, 0xc1, 0xd7, 0xe2, 0x08, 0x62, 0x35, 0x3e, 0xb3, 0x32, 0x89, 0xab, 0xc4, 0x2c, 0x71,
-	0xcc, 0x55, 0x7c, 0x3d, 0x09, 0x73, 0x40, 0xae, 0xf0, 0x6f, 0x43, 0xe2, 0xdf, 0x99, 0x6c, 0x61,
-	0x7c, 0xed, 0xc1, 0xd9, 0x16, 0x8f, 0x12, 0x22, 0xb1, 0xdf, 0xe2, 0xd8, 0xb7, 0xf0, 0xcd, 0x23,
-	0x60, 0xf7, 0xcc, 0x77, 0x0f, 0x01, 0x74, 0xb5, 0x07, 0xc7, 0xfe, 0x30, 0x87, 0x04, 0x31, 0xb3,
-	0x3c, 0xce, 0x4d, 0xc2, 0xdd, 0xe2, 0x70, 0x05, 0xac, 0x27, 0xc1, 0xb5, 0x44, 0x9c, 0x49, 0x98,
-	0xaf, 0xef, 0x70, 0x21, 0xdd, 0xc5, 0x3f, 0x21, 0x98, 0x1f, 0x92, 0x9c, 0xf8, 0x91, 0x26, 0x0a,
-	0x58, 0xfc, 0x48, 0x93, 0x15, 0x4d, 0x5d, 0xe7, 0xd4, 0x6b, 0x58, 0x4b, 0xa2, 0x1e, 0x16, 0xac,
-	0xf2, 0xc6, 0xe3, 0x03, 0x05, 0x3d, 0x39, 0x50, 0xd0, 0x5f, 0x07, 0x0a, 0xfa, 0xf6, 0x50, 0x99,
-	0x7a, 0x72, 0xa8, 0x4c, 0xfd, 0x79, 0xa8, 0x4c, 0x7d, 0xb6, 0xde, 0x23, 0xcc, 0x32, 0xe7, 0x6a,
-	0xdd, 0xaa, 0xb2, 0x4e, 0x81, 0xad, 0xc2, 0x9a, 0xfe, 0x65, 0x6f, 0x19, 0x2e, 0xd6, 0xd5, 0x53,
-	0x5c, 0x37, 0x4b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x42, 0x98, 0x1e, 0x3a, 0x8a, 0x10, 0x00,
-	0x00,
+	// 1171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4b, 0x6f, 0xdc, 0x54,
+	0x14, 0xc7, 0x73, 0xf3, 0x68, 0x9b, 0x43, 0x09, 0xc9, 0x25, 0x34, 0x89, 0xdb, 0x7a, 0x82, 0xd5,
+	0xa6, 0xd3, 0xa4, 0xb1, 0x33, 0x93, 0x36, 0xe1, 0x8d, 0x98, 0xa6, 0x2d, 0x95, 0x40, 0x0a, 0x16,
+	0x08, 0x09, 0x09, 0x59, 0x1e, 0xfb, 0xe2, 0x5a, 0x99, 0xf1, 0x9d, 0xce, 0xb5, 0x13, 0xa2, 0x68,
+	0x36, 0xc0, 0xba, 0x02, 0x11, 0x21, 0x16, 0xfd, 0x04, 0x2c, 0x58, 0x80, 0xc4, 0x0e, 0x16, 0x6c,
+	0xe8, 0xb2, 0x12, 0x1b, 0x56, 0x29, 0x4a, 0xf8, 0x04, 0xfd, 0x04, 0xc8, 0xd7, 0xd7, 0xf3, 0xb4,
+	0xe7, 0x81, 0x68, 0x94, 0xd5, 0xc4, 0x73, 0xce, 0xb9, 0xe7, 0x77, 0xfe, 0x73, 0xe3, 0xff, 0x01,
+	0x99, 0xb2, 0x32, 0x65, 0x2e, 0xd3, 0x5c, 0xcf, 0x22, 0x9e, 0xef, 0x6e, 0x13, 0xa6, 0xdd, 0x0f,
+	0x48, 0x75, 0x57, 0xad, 0x54, 0xa9, 0x4f, 0x31, 0x16, 0x71, 0xb5, 0x11, 0x97, 0xa6, 0x1d, 0xea,
+	0x50, 0x1e, 0xd6, 0xc2, 0xbf, 0xa2, 0x4c, 0xe9, 0x82, 0x43, 0xa9, 0x53, 0x22, 0x9a, 0x59, 0x71,
+	0x35, 0xd3, 0xf3, 0xa8, 0x6f, 0xfa, 0x2e, 0xf5, 0x98, 0x88, 0xca, 0x22, 0xca, 0x9f, 0x8a, 0xc1,
+	0x67, 0x9a, 0x1d, 0x54, 0x79, 0x42, 0x1c, 0xb7, 0x78, 0x23, 0xad, 0x68, 0x32, 0xa2, 0x6d, 0xe7,
+	0x8a, 0xc4, 0x37, 0x73, 0x9a, 0x45, 0xdd, 0x38, 0xbe, 0xd8, 0x1c, 0xe7, 0x80, 0xf5, 0xac, 0x8a,
+	0xe9, 0xb8, 0x5e, 0xcb, 0x59, 0x09, 0x33, 0x39, 0x66, 0xe0, 0x10, 0x11, 0x9f, 0x8b, 0xe3, 0x25,
+	0x6a, 0x6d, 0x05, 0x15, 0xfe, 0x11, 0x85, 0x94, 0x79, 0x90, 0xdf, 0xa7, 0x76, 0x50, 0x22, 0x1f,
+	0xd2, 0x0d, 0x97, 0xf9, 0x55, 0xb7, 0x18, 0xf8, 0xe4, 0x26, 0x75, 0x3d, 0xa6, 0x93, 0xfb, 0x01,
+	0x61, 0xbe, 0xf2, 0x15, 0x82, 0x4c, 0x6a, 0x0a, 0xab, 0x50, 0x8f, 0x11, 0x6c, 0xc2, 0x58, 0x88,
+	0xce, 0x66, 0xd1, 0xfc, 0x48, 0xf6, 0xb9, 0xfc, 0x9c, 0x1a, 0xc1, 0xab, 0x21, 0xbc, 0x2a, 0xb0,
+	0xd5, 0xb0, 0xa4, 0xb0, 0xf2, 0xe8, 0x20, 0x33, 0xf4, 0xc3, 0x93, 0x4c, 0xd6, 0x71, 0xfd, 0x7b,
+	0x41, 0x51, 0xb5, 0x68, 0x59, 0x13, 0x93, 0x46, 0x1f, 0xcb, 0xcc, 0xde, 0xd2, 0xfc, 0xdd, 0x0a,
+	0x61, 0x6a, 0xd4, 0x23, 0x3a, 0x59, 0xc9, 0xc0, 0xc5, 0x88, 0xa2, 0xc1, 0x60, 0xb7, 0x70, 0x7e,
+	0x89, 0x40, 0x4e, 0xcb, 0x38, 0x3e, 0x4c, 0x05, 0x26, 0xef, 0x84, 0xca, 0x17, 0x76, 0xef, 0x6e,
+	0x08, 0x32, 0x3c, 0x01, 0xc3, 0xae, 0x3d, 0x8b, 0xe6, 0x51, 0x76, 0x54, 0x1f, 0x76, 0x6d, 0x65,
+	0x03, 0xa6, 0x9a, 0x72, 0x04, 0x9b, 0x06, 0x63, 0xfc, 0x27, 0xe3, 0x79, 0x21, 0x5b, 0xe7, 0x3d,
+	0x54, 0x79, 0x95, 0x1e, 0xe5, 0x29, 0x1f, 0xc3, 0xf3, 0xfc, 0x39, 0x16, 0x00, 0xdf, 0x06, 0x68,
+	0xdc, 0x0c, 0x71, 0xcc, 0x42, 0xcb, 0x88, 0xd1, 0x3d, 0x8f, 0x07, 0xdd, 0x34, 0x1d, 0x22, 0x6a,
+	0xf5, 0xa6, 0x4a, 0xe5, 0x01, 0x82, 0x89, 0xf8, 0x64, 0x01, 0xb7, 0x0a, 0xa3, 0xb6, 0xe9, 0x9b,
+	0x75, 0xdd, 0xd2, 0xd8, 0x0a, 0xa3, 0xa1, 0x6e, 0x3a, 0x4f, 0xc6, 0x77, 0x5a, 0x78, 0x86, 0x39,
+	0xcf, 0x95, 0x9e, 0x3c, 0x51, 0xc7, 0x16, 0xa0, 0x4f, 0xe1, 0xc5, 0x77, 0xac, 0xb0, 0xcb, 0xb3,
+	0x99, 0x77, 0x1f, 0xc1, 0x74, 0xeb, 0xf9, 0x27, 0x62, 0xea, 0x3d, 0x38, 0xdf, 0x4c, 0xb5, 0x49,
+	0xaa, 0x1b, 0xc4, 0xa3, 0xe5, 0x78, 0xfa, 0x69, 0x18, 0xb3, 0xc3, 0x67, 0x3e, 0xf8, 0xb8, 0x1e,
+	0x3d, 0xe0, 0xdb, 0x09, 0xdd, 0xff, 0x8b, 0x26, 0x0f, 0x11, 0x5c, 0x48, 0xee, 0x7e, 0x22, 0xb4,
+	0x31, 0xe0, 0xa5, 0x8f, 0x2a, 0x16, 0x2d, 0xbb, 0x9e, 0xf3, 0x6c, 0xee, 0xc4, 0x77, 0x08, 0xce,
+	0xb5, 0x77, 0x38, 0x11, 0x93, 0xd7, 0xe0, 0x62, 0x2b, 0xd7, 0xf1, 0xde, 0x8b, 0x9f, 0x11, 0xc8,
+	0x69, 0xfd, 0x85, 0x3e, 0xef, 0xc2, 0x0b, 0x81, 0xc8, 0x30, 0xf8, 0x9b, 0x8a, 0xf5, 0x2b, 0xd5,
+	0x44, 0xd0, 0x72, 0xf2, 0xff, 0x27, 0x1a, 0x83, 0x29, 0x9d, 0xec, 0x98, 0x55, 0x9b, 0xdd, 0x62,
+	0x7e, 0x2c, 0xd4, 0x02, 0x8c, 0xd1, 0x1d, 0x8f, 0x54, 0x23, 0xa1, 0x0a, 0x93, 0x4f, 0x0f, 0x32,
+	0x67, 0x77, 0xcd, 0x72, 0xe9, 0x35, 0x85, 0x7f, 0xad, 0xe8, 0x51, 0x18, 0xcf, 0xc1, 0x99, 0xd0,
+	0x2f, 0x0d, 0xd7, 0x66, 0xb3, 0xc3, 0xf3, 0x23, 0xd9, 0x51, 0xfd, 0x74, 0xf8, 0x7c, 0xd7, 0x66,
+	0xf8, 0x3c, 0x8c, 0x13, 0xcf, 0x36, 0x48, 0x85, 0x5a, 0xf7, 0x66, 0x47, 0xe6, 0x51, 0x76, 0x44,
+	0x3f, 0x43, 0x3c, 0xfb, 0x56, 0xf8, 0xac, 0xec, 0x00, 0x6e, 0x6e, 0x7a, 0xac, 0x4e, 0xf9, 0x41,
+	0xa8, 0xcb, 0x7b, 0xd4, 0xda, 0x32, 0x8b, 0x25, 0xb2, 0x21, 0x16, 0x8f, 0xba, 0x53, 0x7e, 0x83,
+	0x40, 0x4e, 0xcb, 0x10, 0x98, 0x14, 0x70, 0x49, 0x04, 0x8d, 0x78, 0x71, 0x69, 0x30, 0x47, 0xab,
+	0x8d, 0x1a, 0xaf, 0x36, 0x6a, 0x5c, 0x5f, 0xb8, 0x1c, 0x32, 0x3f, 0x3d, 0xc8, 0xcc, 0x45, 0x42,
+	0x76, 0x1e, 0xa1, 0x7c, 0xff, 0x24, 0x83, 0xf4, 0xa9, 0x52, 0x7b, 0x63, 0x25, 0x0f, 0x33, 0x37,
+	0x4b, 0xa6, 0x5b, 0x0e, 0xbf, 0x15, 0xb2, 0xc5, 0x3f, 0xd4, 0x0c, 0x9c, 0x16, 0x3f, 0x80, 0xf0,
+	0xd0, 0x53, 0x91, 0xfe, 0x4a, 0x0d, 0x66, 0x3b, 0x6b, 0x8e, 0x4d, 0xe7, 0xfc, 0x1f, 0x13, 0x30,
+	0xc6, 0x65, 0xc4, 0xbf, 0x23, 0x98, 0x49, 0x59, 0x91, 0x70, 0x3e, 0xe9, 0xd6, 0x77, 0x5f, 0xb9,
+	0xa4, 0xd5, 0x81, 0x6a, 0xa2, 0x89, 0x95, 0xb7, 0xbe, 0xf8, 0xf3, 0x9f, 0x6f, 0x87, 0x5f, 0xc1,
+	0x6b, 0x5a, 0xc2, 0x36, 0x18, 0xaf, 0x8e, 0x65, 0x7e, 0x88, 0xe1, 0x53, 0xc3, 0xae, 0x1f, 0x63,
+	0xf0, 0x71, 0xf0, 0xaf, 0x08, 0xce, 0x25, 0xef, 0x4f, 0x38, 0x97, 0xce, 0x93, 0xb2, 0x8d, 0x49,
+	0xf9, 0x41, 0x4a, 0xc4, 0x04, 0x6f, 0xf0, 0x09, 0xd6, 0xf0, 0xf5, 0x3e, 0x26, 0x68, 0xe0, 0xdb,
+	0x82, 0xff, 0x01, 0x82, 0xf1, 0xfa, 0x5a, 0x85, 0x2f, 0xa5, 0xbf, 0x6c, 0x1a, 0x9b, 0x99, 0x74,
+	0xb9, 0x47, 0x96, 0x00, 0xbb, 0xce, 0xc1, 0x54, 0x7c, 0xad, 0x1b, 0x18, 0x7f, 0xd7, 0x19, 0xc5,
+	0x5d, 0xc3, 0xb5, 0xb5, 0x3d, 0xd7, 0xae, 0xe1, 0x3d, 0x38, 0x25, 0x5e, 0x64, 0x2f, 0xa7, 0xb6,
+	0xa9, 0xeb, 0xa5, 0x74, 0x4b, 0x11, 0x18, 0x8b, 0x1c, 0xe3, 0x12, 0x56, 0x7a, 0x62, 0x30, 0xbc,
+	0x8f, 0xe0, 0x6c, 0xb3, 0x81, 0xe3, 0x2b, 0x49, 0x0d, 0x12, 0xd6, 0x2a, 0x29, 0xdb, 0x3b, 0x51,
+	0xf0, 0xe4, 0x38, 0xcf, 0x12, 0xbe, 0xda, 0x8d, 0xc7, 0xe4, 0x95, 0xc2, 0x09, 0xf0, 0x2f, 0x6d,
+	0xbb, 0x56, 0xec, 0x1e, 0x58, 0xeb, 0xd5, 0xb5, 0xcd, 0xe7, 0xa4, 0x95, 0xfe, 0x0b, 0x04, 0xee,
+	0xeb, 0x1c, 0xf7, 0x06, 0x5e, 0xed, 0x1b, 0xd7, 0xa8, 0x90, 0xaa, 0x11, 0x19, 0xe8, 0x43, 0x04,
+	0x13, 0xad, 0xc6, 0x87, 0xaf, 0x26, 0x11, 0x24, 0xae, 0x25, 0xd2, 0x62, 0x3f, 0xa9, 0x02, 0x73,
+	0x95, 0x63, 0x2e, 0xe3, 0xa5, 0x6e, 0x98, 0x6d, 0x0e, 0x8b, 0x7f, 0xeb, 0xd8, 0x57, 0xea, 0xca,
+	0xe6, 0x7a, 0xf7, 0x6e, 0xd7, 0x36, 0x3f, 0x48, 0x89, 0xc0, 0x7e, 0x93, 0x63, 0xaf, 0xe3, 0x1b,
+	0x03, 0x60, 0x37, 0xe9, 0xbb, 0x8f, 0x00, 0x1a, 0x76, 0x89, 0x13, 0xff, 0x31, 0x3b, 0x3c, 0x5c,
+	0x5a, 0xe8, 0x95, 0x26, 0xe0, 0xd6, 0x39, 0x5c, 0x0e, 0x6b, 0xdd, 0xe0, 0xaa, 0x51, 0x9d, 0x41,
+	0x98, 0xaf, 0xed, 0x71, 0xef, 0xaf, 0xe1, 0x9f, 0x10, 0x4c, 0x75, 0xb8, 0x64, 0xb2, 0xa4, 0x5d,
+	0x3d, 0x57, 0xca, 0x0f, 0x52, 0x22, 0xa8, 0xd7, 0x38, 0xf5, 0x0a, 0x56, 0xbb, 0x51, 0x77, 0x7a,
+	0x2c, 0xfe, 0x11, 0xc1, 0x64, 0xbb, 0x31, 0xe2, 0xa5, 0x24, 0x80, 0x14, 0xcb, 0x95, 0xae, 0xf5,
+	0x97, 0x2c, 0x38, 0xdf, 0xe6, 0x9c, 0xaf, 0xe2, 0xf5, 0x6e, 0x9c, 0x56, 0x5c, 0x6d, 0x08, 0x9d,
+	0xb5, 0x3d, 0xe1, 0xea, 0xb5, 0xc2, 0xe6, 0xa3, 0x43, 0x19, 0x3d, 0x3e, 0x94, 0xd1, 0xdf, 0x87,
+	0x32, 0xfa, 0xfa, 0x48, 0x1e, 0x7a, 0x7c, 0x24, 0x0f, 0xfd, 0x75, 0x24, 0x0f, 0x7d, 0xb2, 0xd6,
+	0x64, 0xca, 0xe2, 0xf0, 0xe5, 0x92, 0x59, 0x64, 0xf5, 0x4e, 0xdb, 0xb9, 0x15, 0xed, 0xf3, 0xe6,
+	0x7e, 0xdc, 0xa8, 0x8b, 0xa7, 0xf8, 0x6e, 0xb2, 0xfa, 0xef, 0x00, 0x96, 0xa4, 0x2e, 0x9f, 0xee,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1106,6 +1200,8 @@ type QueryClient interface {
 	RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error)
 	// returns lockable durations that are valid to give incentives
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// ClaimableRewards returns the rewards accrued by a lock that can be claimed
+	ClaimableRewards(ctx context.Context, in *ClaimableRewardsRequest, opts ...grpc.CallOption) (*ClaimableRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -1206,6 +1302,15 @@ func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDu
 	return out, nil
 }
 
+func (c *queryClient) ClaimableRewards(ctx context.Context, in *ClaimableRewardsRequest, opts ...grpc.CallOption) (*ClaimableRewardsResponse, error) {
+	out := new(ClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ClaimableRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// returns coins that is going to be distributed
@@ -1230,6 +1335,8 @@ type QueryServer interface {
 	RewardsEst(context.Context, *RewardsEstRequest) (*RewardsEstResponse, error)
 	// returns lockable durations that are valid to give incentives
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// ClaimableRewards returns the rewards accrued by a lock that can be claimed
+	ClaimableRewards(context.Context, *ClaimableRewardsRequest) (*ClaimableRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1266,6 +1373,9 @@ func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEst
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
+func (*UnimplementedQueryServer) ClaimableRewards(ctx context.Context, req *ClaimableRewardsRequest) (*ClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1451,6 +1561,24 @@ func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ClaimableRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRewards(ctx, req.(*ClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1495,6 +1623,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
 		},
+		{
+			MethodName: "ClaimableRewards",
+			Handler:    _Query_ClaimableRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -2265,6 +2397,71 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ClaimableRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2579,6 +2776,33 @@ func (m *QueryLockableDurationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *ClaimableRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *ClaimableRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4497,6 +4721,159 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClaimableRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -48,6 +48,8 @@ type MsgCreateGauge struct {
 	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"timestamp"`
 	// number of epochs distribution will be done
 	NumEpochsPaidOver uint64 `protobuf:"varint,6,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	// curve the coins are emitted along, a flat schedule when unset
+	EmissionSchedule *EmissionSchedule `protobuf:"bytes,7,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty" yaml:"emission_schedule"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -125,6 +127,13 @@ func (m *MsgCreateGauge) GetNumEpochsPaidOver() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetEmissionSchedule() *EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return nil
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -257,55 +266,264 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+type MsgCancelGauge struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCancelGauge) Reset()         { *m = MsgCancelGauge{} }
+func (m *MsgCancelGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGauge) ProtoMessage()    {}
+func (*MsgCancelGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgCancelGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGauge.Merge(m, src)
+}
+func (m *MsgCancelGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGauge proto.InternalMessageInfo
+
+func (m *MsgCancelGauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelGauge) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type MsgCancelGaugeResponse struct {
+	// undistributed coins refunded to the gauge owner
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+}
+
+func (m *MsgCancelGaugeResponse) Reset()         { *m = MsgCancelGaugeResponse{} }
+func (m *MsgCancelGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGaugeResponse) ProtoMessage()    {}
+func (*MsgCancelGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgCancelGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGaugeResponse.Merge(m, src)
+}
+func (m *MsgCancelGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelGaugeResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+type MsgClaimRewards struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// locks to claim the rewards of, all locks of the owner when empty
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{6}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimRewards) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgClaimRewardsResponse struct {
+	// rewards paid out to the reward receivers of the locks
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{7}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgCancelGauge)(nil), "osmosis.incentives.MsgCancelGauge")
+	proto.RegisterType((*MsgCancelGaugeResponse)(nil), "osmosis.incentives.MsgCancelGaugeResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "osmosis.incentives.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "osmosis.incentives.MsgClaimRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0x69, 0xf7, 0xcf, 0xdd, 0xd0, 0x88, 0x06, 0x64, 0x05, 0x25, 0x5d, 0x0e, 0x28, 0x20,
-	0xcd, 0xde, 0x86, 0xc4, 0x81, 0x1b, 0x9d, 0x10, 0xda, 0xa1, 0xa2, 0x44, 0x95, 0x90, 0x26, 0xa1,
-	0xc8, 0x49, 0x4c, 0x66, 0xad, 0x89, 0xa3, 0xd8, 0xe9, 0xd6, 0xb7, 0x98, 0xc4, 0x5b, 0xf0, 0x06,
-	0xdc, 0x38, 0xee, 0xb8, 0x23, 0xa7, 0x0e, 0xb5, 0x6f, 0xb0, 0x27, 0x40, 0x71, 0x92, 0xb5, 0x15,
-	0x7f, 0x76, 0xe1, 0xe4, 0xda, 0xdf, 0xf7, 0xfb, 0xfc, 0xf3, 0xf7, 0xfd, 0x1a, 0xf8, 0x84, 0x8b,
-	0x88, 0x0b, 0x26, 0x30, 0x8b, 0x7d, 0x1a, 0x4b, 0x36, 0xa4, 0x02, 0xcb, 0x73, 0x94, 0xa4, 0x5c,
-	0x72, 0x4d, 0x2b, 0x41, 0x34, 0x03, 0x5b, 0x5b, 0x21, 0x0f, 0xb9, 0x82, 0x71, 0xfe, 0xab, 0x60,
-	0xb6, 0xcc, 0x90, 0xf3, 0x70, 0x40, 0xb1, 0xda, 0x79, 0xd9, 0x67, 0x2c, 0x59, 0x44, 0x85, 0x24,
-	0x51, 0x52, 0x12, 0x0c, 0x5f, 0x69, 0x61, 0x8f, 0x08, 0x8a, 0x87, 0xfb, 0x1e, 0x95, 0x64, 0x1f,
-	0xfb, 0x9c, 0xc5, 0x15, 0xfe, 0x87, 0x3e, 0x42, 0x92, 0x85, 0xb4, 0xc4, 0xb7, 0x2b, 0x7c, 0xc0,
-	0xfd, 0xd3, 0x2c, 0x51, 0x4b, 0x01, 0x59, 0x5f, 0xea, 0xf0, 0x7e, 0x57, 0x84, 0x87, 0x29, 0x25,
-	0x92, 0xbe, 0xcb, 0x6b, 0xb4, 0x1d, 0xb8, 0xce, 0x84, 0x9b, 0xd0, 0x34, 0xa1, 0x32, 0x23, 0x03,
-	0x1d, 0xb4, 0x81, 0xbd, 0xea, 0x34, 0x99, 0xe8, 0x55, 0x47, 0xda, 0x33, 0xb8, 0xc4, 0xcf, 0x62,
-	0x9a, 0xea, 0xf7, 0xda, 0xc0, 0x5e, 0xeb, 0x6c, 0xde, 0x8c, 0xcd, 0xf5, 0x11, 0x89, 0x06, 0xaf,
-	0x2d, 0x75, 0x6c, 0x39, 0x05, 0xac, 0x1d, 0xc1, 0x8d, 0x80, 0x09, 0x99, 0x32, 0x2f, 0x93, 0xd4,
-	0x95, 0x5c, 0xaf, 0xb7, 0x81, 0xdd, 0x3c, 0x30, 0x50, 0xe5, 0x4d, 0xd1, 0x10, 0xfa, 0x90, 0xd1,
-	0x74, 0x74, 0xc8, 0xe3, 0x80, 0x49, 0xc6, 0xe3, 0x4e, 0xe3, 0x72, 0x6c, 0xd6, 0x9c, 0xf5, 0x59,
-	0x69, 0x9f, 0x6b, 0x04, 0x2e, 0xe5, 0x2f, 0x16, 0x7a, 0xa3, 0x5d, 0xb7, 0x9b, 0x07, 0xdb, 0xa8,
-	0xf0, 0x04, 0xe5, 0x9e, 0xa0, 0xd2, 0x13, 0x74, 0xc8, 0x59, 0xdc, 0xd9, 0xcb, 0xab, 0xbf, 0x5e,
-	0x9b, 0x76, 0xc8, 0xe4, 0x49, 0xe6, 0x21, 0x9f, 0x47, 0xb8, 0x34, 0xb0, 0x58, 0x76, 0x45, 0x70,
-	0x8a, 0xe5, 0x28, 0xa1, 0x42, 0x15, 0x08, 0xa7, 0x50, 0xd6, 0x3e, 0x42, 0x28, 0x24, 0x49, 0xa5,
-	0x9b, 0xfb, 0xaf, 0x2f, 0xa9, 0x56, 0x5b, 0xa8, 0x08, 0x07, 0x55, 0xe1, 0xa0, 0x7e, 0x15, 0x4e,
-	0xe7, 0x69, 0x7e, 0xd1, 0xcd, 0xd8, 0xdc, 0x2c, 0x9e, 0x7e, 0x9b, 0x9a, 0x75, 0x71, 0x6d, 0x02,
-	0x67, 0x4d, 0x69, 0xe5, 0x6c, 0x0d, 0xc3, 0xad, 0x38, 0x8b, 0x5c, 0x9a, 0x70, 0xff, 0x44, 0xb8,
-	0x09, 0x61, 0x81, 0xcb, 0x87, 0x34, 0xd5, 0x97, 0xdb, 0xc0, 0x6e, 0x38, 0x0f, 0xe2, 0x2c, 0x7a,
-	0xab, 0xa0, 0x1e, 0x61, 0xc1, 0xfb, 0x21, 0x4d, 0x2d, 0x1d, 0x3e, 0x5a, 0x0c, 0xc5, 0xa1, 0x22,
-	0xe1, 0xb1, 0xa0, 0xd6, 0x37, 0x00, 0x37, 0xba, 0x22, 0x7c, 0x13, 0x04, 0x7d, 0x5e, 0xc4, 0x75,
-	0x9b, 0x05, 0xf8, 0x77, 0x16, 0xdb, 0x70, 0x55, 0xcd, 0x84, 0xcb, 0x02, 0x15, 0x5b, 0xc3, 0x59,
-	0x51, 0xfb, 0xa3, 0x40, 0xa3, 0x70, 0x25, 0xa5, 0x67, 0x24, 0x0d, 0x84, 0x5e, 0xff, 0xff, 0xee,
-	0x56, 0xda, 0xd6, 0x63, 0xf8, 0x70, 0xa1, 0xf5, 0xea, 0x51, 0x07, 0xdf, 0x01, 0xac, 0x77, 0x45,
-	0xa8, 0x7d, 0x82, 0xcd, 0xf9, 0x41, 0xb4, 0xd0, 0xef, 0x7f, 0x21, 0xb4, 0xe8, 0x4b, 0xeb, 0xc5,
-	0xdd, 0x9c, 0xea, 0x1a, 0xed, 0x18, 0xc2, 0x39, 0xdf, 0x76, 0xfe, 0x52, 0x39, 0xa3, 0xb4, 0x9e,
-	0xdf, 0x49, 0xa9, 0xb4, 0x3b, 0xbd, 0xcb, 0x89, 0x01, 0xae, 0x26, 0x06, 0xf8, 0x39, 0x31, 0xc0,
-	0xc5, 0xd4, 0xa8, 0x5d, 0x4d, 0x8d, 0xda, 0x8f, 0xa9, 0x51, 0x3b, 0x7e, 0x35, 0x67, 0x54, 0x29,
-	0xb7, 0x3b, 0x20, 0x9e, 0xa8, 0x36, 0x78, 0xb8, 0xbf, 0x87, 0xcf, 0x17, 0x3e, 0x21, 0xb9, 0x79,
-	0xde, 0xb2, 0x9a, 0xb8, 0x97, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x05, 0x85, 0x94, 0x65,
-	0x04, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x18, 0x8d, 0x49, 0x20, 0x61, 0x12, 0x2e, 0xe0, 0xcb, 0x05, 0x93, 0x8b, 0xec, 0xe0, 0x56, 0x55,
+	0x4a, 0x85, 0x0d, 0x54, 0xea, 0xa2, 0xbb, 0x06, 0xa1, 0x8a, 0x05, 0x2a, 0x35, 0x48, 0x95, 0x90,
+	0x2a, 0xd7, 0xb1, 0x3f, 0xcc, 0x08, 0xdb, 0x63, 0x79, 0xc6, 0x01, 0x76, 0x45, 0xea, 0x03, 0xf0,
+	0x1c, 0x7d, 0x83, 0xbe, 0x01, 0x4b, 0x96, 0x5d, 0x85, 0x0a, 0xde, 0x20, 0x4f, 0x50, 0xf9, 0x37,
+	0x09, 0x3f, 0x85, 0x05, 0x5d, 0xd9, 0x33, 0xe7, 0x7c, 0x67, 0xbe, 0x9f, 0xe3, 0x31, 0xfa, 0x9f,
+	0x50, 0x97, 0x50, 0x4c, 0x55, 0xec, 0x99, 0xe0, 0x31, 0xdc, 0x01, 0xaa, 0xb2, 0x63, 0xc5, 0x0f,
+	0x08, 0x23, 0x3c, 0x9f, 0x82, 0x4a, 0x1f, 0xac, 0xcf, 0xd8, 0xc4, 0x26, 0x31, 0xac, 0x46, 0x6f,
+	0x09, 0xb3, 0x2e, 0xd9, 0x84, 0xd8, 0x0e, 0xa8, 0xf1, 0xaa, 0x1d, 0xee, 0xab, 0x0c, 0xbb, 0x40,
+	0x99, 0xe1, 0xfa, 0x29, 0x41, 0x34, 0x63, 0x2d, 0xb5, 0x6d, 0x50, 0x50, 0x3b, 0xab, 0x6d, 0x60,
+	0xc6, 0xaa, 0x6a, 0x12, 0xec, 0x65, 0xf8, 0x1d, 0x79, 0xd8, 0x46, 0x68, 0x43, 0x8a, 0xcf, 0x67,
+	0xb8, 0x43, 0xcc, 0xc3, 0xd0, 0x8f, 0x1f, 0x09, 0x24, 0x7f, 0x2b, 0xa1, 0x7f, 0xb6, 0xa8, 0xbd,
+	0x1e, 0x80, 0xc1, 0xe0, 0x7d, 0x14, 0xc3, 0x2f, 0xa2, 0x1a, 0xa6, 0xba, 0x0f, 0x81, 0x0f, 0x2c,
+	0x34, 0x1c, 0x81, 0x6b, 0x70, 0xcd, 0x8a, 0x56, 0xc5, 0x74, 0x3b, 0xdb, 0xe2, 0x5f, 0xa0, 0x51,
+	0x72, 0xe4, 0x41, 0x20, 0x8c, 0x34, 0xb8, 0xe6, 0x78, 0x6b, 0xaa, 0xd7, 0x95, 0x6a, 0x27, 0x86,
+	0xeb, 0xbc, 0x95, 0xe3, 0x6d, 0x59, 0x4b, 0x60, 0x7e, 0x13, 0x4d, 0x58, 0x98, 0xb2, 0x00, 0xb7,
+	0x43, 0x06, 0x3a, 0x23, 0x42, 0xb1, 0xc1, 0x35, 0xab, 0x6b, 0xa2, 0x92, 0xf5, 0x26, 0x49, 0x48,
+	0xf9, 0x18, 0x42, 0x70, 0xb2, 0x4e, 0x3c, 0x0b, 0x33, 0x4c, 0xbc, 0x56, 0xe9, 0xbc, 0x2b, 0x15,
+	0xb4, 0x5a, 0x3f, 0x74, 0x97, 0xf0, 0x06, 0x1a, 0x8d, 0x2a, 0xa6, 0x42, 0xa9, 0x51, 0x6c, 0x56,
+	0xd7, 0xe6, 0x95, 0xa4, 0x27, 0x4a, 0xd4, 0x13, 0x25, 0xed, 0x89, 0xb2, 0x4e, 0xb0, 0xd7, 0x5a,
+	0x89, 0xa2, 0xbf, 0x5f, 0x4a, 0x4d, 0x1b, 0xb3, 0x83, 0xb0, 0xad, 0x98, 0xc4, 0x55, 0xd3, 0x06,
+	0x26, 0x8f, 0x65, 0x6a, 0x1d, 0xaa, 0xec, 0xc4, 0x07, 0x1a, 0x07, 0x50, 0x2d, 0x51, 0xe6, 0x3f,
+	0x21, 0x44, 0x99, 0x11, 0x30, 0x3d, 0xea, 0xbf, 0x30, 0x1a, 0xa7, 0x5a, 0x57, 0x92, 0xe1, 0x28,
+	0xd9, 0x70, 0x94, 0xdd, 0x6c, 0x38, 0xad, 0x85, 0xe8, 0xa0, 0x5e, 0x57, 0x9a, 0x4a, 0x4a, 0xcf,
+	0xa7, 0x26, 0x9f, 0x5d, 0x4a, 0x9c, 0x36, 0x1e, 0x6b, 0x45, 0x6c, 0x5e, 0x45, 0x33, 0x5e, 0xe8,
+	0xea, 0xe0, 0x13, 0xf3, 0x80, 0xea, 0xbe, 0x81, 0x2d, 0x9d, 0x74, 0x20, 0x10, 0xc6, 0x1a, 0x5c,
+	0xb3, 0xa4, 0x4d, 0x7b, 0xa1, 0xbb, 0x11, 0x43, 0xdb, 0x06, 0xb6, 0x3e, 0x74, 0x20, 0xe0, 0x09,
+	0x9a, 0x06, 0x17, 0x53, 0x8a, 0x89, 0xa7, 0x53, 0xf3, 0x00, 0xac, 0xd0, 0x01, 0xa1, 0x1c, 0x27,
+	0xf4, 0x5c, 0xb9, 0xed, 0x2b, 0x65, 0x23, 0x25, 0xef, 0xa4, 0xdc, 0xd6, 0x42, 0xaf, 0x2b, 0x09,
+	0x49, 0x5a, 0xb7, 0x84, 0x64, 0x6d, 0x0a, 0x6e, 0xf0, 0x65, 0x01, 0xcd, 0x0e, 0xbb, 0x40, 0x03,
+	0xea, 0x13, 0x8f, 0x82, 0xfc, 0x83, 0x43, 0x13, 0x5b, 0xd4, 0x7e, 0x67, 0x59, 0xbb, 0x24, 0xf1,
+	0x47, 0x3e, 0x7c, 0xee, 0xcf, 0xc3, 0x9f, 0x47, 0x95, 0xd8, 0x84, 0x3a, 0xb6, 0x62, 0x9f, 0x94,
+	0xb4, 0x72, 0xbc, 0xde, 0xb4, 0x78, 0x40, 0xe5, 0x00, 0x8e, 0x8c, 0xc0, 0xa2, 0x42, 0xf1, 0xe9,
+	0xc7, 0x99, 0x69, 0xcb, 0x73, 0xe8, 0xbf, 0xa1, 0xd4, 0xf3, 0xa2, 0x76, 0x12, 0xd3, 0x1b, 0x9e,
+	0x09, 0xce, 0x53, 0x15, 0x25, 0x9f, 0x72, 0x68, 0x76, 0x58, 0x35, 0x3b, 0x8f, 0xb7, 0x51, 0x25,
+	0x80, 0xfd, 0xd0, 0xb3, 0xc0, 0x12, 0xb8, 0xa7, 0x2f, 0x38, 0x17, 0x97, 0x31, 0x9a, 0x8c, 0x52,
+	0x70, 0x0c, 0xec, 0x6a, 0x49, 0x13, 0x1e, 0x5d, 0x99, 0x82, 0x2a, 0xd1, 0xd7, 0xa8, 0x63, 0x8b,
+	0x0a, 0x23, 0x8d, 0x62, 0xb3, 0xd4, 0xfa, 0xb7, 0xd7, 0x95, 0x26, 0x13, 0x6a, 0x86, 0xc8, 0x5a,
+	0x39, 0x7a, 0xdd, 0xb4, 0xa8, 0xfc, 0x95, 0x43, 0x73, 0x37, 0xce, 0xca, 0xeb, 0x05, 0x54, 0x36,
+	0xa3, 0xfd, 0xbf, 0x53, 0x6e, 0xa6, 0xbd, 0x76, 0x5a, 0x44, 0xc5, 0x2d, 0x6a, 0xf3, 0x9f, 0x51,
+	0x75, 0xf0, 0x02, 0x93, 0xef, 0xfa, 0x44, 0x86, 0xed, 0x5d, 0x5f, 0x7a, 0x98, 0x93, 0x57, 0xb3,
+	0x87, 0xd0, 0x80, 0xfd, 0x17, 0xef, 0x89, 0xec, 0x53, 0xea, 0x2f, 0x1f, 0xa4, 0xe4, 0xda, 0x51,
+	0xea, 0x03, 0x36, 0xbc, 0x37, 0xf5, 0x3e, 0xa7, 0xbe, 0xf4, 0x30, 0x27, 0x97, 0xff, 0x82, 0x6a,
+	0x43, 0x66, 0x78, 0x76, 0x5f, 0xec, 0x00, 0xa9, 0xfe, 0xea, 0x11, 0xa4, 0xec, 0x84, 0xd6, 0xf6,
+	0xf9, 0x95, 0xc8, 0x5d, 0x5c, 0x89, 0xdc, 0xaf, 0x2b, 0x91, 0x3b, 0xbb, 0x16, 0x0b, 0x17, 0xd7,
+	0x62, 0xe1, 0xe7, 0xb5, 0x58, 0xd8, 0x7b, 0x33, 0x30, 0xd0, 0x54, 0x70, 0xd9, 0x31, 0xda, 0x34,
+	0x5b, 0xa8, 0x9d, 0xd5, 0x15, 0xf5, 0x78, 0xe8, 0xdf, 0x19, 0x0d, 0xb9, 0x3d, 0x16, 0x5f, 0xb5,
+	0xaf, 0x7f, 0x0f, 0x00, 0x36, 0xe5, 0x47, 0x5a, 0x5e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -322,6 +540,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -350,10 +570,30 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error) {
+	out := new(MsgCancelGaugeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/CancelGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	CancelGauge(context.Context, *MsgCancelGauge) (*MsgCancelGaugeResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -366,6 +606,12 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) CancelGauge(ctx context.Context, req *MsgCancelGauge) (*MsgCancelGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGauge not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -407,6 +653,42 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/CancelGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelGauge(ctx, req.(*MsgCancelGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -419,6 +701,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "CancelGauge",
+			Handler:    _Msg_CancelGauge_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -444,17 +734,29 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionSchedule != nil {
+		{
+			size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.NumEpochsPaidOver != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -596,67 +898,228 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsPerpetual {
-		n += 2
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.DistributeTo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddToGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GaugeId != 0 {
-		n += 1 + sovTx(uint64(m.GaugeId))
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA5 := make([]byte, len(m.LockIds)*10)
+		var j4 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPerpetual {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DistributeTo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	}
+	if m.EmissionSchedule != nil {
+		l = m.EmissionSchedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
 	}
 	if len(m.Rewards) > 0 {
 		for _, e := range m.Rewards {
@@ -676,6 +1139,72 @@ func (m *MsgAddToGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgCancelGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -882,6 +1411,42 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionSchedule == nil {
+				m.EmissionSchedule = &EmissionSchedule{}
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1138,6 +1703,433 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types1.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types1.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,8 +24,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	// delete synthetic locks matured before lockup deletion
 	k.DeleteAllMaturedSyntheticLocks(ctx)
 
-	// withdraw and delete locks
-	k.WithdrawAllMaturedLocks(ctx)
+	// withdraw and delete locks, bounded by the max matured locks per block param
+	k.WithdrawMaturedLocks(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
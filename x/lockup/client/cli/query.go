@@ -48,6 +48,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdOutputLocksJson(),
 		GetCmdSyntheticLockupsByLockupID(),
 		GetCmdAccountLockedDuration(),
+		GetCmdMaturedLocksBacklog(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -128,6 +130,78 @@ $ %s query lockup module-locked-amount
 	return cmd
 }
 
+// GetCmdMaturedLocksBacklog returns the number of matured locks that are waiting to be withdrawn.
+func GetCmdMaturedLocksBacklog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "matured-locks-backlog",
+		Short: "Query the number of matured locks waiting to be withdrawn",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of matured locks waiting to be withdrawn.
+
+Example:
+$ %s query lockup matured-locks-backlog
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MaturedLocksBacklog(cmd.Context(), &types.MaturedLocksBacklogRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the lockup module params.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query lockup module params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query lockup module params.
+
+Example:
+$ %s query lockup params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAccountUnlockableCoins returns unlockable coins which has finsihed unlocking.
 func GetCmdAccountUnlockableCoins() *cobra.Command {
 	cmd := &cobra.Command{
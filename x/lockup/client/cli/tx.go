@@ -29,6 +29,7 @@ func GetTxCmd() *cobra.Command {
 		NewLockTokensCmd(),
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
+		NewSetRewardReceiverCmd(),
 	)
 
 	return cmd
@@ -154,3 +155,41 @@ func NewBeginUnlockByIDCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetRewardReceiverCmd sets the address that receives the rewards of a lock.
+func NewSetRewardReceiverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reward-receiver [id] [reward-receiver]",
+		Short: "set the address that receives the rewards of a lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			rewardReceiver, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRewardReceiver(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+				rewardReceiver,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -25,3 +25,7 @@ func (k Keeper) SyntheticCoins(coins sdk.Coins, suffix string) sdk.Coins {
 func (k Keeper) GetCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
 	return k.getCoinsFromLocks(locks)
 }
+
+func (k Keeper) CountMaturedLocks(ctx sdk.Context, maxCount uint64) uint64 {
+	return k.countMaturedLocks(ctx, maxCount)
+}
@@ -45,6 +45,7 @@ var (
 				Coins:    sdk.Coins{sdk.NewInt64Coin("foo", 5000000)},
 			},
 		},
+		Params: types.DefaultParams(),
 	}
 )
 
@@ -88,6 +89,7 @@ func TestExportGenesis(t *testing.T) {
 
 	genesisExported := app.LockupKeeper.ExportGenesis(ctx)
 	require.Equal(t, genesisExported.LastLockId, uint64(11))
+	require.Equal(t, genesisExported.Params, types.DefaultParams())
 	require.Equal(t, genesisExported.Locks, []types.PeriodLock{
 		{
 			ID:       1,
@@ -285,3 +285,15 @@ func (q Querier) LockedDenom(goCtx context.Context, req *types.LockedDenomReques
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.LockedDenomResponse{Amount: q.Keeper.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
+
+// MaturedLocksBacklog returns the number of matured locks that are waiting to be withdrawn.
+func (q Querier) MaturedLocksBacklog(goCtx context.Context, _ *types.MaturedLocksBacklogRequest) (*types.MaturedLocksBacklogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.MaturedLocksBacklogResponse{Length: q.Keeper.GetMaturedLocksBacklog(ctx)}, nil
+}
+
+// Params returns lockup module params.
+func (q Querier) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(ctx)}, nil
+}
@@ -513,3 +513,31 @@ func (suite *KeeperTestSuite) TestLockedDenom() {
 	testTotalLockedDuration("2h", 0)
 	testTotalLockedDuration("1h", 10)
 }
+
+func (suite *KeeperTestSuite) TestMaturedLocksBacklog() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// initial check
+	res, err := suite.querier.MaturedLocksBacklog(sdk.WrapSDKContext(suite.Ctx), &types.MaturedLocksBacklogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Length)
+
+	// lock coins and begin unlocking
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Hour)
+	suite.BeginUnlocking(addr1)
+
+	// only the lock that finished unlocking is in the backlog
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	res, err = suite.querier.MaturedLocksBacklog(sdk.WrapSDKContext(suite.Ctx), &types.MaturedLocksBacklogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Length)
+
+	// withdrawing drains the backlog
+	suite.App.LockupKeeper.WithdrawMaturedLocks(suite.Ctx)
+	res, err = suite.querier.MaturedLocksBacklog(sdk.WrapSDKContext(suite.Ctx), &types.MaturedLocksBacklogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Length)
+}
@@ -20,6 +20,48 @@ func (k Keeper) WithdrawAllMaturedLocks(ctx sdk.Context) {
 	k.unlockFromIterator(ctx, k.LockIteratorBeforeTime(ctx, ctx.BlockTime()))
 }
 
+// WithdrawMaturedLocks withdraws matured locks in the order of their end time, processing at most
+// MaxMaturedLocksPerBlock locks. Matured locks beyond the limit are left in the unlocking queue
+// and get withdrawn in the following blocks.
+func (k Keeper) WithdrawMaturedLocks(ctx sdk.Context) {
+	maxLocks := k.GetParams(ctx).MaxMaturedLocksPerBlock
+
+	iterator := k.LockIteratorBeforeTime(ctx, ctx.BlockTime())
+	lockIDs := []uint64{}
+	for ; iterator.Valid() && uint64(len(lockIDs)) < maxLocks; iterator.Next() {
+		lockIDs = append(lockIDs, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, lockID := range lockIDs {
+		err := k.UnlockMaturedLock(ctx, lockID)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// MaxMaturedLocksBacklog is the maximum number of matured locks GetMaturedLocksBacklog counts.
+const MaxMaturedLocksBacklog = 10_000
+
+// GetMaturedLocksBacklog returns the number of locks that have finished unlocking
+// by the current block time, but have not been withdrawn yet, up to MaxMaturedLocksBacklog.
+func (k Keeper) GetMaturedLocksBacklog(ctx sdk.Context) uint64 {
+	return k.countMaturedLocks(ctx, MaxMaturedLocksBacklog)
+}
+
+// countMaturedLocks returns the number of matured locks that have not been withdrawn yet, up to maxCount.
+func (k Keeper) countMaturedLocks(ctx sdk.Context, maxCount uint64) uint64 {
+	iterator := k.LockIteratorBeforeTime(ctx, ctx.BlockTime())
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid() && count < maxCount; iterator.Next() {
+		count++
+	}
+	return count
+}
+
 // GetModuleBalance returns full balance of the module.
 func (k Keeper) GetModuleBalance(ctx sdk.Context) sdk.Coins {
 	acc := k.ak.GetModuleAccount(ctx, types.ModuleName)
@@ -37,6 +79,15 @@ func (k Keeper) GetModuleLockedCoins(ctx sdk.Context) sdk.Coins {
 // GetPeriodLocksByDuration returns the total amount of query.Denom tokens locked for longer than
 // query.Duration.
 func (k Keeper) GetPeriodLocksAccumulation(ctx sdk.Context, query types.QueryCondition) sdk.Int {
+	// the accumulation store is indexed by duration only, so locks ending after
+	// a timestamp have to be summed up from the lock queues.
+	switch query.LockQueryType {
+	case types.ByTime:
+		return types.SumLocksByDenom(k.GetLocksPastTimeDenom(ctx, query.Denom, query.Timestamp), query.Denom)
+	case types.NoLock:
+		// no lock conditions match unlocked balances, never locks
+		return sdk.ZeroInt()
+	}
 	beginKey := accumulationKey(query.Duration)
 	return k.accumulationStore(ctx, query.Denom).SubsetAccumulation(beginKey, nil)
 }
@@ -93,6 +144,35 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 	return lock, nil
 }
 
+// SetLockRewardReceiver sets the address that receives the rewards of the lock with the given ID.
+// Setting the reward receiver to the lock owner clears the reward receiver address.
+func (k Keeper) SetLockRewardReceiver(ctx sdk.Context, lockID uint64, owner, rewardReceiver sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	if rewardReceiver.Equals(owner) {
+		lock.RewardReceiverAddress = ""
+	} else {
+		lock.RewardReceiverAddress = rewardReceiver.String()
+	}
+
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnRewardReceiverChange(ctx, lock.ID, rewardReceiver)
+	}
+	return nil
+}
+
 // addTokenToLock adds token to lock and modifies the state of the lock and the accumulation store.
 func (k Keeper) addTokenToLock(ctx sdk.Context, lock *types.PeriodLock, coin sdk.Coin) error {
 	lock.Coins = lock.Coins.Add(coin)
@@ -652,7 +732,32 @@ func (k Keeper) deleteLock(ctx sdk.Context, id uint64) {
 	store.Delete(lockStoreKey(id))
 }
 
+// SplitLock splits off the given coins of a lock into a new lock with the same owner and duration,
+// and returns the new lock. Synthetic lockups of the lock are carried over to the new lock.
+// The lock must not be unlocking, and coins must be less than its locked coins.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if coins.Empty() || !coins.IsValid() || !coins.IsAllLT(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("split coins %s must be less than the locked coins %s", coins, lock.Coins)
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	return splitLock, nil
+}
+
 // splitLock splits a lock with the given amount, and stores split new lock to the state.
+// The synthetic lockups of the lock are copied onto the split lock, which leaves the
+// synthetic accumulation stores unchanged.
 func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (types.PeriodLock, error) {
 	if lock.IsUnlocking() {
 		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lock")
@@ -667,8 +772,24 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	k.SetLastLockID(ctx, splitLockID)
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
+	splitLock.RewardReceiverAddress = lock.RewardReceiverAddress
 	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		synthLock.UnderlyingLockId = splitLock.ID
+		err = k.setSyntheticLockAndResetRefs(ctx, splitLock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
@@ -302,6 +302,39 @@ func (suite *KeeperTestSuite) TestLocksPastTimeDenom() {
 	suite.Require().Len(locks, 1)
 }
 
+func (suite *KeeperTestSuite) TestPeriodLocksAccumulationByTime() {
+	suite.SetupTest()
+
+	now := time.Now()
+	suite.Ctx = suite.Ctx.WithBlockTime(now)
+
+	// lock coins for a second, for an hour, and for an hour that started unlocking
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Hour)
+	suite.FundAcc(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 30)})
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, time.Hour)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		timestamp time.Time
+		expected  string
+	}{
+		{now, "60"},
+		{now.Add(10 * time.Minute), "50"},
+		{now.Add(2 * time.Hour), "0"},
+	} {
+		accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			LockQueryType: types.ByTime,
+			Denom:         "stake",
+			Timestamp:     tc.timestamp,
+		})
+		suite.Require().Equal(tc.expected, accum.String())
+	}
+}
+
 func (suite *KeeperTestSuite) TestLocksLongerThanDurationDenom() {
 	suite.SetupTest()
 
@@ -550,6 +583,45 @@ func (suite *KeeperTestSuite) TestEndblockerWithdrawAllMaturedLockups() {
 	suite.Require().Len(locks, 0)
 }
 
+func (suite *KeeperTestSuite) TestWithdrawMaturedLocksBounded() {
+	suite.SetupTest()
+
+	params := suite.App.LockupKeeper.GetParams(suite.Ctx)
+	params.MaxMaturedLocksPerBlock = 2
+	suite.App.LockupKeeper.SetParams(suite.Ctx, params)
+
+	// lock coins and begin unlocking them all
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	for i := 0; i < 5; i++ {
+		suite.LockTokens(addr1, coins, time.Second)
+	}
+	_, err := suite.App.LockupKeeper.BeginUnlockAllNotUnlockings(suite.Ctx, addr1)
+	suite.Require().NoError(err)
+
+	// nothing has matured yet
+	suite.Require().Equal(uint64(0), suite.App.LockupKeeper.GetMaturedLocksBacklog(suite.Ctx))
+	suite.App.LockupKeeper.WithdrawMaturedLocks(suite.Ctx)
+	locks, err := suite.App.LockupKeeper.GetPeriodLocks(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(locks, 5)
+
+	// every lock matured at once, withdraw at most two per block and carry over the rest
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	suite.Require().Equal(uint64(5), suite.App.LockupKeeper.GetMaturedLocksBacklog(suite.Ctx))
+	suite.Require().Equal(uint64(3), suite.App.LockupKeeper.CountMaturedLocks(suite.Ctx, 3))
+	for _, expectedBacklog := range []uint64{3, 1, 0} {
+		suite.App.LockupKeeper.WithdrawMaturedLocks(suite.Ctx)
+		suite.Require().Equal(expectedBacklog, suite.App.LockupKeeper.GetMaturedLocksBacklog(suite.Ctx))
+		locks, err = suite.App.LockupKeeper.GetPeriodLocks(suite.Ctx)
+		suite.Require().NoError(err)
+		suite.Require().Len(locks, int(expectedBacklog))
+	}
+
+	// locks are withdrawn to the owner
+	suite.Require().Equal(sdk.NewInt(50), suite.App.BankKeeper.GetBalance(suite.Ctx, addr1, "stake").Amount)
+}
+
 func (suite *KeeperTestSuite) TestLockAccumulationStore() {
 	suite.SetupTest()
 
@@ -641,6 +713,64 @@ func (suite *KeeperTestSuite) TestSlashTokensFromLockByID() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestSplitLock() {
+	suite.SetupTest()
+
+	// lock coins with a synthetic lockup
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr, coins, time.Second)
+	err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstake", time.Second, false)
+	suite.Require().NoError(err)
+
+	// split coins must be positive and less than the locked coins
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{})
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake1", 1)})
+	suite.Require().Error(err)
+
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), splitLock.ID)
+	suite.Require().Equal(time.Second, splitLock.Duration)
+	suite.Require().Equal(addr.String(), splitLock.Owner)
+
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal("6stake", lock.Coins.String())
+	lock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal("4stake", lock.Coins.String())
+
+	// the split lock carries the synthetic lockup, and accumulations are unchanged
+	synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, 2, "synthstake")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), synthLock.UnderlyingLockId)
+	for _, denom := range []string{"stake", "synthstake"} {
+		acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    denom,
+			Duration: time.Second,
+		})
+		suite.Require().Equal(int64(10), acc.Int64())
+
+		locks := suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, denom, time.Second)
+		suite.Require().Len(locks, 2)
+	}
+
+	// deleting both synthetic lockups clears the synthetic accumulation
+	err = suite.App.LockupKeeper.DeleteSyntheticLockup(suite.Ctx, 1, "synthstake")
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.DeleteSyntheticLockup(suite.Ctx, 2, "synthstake")
+	suite.Require().NoError(err)
+	acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		Denom:    "synthstake",
+		Duration: time.Second,
+	})
+	suite.Require().Equal(int64(0), acc.Int64())
+}
+
 func (suite *KeeperTestSuite) TestEditLockup() {
 	suite.SetupTest()
 
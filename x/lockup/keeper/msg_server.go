@@ -180,3 +180,35 @@ func (server msgServer) ExtendLockup(goCtx context.Context, msg *types.MsgExtend
 
 	return &types.MsgExtendLockupResponse{}, nil
 }
+
+// SetRewardReceiver sets the address that receives the gauge rewards distributed to the lock.
+// Only the lock owner is able to set the reward receiver.
+func (server msgServer) SetRewardReceiver(goCtx context.Context, msg *types.MsgSetRewardReceiver) (*types.MsgSetRewardReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardReceiver, err := sdk.AccAddressFromBech32(msg.RewardReceiver)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SetLockRewardReceiver(ctx, msg.ID, owner, rewardReceiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetRewardReceiver,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeRewardReceiver, msg.RewardReceiver),
+		),
+	})
+
+	return &types.MsgSetRewardReceiverResponse{Success: true}, nil
+}
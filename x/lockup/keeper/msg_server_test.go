@@ -327,3 +327,79 @@ func (suite *KeeperTestSuite) TestMsgEditLockup() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetRewardReceiver() {
+	type param struct {
+		coinsToLock    sdk.Coins
+		lockOwner      sdk.AccAddress
+		sender         sdk.AccAddress
+		rewardReceiver sdk.AccAddress
+	}
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	tests := []struct {
+		name                          string
+		param                         param
+		expectedRewardReceiverAddress string
+		expectPass                    bool
+	}{
+		{
+			name: "set reward receiver to another address",
+			param: param{
+				coinsToLock:    sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+				lockOwner:      addr1,
+				sender:         addr1,
+				rewardReceiver: addr2,
+			},
+			expectedRewardReceiverAddress: addr2.String(),
+			expectPass:                    true,
+		},
+		{
+			name: "set reward receiver to the owner clears the reward receiver",
+			param: param{
+				coinsToLock:    sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+				lockOwner:      addr1,
+				sender:         addr1,
+				rewardReceiver: addr1,
+			},
+			expectedRewardReceiverAddress: "",
+			expectPass:                    true,
+		},
+		{
+			name: "sender is not the lock owner",
+			param: param{
+				coinsToLock:    sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+				lockOwner:      addr1,
+				sender:         addr2,
+				rewardReceiver: addr2,
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, test.param.lockOwner, test.param.coinsToLock)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(test.param.lockOwner, time.Second, test.param.coinsToLock))
+		suite.Require().NoError(err)
+
+		_, err = msgServer.SetRewardReceiver(c, types.NewMsgSetRewardReceiver(test.param.sender, resp.ID, test.param.rewardReceiver))
+		if !test.expectPass {
+			suite.Require().Error(err, test.name)
+			continue
+		}
+		suite.Require().NoError(err, test.name)
+
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(test.expectedRewardReceiverAddress, lock.RewardReceiverAddress, test.name)
+		suite.Require().Equal(test.param.rewardReceiver.String(), lock.RewardReceiver(), test.name)
+	}
+}
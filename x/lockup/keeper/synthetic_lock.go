@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -8,7 +9,9 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // A synthetic lock object is a lock obejct used for the superfluid module.
@@ -90,6 +93,76 @@ func (k Keeper) GetAllSyntheticLockups(ctx sdk.Context) []types.SyntheticLock {
 	return synthLocks
 }
 
+// PaginateUnlockingSyntheticLockupsByDenom paginates the unlocking synthetic lockups of the given synthetic denom,
+// ordered by duration and lock id. onResult is called as in query.FilteredPaginate.
+func (k Keeper) PaginateUnlockingSyntheticLockupsByDenom(ctx sdk.Context, synthDenom string, pageReq *query.PageRequest,
+	onResult func(synthLock types.SyntheticLock, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	refPrefix := combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixDenomLockDuration, []byte(synthDenom), []byte{})
+	return k.paginateSyntheticLockRefs(ctx, refPrefix, pageReq, func(_ []byte) string { return synthDenom }, onResult)
+}
+
+// PaginateUnlockingSyntheticLockupsByAddr paginates the unlocking synthetic lockups of the locks owned by the given address,
+// ordered by synthetic denom, duration and lock id. onResult is called as in query.FilteredPaginate.
+func (k Keeper) PaginateUnlockingSyntheticLockupsByAddr(ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest,
+	onResult func(synthLock types.SyntheticLock, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	refPrefix := combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountDenomLockDuration, owner, []byte{})
+	// the refs of the account are keyed by denom first, which never contains the separator
+	denomFromRef := func(key []byte) string {
+		return string(key[:bytes.Index(key, types.KeyIndexSeparator)])
+	}
+	return k.paginateSyntheticLockRefs(ctx, refPrefix, pageReq, denomFromRef, onResult)
+}
+
+// paginateSyntheticLockRefs paginates the synthetic lockups of the lock refs under refPrefix,
+// skipping the refs of the underlying locks themselves.
+func (k Keeper) paginateSyntheticLockRefs(ctx sdk.Context, refPrefix []byte, pageReq *query.PageRequest,
+	denomFromRef func(key []byte) string, onResult func(synthLock types.SyntheticLock, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := pageReq.Offset + limit
+
+	// FilteredPaginate moves the next key past the page onto the refs it skips while counting the total,
+	// so the key of the first synthetic lockup after the page is kept here.
+	var hits uint64
+	var nextKey []byte
+
+	store := ctx.KVStore(k.storeKey)
+	refStore := prefix.NewStore(store, refPrefix)
+	pageRes, err := query.FilteredPaginate(refStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		bz := store.Get(syntheticLockStoreKey(sdk.BigEndianToUint64(value), denomFromRef(key)))
+		if bz == nil {
+			return false, nil
+		}
+		synthLock := types.SyntheticLock{}
+		if err := proto.Unmarshal(bz, &synthLock); err != nil {
+			return false, err
+		}
+		hit, err := onResult(synthLock, accumulate)
+		if hit {
+			if hits == end {
+				nextKey = append([]byte{}, key...)
+			}
+			hits++
+		}
+		return hit, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pageReq.Key) == 0 {
+		pageRes.NextKey = nextKey
+	}
+	return pageRes, nil
+}
+
 // CreateSyntheticLockup create synthetic lockup with lock id and synthdenom.
 func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, synthDenom string, unlockDuration time.Duration, isUnlocking bool) error {
 	// Note: synthetic lockup is doing everything same as lockup except coin movement
@@ -190,6 +263,10 @@ func (k Keeper) DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, synthdenom
 		return err
 	}
 	k.accumulationStore(ctx, synthLock.SynthDenom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+
+	if k.hooks != nil {
+		k.hooks.OnSyntheticLockupDeleted(ctx, lockID, synthdenom)
+	}
 	return nil
 }
 
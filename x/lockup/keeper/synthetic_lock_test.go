@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (suite *KeeperTestSuite) TestSyntheticLockupCreation() {
@@ -209,6 +210,67 @@ func (suite *KeeperTestSuite) TestSyntheticLockupCreateGetDeleteAccumulation() {
 	suite.Require().Equal(accum.String(), "0")
 }
 
+func (suite *KeeperTestSuite) TestPaginateUnlockingSyntheticLockups() {
+	suite.SetupTest()
+
+	// lock coins three times, and unlock the last lock
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	for i := 0; i < 3; i++ {
+		suite.LockTokens(addr1, coins, time.Second)
+	}
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 3, nil)
+	suite.Require().NoError(err)
+
+	// the first two locks have unlocking synthetic lockups, the first one a not unlocking one too
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "stake/bonding", time.Second, false)
+	suite.Require().NoError(err)
+	for _, lockID := range []uint64{1, 2} {
+		err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lockID, "stake/unbonding", time.Second, true)
+		suite.Require().NoError(err)
+	}
+
+	collect := func(synthLocks *[]types.SyntheticLock) func(types.SyntheticLock, bool) (bool, error) {
+		return func(synthLock types.SyntheticLock, accumulate bool) (bool, error) {
+			if accumulate {
+				*synthLocks = append(*synthLocks, synthLock)
+			}
+			return true, nil
+		}
+	}
+
+	// the unlocking lock itself is skipped
+	synthLocks := []types.SyntheticLock{}
+	pageRes, err := suite.App.LockupKeeper.PaginateUnlockingSyntheticLockupsByAddr(suite.Ctx, addr1, &query.PageRequest{Limit: 1, CountTotal: true}, collect(&synthLocks))
+	suite.Require().NoError(err)
+	suite.Require().Len(synthLocks, 1)
+	suite.Require().Equal(uint64(1), synthLocks[0].UnderlyingLockId)
+	suite.Require().Equal("stake/unbonding", synthLocks[0].SynthDenom)
+	suite.Require().Equal(uint64(2), pageRes.Total)
+
+	synthLocks = []types.SyntheticLock{}
+	pageRes, err = suite.App.LockupKeeper.PaginateUnlockingSyntheticLockupsByAddr(suite.Ctx, addr1, &query.PageRequest{Key: pageRes.NextKey, Limit: 1}, collect(&synthLocks))
+	suite.Require().NoError(err)
+	suite.Require().Len(synthLocks, 1)
+	suite.Require().Equal(uint64(2), synthLocks[0].UnderlyingLockId)
+
+	// the key based page stops after its last synthetic lockup, leaving the unlocking lock to the next one
+	synthLocks = []types.SyntheticLock{}
+	_, err = suite.App.LockupKeeper.PaginateUnlockingSyntheticLockupsByAddr(suite.Ctx, addr1, &query.PageRequest{Key: pageRes.NextKey, Limit: 1}, collect(&synthLocks))
+	suite.Require().NoError(err)
+	suite.Require().Len(synthLocks, 0)
+
+	synthLocks = []types.SyntheticLock{}
+	_, err = suite.App.LockupKeeper.PaginateUnlockingSyntheticLockupsByDenom(suite.Ctx, "stake/unbonding", nil, collect(&synthLocks))
+	suite.Require().NoError(err)
+	suite.Require().Len(synthLocks, 2)
+
+	synthLocks = []types.SyntheticLock{}
+	_, err = suite.App.LockupKeeper.PaginateUnlockingSyntheticLockupsByDenom(suite.Ctx, "stake/bonding", nil, collect(&synthLocks))
+	suite.Require().NoError(err)
+	suite.Require().Len(synthLocks, 0)
+}
+
 func (suite *KeeperTestSuite) TestSyntheticLockupDeleteAllMaturedSyntheticLocks() {
 	suite.SetupTest()
 
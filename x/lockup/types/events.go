@@ -2,10 +2,11 @@ package types
 
 // event types.
 const (
-	TypeEvtLockTokens      = "lock_tokens"
-	TypeEvtAddTokensToLock = "add_tokens_to_lock"
-	TypeEvtBeginUnlockAll  = "begin_unlock_all"
-	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtLockTokens        = "lock_tokens"
+	TypeEvtAddTokensToLock   = "add_tokens_to_lock"
+	TypeEvtBeginUnlockAll    = "begin_unlock_all"
+	TypeEvtBeginUnlock       = "begin_unlock"
+	TypeEvtSetRewardReceiver = "set_reward_receiver"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +14,5 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributeRewardReceiver       = "reward_receiver"
 )
@@ -5,11 +5,13 @@ const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state.
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return nil
+	return gs.Params.Validate()
 }
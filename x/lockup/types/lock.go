@@ -38,6 +38,15 @@ func (p PeriodLock) OwnerAddress() sdk.AccAddress {
 	return addr
 }
 
+// RewardReceiver returns the address that receives the rewards of the lock.
+// It defaults to the lock owner when no reward receiver has been set.
+func (p PeriodLock) RewardReceiver() string {
+	if p.RewardReceiverAddress == "" {
+		return p.Owner
+	}
+	return p.RewardReceiverAddress
+}
+
 func (p PeriodLock) SingleCoin() (sdk.Coin, error) {
 	if len(p.Coins) != 1 {
 		return sdk.Coin{}, fmt.Errorf("PeriodLock %d has no single coin: %s", p.ID, p.Coins)
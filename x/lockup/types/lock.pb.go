@@ -37,16 +37,20 @@ const (
 	ByDuration LockQueryType = 0
 	// Queries for lockups that started before a specific time
 	ByTime LockQueryType = 1
+	// Matches no locks, used by incentives gauges that reward unlocked balances
+	NoLock LockQueryType = 2
 )
 
 var LockQueryType_name = map[int32]string{
 	0: "ByDuration",
 	1: "ByTime",
+	2: "NoLock",
 }
 
 var LockQueryType_value = map[string]int32{
 	"ByDuration": 0,
 	"ByTime":     1,
+	"NoLock":     2,
 }
 
 func (x LockQueryType) String() string {
@@ -66,6 +70,9 @@ type PeriodLock struct {
 	Duration time.Duration                            `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 	EndTime  time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// reward_receiver_address is the address that receives the gauge rewards
+	// distributed to this lock. Rewards are sent to the owner when it is empty.
+	RewardReceiverAddress string `protobuf:"bytes,6,opt,name=reward_receiver_address,json=rewardReceiverAddress,proto3" json:"reward_receiver_address,omitempty" yaml:"reward_receiver_address"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -136,6 +143,13 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetRewardReceiverAddress() string {
+	if m != nil {
+		return m.RewardReceiverAddress
+	}
+	return ""
+}
+
 type QueryCondition struct {
 	// type of lock query, ByLockDuration | ByLockTime
 	LockQueryType LockQueryType `protobuf:"varint,1,opt,name=lock_query_type,json=lockQueryType,proto3,enum=osmosis.lockup.LockQueryType" json:"lock_query_type,omitempty"`
@@ -303,45 +317,48 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0xdd, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
-	0xd4, 0xda, 0x4d, 0xd8, 0x18, 0xdd, 0x30, 0x44, 0xea, 0x00, 0xa6, 0x62, 0x60, 0x89, 0xfc, 0xe3,
-	0x70, 0x4e, 0xb1, 0x7d, 0xc6, 0x3f, 0x0a, 0xfe, 0x0f, 0x18, 0x3b, 0x82, 0xc4, 0xc6, 0xc6, 0x5f,
-	0xd2, 0xb1, 0x23, 0x53, 0x8a, 0x12, 0xb1, 0x30, 0xf6, 0x2f, 0x40, 0x77, 0xe7, 0x4b, 0xd2, 0x22,
-	0xa4, 0x0e, 0x30, 0x9d, 0xdf, 0x7d, 0xef, 0x7d, 0xef, 0xdd, 0xf7, 0x3e, 0x19, 0xec, 0x93, 0x2c,
-	0x22, 0x19, 0xce, 0xcc, 0x90, 0x78, 0xd3, 0x22, 0x61, 0x87, 0x91, 0xa4, 0x24, 0x27, 0xb0, 0x59,
-	0x41, 0x06, 0x87, 0x3a, 0x7b, 0x01, 0x09, 0x08, 0x83, 0x4c, 0xfa, 0xc5, 0xb3, 0x3a, 0x6a, 0x40,
-	0x48, 0x10, 0x22, 0x93, 0x45, 0x6e, 0xf1, 0xd6, 0xf4, 0x8b, 0xd4, 0xc9, 0x31, 0x89, 0x2b, 0x5c,
-	0xbb, 0x8d, 0xe7, 0x38, 0x42, 0x59, 0xee, 0x44, 0x89, 0x20, 0xf0, 0x58, 0x1f, 0xd3, 0x75, 0x32,
-	0x64, 0x9e, 0xf5, 0x5d, 0x94, 0x3b, 0x7d, 0xd3, 0x23, 0xb8, 0x22, 0xd0, 0x7f, 0x2a, 0x00, 0xbc,
-	0x40, 0x29, 0x26, 0xfe, 0x09, 0xf1, 0xa6, 0xb0, 0x09, 0x94, 0xd1, 0xb0, 0x2d, 0x77, 0xe5, 0x5e,
-	0xcd, 0x56, 0x46, 0x43, 0xf8, 0x18, 0xd4, 0xc9, 0xfb, 0x18, 0xa5, 0x6d, 0xa5, 0x2b, 0xf7, 0xb6,
-	0xad, 0xd6, 0xf5, 0x4c, 0xbb, 0x5f, 0x3a, 0x51, 0xf8, 0x4c, 0x67, 0xd7, 0xba, 0xcd, 0x61, 0x38,
-	0x01, 0x5b, 0x62, 0xb2, 0xf6, 0x46, 0x57, 0xee, 0xed, 0x0c, 0xf6, 0x0d, 0x3e, 0x9a, 0x21, 0x46,
-	0x33, 0x86, 0x55, 0x82, 0xd5, 0xbf, 0x98, 0x69, 0xd2, 0xaf, 0x99, 0x06, 0x45, 0xc9, 0x01, 0x89,
-	0x70, 0x8e, 0xa2, 0x24, 0x2f, 0xaf, 0x67, 0xda, 0x2e, 0xe7, 0x17, 0x98, 0xfe, 0xe9, 0x4a, 0x93,
-	0xed, 0x25, 0x3b, 0xb4, 0xc1, 0x16, 0x8a, 0xfd, 0x31, 0x7d, 0x67, 0xbb, 0xc6, 0x3a, 0x75, 0xfe,
-	0xe8, 0x74, 0x2a, 0x44, 0xb0, 0x1e, 0xd0, 0x56, 0x2b, 0x52, 0x51, 0xa9, 0x9f, 0x53, 0xd2, 0x7b,
-	0x28, 0xf6, 0x69, 0x2a, 0x74, 0x40, 0x9d, 0x4a, 0x92, 0xb5, 0xeb, 0xdd, 0x0d, 0x36, 0x3a, 0x17,
-	0xcd, 0xa0, 0xa2, 0x19, 0x95, 0x68, 0xc6, 0x31, 0xc1, 0xb1, 0x75, 0x44, 0xf9, 0xbe, 0x5d, 0x69,
-	0xbd, 0x00, 0xe7, 0x93, 0xc2, 0x35, 0x3c, 0x12, 0x99, 0x95, 0xc2, 0xfc, 0x38, 0xcc, 0xfc, 0xa9,
-	0x99, 0x97, 0x09, 0xca, 0x58, 0x41, 0x66, 0x73, 0x66, 0xfd, 0xb3, 0x02, 0x9a, 0x2f, 0x0b, 0x94,
-	0x96, 0xc7, 0x24, 0xf6, 0x31, 0x7b, 0xc9, 0x73, 0xb0, 0x4b, 0x77, 0x3f, 0x7e, 0x47, 0xaf, 0xc7,
-	0xb4, 0x86, 0x09, 0xdf, 0x1c, 0x3c, 0x32, 0x6e, 0x7a, 0xc3, 0xa0, 0xab, 0x61, 0xc5, 0xa7, 0x65,
-	0x82, 0xec, 0x46, 0xb8, 0x1e, 0xc2, 0x3d, 0x50, 0xf7, 0x51, 0x4c, 0x22, 0xbe, 0x22, 0x9b, 0x07,
-	0x54, 0xa6, 0xbb, 0x2f, 0xe4, 0x96, 0x4a, 0x7f, 0x93, 0xfe, 0x35, 0xd8, 0x5e, 0xda, 0xeb, 0x0e,
-	0xda, 0x3f, 0xac, 0x58, 0x5b, 0x9c, 0x75, 0x59, 0xca, 0xc5, 0x5f, 0x51, 0xe9, 0x5f, 0x14, 0xd0,
-	0x78, 0x55, 0xc6, 0xf9, 0x04, 0xe5, 0xd8, 0x63, 0x36, 0x3c, 0x00, 0xb0, 0x88, 0x7d, 0x94, 0x86,
-	0x25, 0x8e, 0x83, 0x31, 0x53, 0x09, 0xfb, 0x95, 0x2d, 0x5b, 0x2b, 0x84, 0xe6, 0x8e, 0x7c, 0xa8,
-	0x81, 0x9d, 0x8c, 0x96, 0x8f, 0xd7, 0x75, 0x00, 0xec, 0x6a, 0x28, 0xc4, 0x58, 0x7a, 0x66, 0xe3,
-	0x1f, 0x79, 0x66, 0xdd, 0xf1, 0xb5, 0xff, 0xe9, 0xf8, 0x27, 0x7d, 0xd0, 0xb8, 0x61, 0x00, 0xd8,
-	0x04, 0xc0, 0x2a, 0x05, 0x77, 0x4b, 0x82, 0x00, 0x6c, 0x5a, 0x25, 0x1d, 0xaa, 0x25, 0x77, 0x6a,
-	0x1f, 0xbf, 0xaa, 0x92, 0x75, 0x72, 0x31, 0x57, 0xe5, 0xcb, 0xb9, 0x2a, 0xff, 0x98, 0xab, 0xf2,
-	0xf9, 0x42, 0x95, 0x2e, 0x17, 0xaa, 0xf4, 0x7d, 0xa1, 0x4a, 0x6f, 0x06, 0x6b, 0xc6, 0xad, 0x5c,
-	0x76, 0x18, 0x3a, 0x6e, 0x26, 0x02, 0xf3, 0xac, 0x7f, 0x64, 0x7e, 0x10, 0xff, 0x2b, 0x66, 0x64,
-	0x77, 0x93, 0x3d, 0xe8, 0xe9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0xd6, 0xd2, 0x8b, 0xce,
-	0x04, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
+	0x1c, 0xdd, 0xee, 0x1f, 0x84, 0x41, 0x96, 0xcd, 0x04, 0xe3, 0xb2, 0x6a, 0xbb, 0xe9, 0xc1, 0x6c,
+	0x0c, 0xb4, 0x2c, 0xde, 0x4c, 0x3c, 0x58, 0xd6, 0x03, 0x09, 0x31, 0x5a, 0x89, 0x07, 0x2e, 0x4d,
+	0xdb, 0x19, 0xcb, 0x84, 0xb6, 0x53, 0x3b, 0x2d, 0xd8, 0x6f, 0xe0, 0x91, 0xa3, 0x26, 0xde, 0xbc,
+	0xf9, 0x2d, 0xbc, 0x71, 0xe4, 0xe8, 0x69, 0x31, 0x70, 0xf3, 0xc8, 0x27, 0x30, 0x33, 0xd3, 0xee,
+	0x2e, 0x18, 0x12, 0x0e, 0x7a, 0x6a, 0x67, 0xde, 0xef, 0xf7, 0xe6, 0xd7, 0xf7, 0xde, 0x14, 0xac,
+	0x52, 0x16, 0x51, 0x46, 0x98, 0x19, 0x52, 0xff, 0x20, 0x4f, 0xc4, 0xc3, 0x48, 0x52, 0x9a, 0x51,
+	0xd8, 0x2e, 0x21, 0x43, 0x42, 0xbd, 0x95, 0x80, 0x06, 0x54, 0x40, 0x26, 0x7f, 0x93, 0x55, 0x3d,
+	0x35, 0xa0, 0x34, 0x08, 0xb1, 0x29, 0x56, 0x5e, 0xfe, 0xde, 0x44, 0x79, 0xea, 0x66, 0x84, 0xc6,
+	0x25, 0xae, 0x5d, 0xc7, 0x33, 0x12, 0x61, 0x96, 0xb9, 0x51, 0x52, 0x11, 0xf8, 0xe2, 0x1c, 0xd3,
+	0x73, 0x19, 0x36, 0x0f, 0x87, 0x1e, 0xce, 0xdc, 0xa1, 0xe9, 0x53, 0x52, 0x12, 0xe8, 0x3f, 0x1a,
+	0x00, 0xbc, 0xc6, 0x29, 0xa1, 0x68, 0x87, 0xfa, 0x07, 0xb0, 0x0d, 0xea, 0xdb, 0xa3, 0xae, 0xd2,
+	0x57, 0x06, 0x4d, 0xbb, 0xbe, 0x3d, 0x82, 0x8f, 0x41, 0x8b, 0x1e, 0xc5, 0x38, 0xed, 0xd6, 0xfb,
+	0xca, 0x60, 0xc1, 0xea, 0x5c, 0x8e, 0xb5, 0xbb, 0x85, 0x1b, 0x85, 0xcf, 0x74, 0xb1, 0xad, 0xdb,
+	0x12, 0x86, 0xfb, 0x60, 0xbe, 0x9a, 0xac, 0xdb, 0xe8, 0x2b, 0x83, 0xc5, 0xcd, 0x55, 0x43, 0x8e,
+	0x66, 0x54, 0xa3, 0x19, 0xa3, 0xb2, 0xc0, 0x1a, 0x9e, 0x8c, 0xb5, 0xda, 0xef, 0xb1, 0x06, 0xab,
+	0x96, 0x35, 0x1a, 0x91, 0x0c, 0x47, 0x49, 0x56, 0x5c, 0x8e, 0xb5, 0x65, 0xc9, 0x5f, 0x61, 0xfa,
+	0xe7, 0x33, 0x4d, 0xb1, 0x27, 0xec, 0xd0, 0x06, 0xf3, 0x38, 0x46, 0x0e, 0xff, 0xce, 0x6e, 0x53,
+	0x9c, 0xd4, 0xfb, 0xeb, 0xa4, 0xdd, 0x4a, 0x04, 0xeb, 0x01, 0x3f, 0x6a, 0x4a, 0x5a, 0x75, 0xea,
+	0xc7, 0x9c, 0xf4, 0x0e, 0x8e, 0x11, 0x2f, 0x85, 0x2e, 0x68, 0x71, 0x49, 0x58, 0xb7, 0xd5, 0x6f,
+	0x88, 0xd1, 0xa5, 0x68, 0x06, 0x17, 0xcd, 0x28, 0x45, 0x33, 0xb6, 0x28, 0x89, 0xad, 0x0d, 0xce,
+	0xf7, 0xfd, 0x4c, 0x1b, 0x04, 0x24, 0xdb, 0xcf, 0x3d, 0xc3, 0xa7, 0x91, 0x59, 0x2a, 0x2c, 0x1f,
+	0xeb, 0x0c, 0x1d, 0x98, 0x59, 0x91, 0x60, 0x26, 0x1a, 0x98, 0x2d, 0x99, 0xe1, 0x1e, 0xb8, 0x9f,
+	0xe2, 0x23, 0x37, 0x45, 0x4e, 0x8a, 0x7d, 0x4c, 0x0e, 0x71, 0xea, 0xb8, 0x08, 0xa5, 0x98, 0xb1,
+	0xee, 0x9c, 0x90, 0x56, 0xbf, 0x1c, 0x6b, 0xaa, 0x9c, 0xf2, 0x86, 0x42, 0xdd, 0xbe, 0x27, 0x11,
+	0xbb, 0x04, 0x5e, 0x94, 0xfb, 0x5f, 0xea, 0xa0, 0xfd, 0x26, 0xc7, 0x69, 0xb1, 0x45, 0x63, 0x44,
+	0x84, 0x4a, 0x2f, 0xc1, 0x32, 0xcf, 0x95, 0xf3, 0x81, 0x6f, 0x3b, 0x7c, 0x1e, 0x61, 0x6a, 0x7b,
+	0xf3, 0x91, 0x71, 0x35, 0x77, 0x06, 0xb7, 0x5d, 0x34, 0xef, 0x16, 0x09, 0xb6, 0x97, 0xc2, 0xd9,
+	0x25, 0x5c, 0x01, 0x2d, 0x84, 0x63, 0x1a, 0x49, 0xfb, 0x6d, 0xb9, 0xe0, 0x16, 0xdc, 0xde, 0xec,
+	0x6b, 0x0e, 0xdc, 0x64, 0xeb, 0x3b, 0xb0, 0x30, 0x89, 0xee, 0x2d, 0x7c, 0x7d, 0x58, 0xb2, 0x76,
+	0x24, 0xeb, 0xa4, 0x55, 0x1a, 0x3b, 0xa5, 0xd2, 0xbf, 0xd6, 0xc1, 0xd2, 0xdb, 0x22, 0xce, 0xf6,
+	0x71, 0x46, 0x7c, 0x11, 0xf1, 0x35, 0x00, 0xf3, 0x18, 0xe1, 0x34, 0x2c, 0x48, 0x1c, 0x38, 0x42,
+	0x25, 0x82, 0xca, 0xc8, 0x77, 0xa6, 0x08, 0xaf, 0xdd, 0x46, 0x50, 0x03, 0x8b, 0x8c, 0xb7, 0x3b,
+	0xb3, 0x3a, 0x00, 0xb1, 0x35, 0xaa, 0xc4, 0x98, 0xe4, 0xb1, 0xf1, 0x8f, 0xf2, 0x38, 0x7b, 0x9b,
+	0x9a, 0xff, 0xf3, 0x36, 0x3d, 0x79, 0x0e, 0x96, 0xae, 0x04, 0x00, 0xb6, 0x01, 0xb0, 0x8a, 0x8a,
+	0xbb, 0x53, 0x83, 0x00, 0xcc, 0x59, 0x05, 0x1f, 0xaa, 0xa3, 0xf0, 0xf7, 0x57, 0x94, 0x97, 0x77,
+	0xea, 0xbd, 0xe6, 0xa7, 0x6f, 0x6a, 0xcd, 0xda, 0x39, 0x39, 0x57, 0x95, 0xd3, 0x73, 0x55, 0xf9,
+	0x75, 0xae, 0x2a, 0xc7, 0x17, 0x6a, 0xed, 0xf4, 0x42, 0xad, 0xfd, 0xbc, 0x50, 0x6b, 0x7b, 0x9b,
+	0x33, 0x17, 0xa4, 0x4c, 0xdc, 0x7a, 0xe8, 0x7a, 0xac, 0x5a, 0x98, 0x87, 0xc3, 0x0d, 0xf3, 0x63,
+	0xf5, 0x5f, 0x14, 0x17, 0xc6, 0x9b, 0x13, 0x1f, 0xf7, 0xf4, 0xcf, 0x00, 0x77, 0xad, 0x00, 0xfa,
+	0x36, 0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -364,6 +381,13 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardReceiverAddress) > 0 {
+		i -= len(m.RewardReceiverAddress)
+		copy(dAtA[i:], m.RewardReceiverAddress)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.RewardReceiverAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -545,6 +569,10 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	l = len(m.RewardReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
 	return n
 }
 
@@ -774,6 +802,38 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
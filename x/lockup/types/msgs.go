@@ -13,6 +13,7 @@ const (
 	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
 	TypeMsgBeginUnlocking    = "begin_unlocking"
 	TypeMsgExtendLockup      = "edit_lockup"
+	TypeMsgSetRewardReceiver = "set_reward_receiver"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -132,3 +133,38 @@ func (m MsgExtendLockup) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgSetRewardReceiver{}
+
+// NewMsgSetRewardReceiver creates a message to set the reward receiver address of a lock.
+func NewMsgSetRewardReceiver(owner sdk.AccAddress, id uint64, rewardReceiver sdk.AccAddress) *MsgSetRewardReceiver {
+	return &MsgSetRewardReceiver{
+		Owner:          owner.String(),
+		ID:             id,
+		RewardReceiver: rewardReceiver.String(),
+	}
+}
+
+func (m MsgSetRewardReceiver) Route() string { return RouterKey }
+func (m MsgSetRewardReceiver) Type() string  { return TypeMsgSetRewardReceiver }
+func (m MsgSetRewardReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return fmt.Errorf("invalid owner address (%s)", err)
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RewardReceiver); err != nil {
+		return fmt.Errorf("invalid reward receiver address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgSetRewardReceiver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetRewardReceiver) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
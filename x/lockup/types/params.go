@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMaxMaturedLocksPerBlock = []byte("MaxMaturedLocksPerBlock")
+
+	// DefaultMaxMaturedLocksPerBlock is the default number of matured locks withdrawn per block.
+	DefaultMaxMaturedLocksPerBlock uint64 = 1000
+)
+
+// ParamKeyTable for lockup module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxMaturedLocksPerBlock uint64) Params {
+	return Params{
+		MaxMaturedLocksPerBlock: maxMaturedLocksPerBlock,
+	}
+}
+
+// DefaultParams returns default lockup module parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxMaturedLocksPerBlock: DefaultMaxMaturedLocksPerBlock,
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateMaxMaturedLocksPerBlock(p.MaxMaturedLocksPerBlock); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxMaturedLocksPerBlock, &p.MaxMaturedLocksPerBlock, validateMaxMaturedLocksPerBlock),
+	}
+}
+
+func validateMaxMaturedLocksPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max matured locks per block must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -1529,6 +1529,166 @@ func (m *AccountLockedLongerDurationDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+type MaturedLocksBacklogRequest struct {
+}
+
+func (m *MaturedLocksBacklogRequest) Reset()         { *m = MaturedLocksBacklogRequest{} }
+func (m *MaturedLocksBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*MaturedLocksBacklogRequest) ProtoMessage()    {}
+func (*MaturedLocksBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{32}
+}
+func (m *MaturedLocksBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaturedLocksBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaturedLocksBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaturedLocksBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaturedLocksBacklogRequest.Merge(m, src)
+}
+func (m *MaturedLocksBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaturedLocksBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaturedLocksBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaturedLocksBacklogRequest proto.InternalMessageInfo
+
+type MaturedLocksBacklogResponse struct {
+	Length uint64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *MaturedLocksBacklogResponse) Reset()         { *m = MaturedLocksBacklogResponse{} }
+func (m *MaturedLocksBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*MaturedLocksBacklogResponse) ProtoMessage()    {}
+func (*MaturedLocksBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{33}
+}
+func (m *MaturedLocksBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaturedLocksBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaturedLocksBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaturedLocksBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaturedLocksBacklogResponse.Merge(m, src)
+}
+func (m *MaturedLocksBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaturedLocksBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaturedLocksBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaturedLocksBacklogResponse proto.InternalMessageInfo
+
+func (m *MaturedLocksBacklogResponse) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{34}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{35}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
@@ -1562,102 +1722,114 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationNotUnlockingOnlyResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationNotUnlockingOnlyResponse")
 	proto.RegisterType((*AccountLockedLongerDurationDenomRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomRequest")
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
+	proto.RegisterType((*MaturedLocksBacklogRequest)(nil), "osmosis.lockup.MaturedLocksBacklogRequest")
+	proto.RegisterType((*MaturedLocksBacklogResponse)(nil), "osmosis.lockup.MaturedLocksBacklogResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb4, 0x4d, 0xa1, 0xaf, 0xf4, 0x87, 0x86, 0xb6, 0x24, 0xdb, 0xd6, 0x4e, 0xb7, 0x6d,
-	0x30, 0x10, 0xef, 0x26, 0x6e, 0xd5, 0x96, 0x2a, 0x6d, 0x52, 0xc7, 0x04, 0x05, 0x19, 0x68, 0xdd,
-	0x42, 0xc5, 0x2f, 0x59, 0x6b, 0x7b, 0xeb, 0xae, 0x62, 0xef, 0xb8, 0xde, 0x75, 0xc1, 0x54, 0xa5,
-	0xa2, 0xe5, 0xc8, 0xa1, 0x88, 0x0b, 0xe2, 0x80, 0x80, 0x1b, 0x1c, 0x10, 0x17, 0x0e, 0x15, 0x77,
-	0x54, 0x81, 0x84, 0x2a, 0x71, 0x41, 0x1c, 0x52, 0x94, 0xf0, 0x17, 0xe4, 0xc4, 0x11, 0xed, 0xcc,
-	0xec, 0xc6, 0xbb, 0xde, 0x5d, 0xef, 0xda, 0x24, 0xca, 0xc9, 0x3f, 0xe6, 0xcd, 0x7b, 0xdf, 0xf7,
-	0xcd, 0xdb, 0x99, 0xf9, 0x16, 0x04, 0x62, 0xd4, 0x89, 0xa1, 0x19, 0x72, 0x8d, 0x94, 0x17, 0x5b,
-	0x0d, 0xf9, 0x46, 0x4b, 0x6d, 0xb6, 0xa5, 0x46, 0x93, 0x98, 0x04, 0xef, 0xe6, 0x63, 0x12, 0x1b,
-	0x13, 0xf6, 0x55, 0x49, 0x95, 0xd0, 0x21, 0xd9, 0xfa, 0xc6, 0xa2, 0x84, 0x44, 0x99, 0x86, 0xc9,
-	0x25, 0xc5, 0x50, 0xe5, 0x9b, 0x53, 0x25, 0xd5, 0x54, 0xa6, 0xe4, 0x32, 0xd1, 0x74, 0x3e, 0x7e,
-	0xa8, 0x4a, 0x48, 0xb5, 0xa6, 0xca, 0x4a, 0x43, 0x93, 0x15, 0x5d, 0x27, 0xa6, 0x62, 0x6a, 0x44,
-	0x37, 0xf8, 0x68, 0x92, 0x8f, 0xd2, 0x5f, 0xa5, 0xd6, 0x35, 0xd9, 0xd4, 0xea, 0xaa, 0x61, 0x2a,
-	0xf5, 0x86, 0x9d, 0xde, 0x1b, 0x50, 0x69, 0x35, 0x69, 0x06, 0x3e, 0x3e, 0xea, 0x21, 0x60, 0x7d,
-	0xb0, 0x21, 0xf1, 0x00, 0xec, 0x7b, 0x95, 0x54, 0x5a, 0x35, 0x35, 0xab, 0xd4, 0x14, 0xbd, 0xac,
-	0x16, 0xd4, 0x1b, 0x2d, 0xd5, 0x30, 0xc5, 0x0f, 0x61, 0xbf, 0xe7, 0x7f, 0xa3, 0x41, 0x74, 0x43,
-	0xc5, 0x0a, 0x0c, 0x5b, 0xc0, 0x8d, 0x11, 0x34, 0xb6, 0x35, 0xb5, 0x33, 0x33, 0x2a, 0x31, 0x6a,
-	0x92, 0x45, 0x4d, 0xe2, 0xd4, 0xa4, 0x39, 0xa2, 0xe9, 0xd9, 0xc9, 0x87, 0x4b, 0xc9, 0xa1, 0xef,
-	0x1f, 0x27, 0x53, 0x55, 0xcd, 0xbc, 0xde, 0x2a, 0x49, 0x65, 0x52, 0x97, 0xb9, 0x0e, 0xec, 0x23,
-	0x6d, 0x54, 0x16, 0x65, 0xb3, 0xdd, 0x50, 0x0d, 0x3a, 0xc1, 0x28, 0xb0, 0xcc, 0xe2, 0x41, 0x18,
-	0x65, 0xb5, 0xf3, 0xa4, 0xbc, 0xa8, 0x56, 0x2e, 0xd4, 0x49, 0x4b, 0x37, 0x6d, 0x60, 0x77, 0x40,
-	0xf0, 0x1b, 0xdc, 0x38, 0x74, 0x2f, 0xc3, 0xe1, 0x0b, 0xe5, 0xb2, 0x55, 0xf5, 0x0d, 0xdd, 0x12,
-	0x52, 0x29, 0xd5, 0x54, 0x16, 0xc0, 0x10, 0xe2, 0x71, 0x18, 0x26, 0xef, 0xeb, 0x6a, 0x73, 0x04,
-	0x8d, 0xa1, 0xd4, 0x8e, 0xec, 0xde, 0xd5, 0xa5, 0xe4, 0x53, 0x6d, 0xa5, 0x5e, 0x3b, 0x2b, 0xd2,
-	0xbf, 0xc5, 0x02, 0x1b, 0x16, 0xef, 0x21, 0x48, 0x04, 0x65, 0xda, 0x38, 0x3a, 0xf3, 0x70, 0xc8,
-	0x05, 0x42, 0xd3, 0xab, 0x7d, 0xb1, 0xb9, 0x8b, 0x3c, 0xba, 0xac, 0x25, 0xda, 0x38, 0x32, 0x73,
-	0x30, 0xca, 0x31, 0xb0, 0xee, 0xe8, 0x8b, 0xc9, 0x1d, 0x10, 0xfc, 0x92, 0x6c, 0x1c, 0x8b, 0xaf,
-	0x90, 0xb3, 0x26, 0x0c, 0xc1, 0x45, 0xc5, 0x30, 0xaf, 0x68, 0x75, 0x35, 0x26, 0x13, 0xfc, 0x26,
-	0xec, 0x70, 0xb6, 0x8a, 0x91, 0x2d, 0x63, 0x28, 0xb5, 0x33, 0x23, 0x48, 0x6c, 0xaf, 0x90, 0xec,
-	0xbd, 0x42, 0xba, 0x62, 0x47, 0x64, 0x0f, 0x59, 0x80, 0x57, 0x97, 0x92, 0x7b, 0x59, 0x2e, 0x67,
-	0xaa, 0x78, 0xff, 0x71, 0x12, 0x15, 0xd6, 0x52, 0x89, 0x57, 0x9d, 0xa5, 0xf6, 0xe2, 0xe3, 0x22,
-	0x9d, 0x82, 0x61, 0xab, 0x05, 0x6c, 0x91, 0x04, 0xc9, 0xbd, 0x4b, 0x4a, 0x17, 0xd5, 0xa6, 0x46,
-	0x2a, 0xd6, 0xe4, 0xec, 0x36, 0xab, 0x68, 0x81, 0x85, 0x8b, 0x3f, 0x20, 0x98, 0xf0, 0xcd, 0xfc,
-	0x1a, 0x59, 0xeb, 0xaa, 0xd7, 0xf5, 0x5a, 0x7b, 0xb3, 0x28, 0x51, 0x85, 0x74, 0x44, 0xbc, 0x03,
-	0x2a, 0xf3, 0x2d, 0x82, 0x31, 0xd7, 0xe3, 0xa5, 0x56, 0xb2, 0xea, 0x35, 0xd2, 0x54, 0x37, 0x53,
-	0x5f, 0xbc, 0x03, 0x47, 0x42, 0x30, 0x0e, 0xa8, 0xc0, 0x03, 0xe4, 0x64, 0x77, 0x6b, 0x9d, 0x53,
-	0x75, 0x52, 0xdf, 0x24, 0x12, 0xe0, 0x7d, 0x30, 0x5c, 0xb1, 0xf0, 0x8c, 0x6c, 0xb5, 0xea, 0x17,
-	0xd8, 0x0f, 0xf1, 0x5d, 0x10, 0xc3, 0xa0, 0x0f, 0xa8, 0xcc, 0x47, 0x80, 0x59, 0x5a, 0x97, 0x12,
-	0x0e, 0x12, 0xd4, 0x81, 0x04, 0x17, 0xe0, 0x49, 0xfb, 0x72, 0xc0, 0x69, 0x8f, 0x76, 0xd1, 0xce,
-	0xf1, 0x80, 0xec, 0x41, 0xce, 0x7a, 0x0f, 0x63, 0x6d, 0x4f, 0x14, 0xbf, 0xb0, 0x48, 0x3b, 0x79,
-	0x44, 0x1d, 0x9e, 0x76, 0xd5, 0xe7, 0x74, 0xae, 0xc2, 0x76, 0x85, 0x9e, 0xce, 0x7c, 0x2d, 0x66,
-	0xac, 0x6c, 0x7f, 0x2d, 0x25, 0xc7, 0x23, 0xec, 0x87, 0x0b, 0xba, 0xb9, 0xba, 0x94, 0xdc, 0xc5,
-	0xea, 0xb2, 0x2c, 0x62, 0x81, 0xa7, 0x13, 0x53, 0xb0, 0x8b, 0xd5, 0xb3, 0xa9, 0x3e, 0x03, 0x4f,
-	0x58, 0x4a, 0x14, 0xb5, 0x0a, 0x2d, 0xb5, 0xad, 0xb0, 0xdd, 0xfa, 0xb9, 0x50, 0x11, 0x67, 0x61,
-	0xb7, 0x1d, 0xc9, 0x41, 0x49, 0xb0, 0xcd, 0x1a, 0xa3, 0x71, 0xa1, 0x12, 0x17, 0x68, 0x9c, 0x38,
-	0x0d, 0x47, 0x2e, 0xb7, 0x75, 0xf3, 0xba, 0x6a, 0x6a, 0xe5, 0x3c, 0x8d, 0x31, 0xb2, 0x6d, 0xf6,
-	0x65, 0x21, 0xd7, 0xb3, 0x7e, 0x13, 0xc4, 0xb0, 0xd9, 0x1c, 0x53, 0x1e, 0xf6, 0x18, 0x76, 0x54,
-	0xb1, 0xb3, 0x03, 0x0e, 0x7b, 0xe1, 0xb9, 0x92, 0xf1, 0x26, 0xd8, 0x6d, 0x74, 0xfe, 0x69, 0x88,
-	0x5f, 0x23, 0x4f, 0xb3, 0xe5, 0x89, 0x5e, 0x55, 0x9b, 0xf6, 0xa2, 0xc6, 0x7d, 0x50, 0xd6, 0xa3,
-	0x61, 0xde, 0x83, 0xa3, 0xa1, 0x08, 0x07, 0x7c, 0x1e, 0xbe, 0xf4, 0x9e, 0x9f, 0x9b, 0x89, 0xbb,
-	0xf7, 0xec, 0xfc, 0xdf, 0x58, 0xff, 0x88, 0x20, 0x13, 0xa2, 0xea, 0xa0, 0x27, 0xe8, 0x7a, 0x68,
-	0x51, 0x87, 0x13, 0xb1, 0x10, 0x0f, 0xa8, 0xd0, 0xcf, 0x08, 0x9e, 0x0d, 0xa9, 0xd7, 0xd7, 0x39,
-	0xb2, 0x0e, 0xb2, 0x04, 0x9c, 0x21, 0x25, 0x48, 0xf5, 0x06, 0x3f, 0x98, 0x42, 0x99, 0x8f, 0x47,
-	0x60, 0xf8, 0x92, 0xe5, 0x6e, 0xf1, 0xa7, 0x08, 0x76, 0xb9, 0x0c, 0x20, 0x3e, 0xe6, 0x4d, 0xe2,
-	0xe7, 0x1b, 0x85, 0xe3, 0x3d, 0xa2, 0x18, 0x40, 0x51, 0xba, 0xfb, 0xc7, 0x3f, 0x9f, 0x6f, 0x49,
-	0xe1, 0x71, 0xd9, 0x63, 0x4d, 0x6d, 0x73, 0x5c, 0xa7, 0xd3, 0x8a, 0x25, 0x5e, 0xfc, 0x1b, 0x04,
-	0xb8, 0xdb, 0xf6, 0xe1, 0xe7, 0xfc, 0xab, 0xf9, 0xf8, 0x46, 0xe1, 0xf9, 0x28, 0xa1, 0x1c, 0xdd,
-	0x49, 0x8a, 0x4e, 0xc2, 0x13, 0x3d, 0xd0, 0xb1, 0x3b, 0x4e, 0x91, 0x1d, 0x4b, 0xf8, 0x01, 0x82,
-	0x03, 0xfe, 0x7e, 0x0e, 0xa7, 0xbd, 0xc5, 0x43, 0x1d, 0xa4, 0x20, 0x45, 0x0d, 0xe7, 0x78, 0x67,
-	0x29, 0xde, 0xb3, 0xf8, 0x4c, 0x10, 0x5e, 0x85, 0xcd, 0x2f, 0xb6, 0x9c, 0x04, 0x45, 0x6a, 0x35,
-	0xe4, 0x5b, 0xb4, 0x8b, 0x6f, 0xe3, 0x9f, 0x10, 0xec, 0xf7, 0x75, 0x6f, 0x78, 0x22, 0x14, 0x8b,
-	0xc7, 0x2d, 0x0a, 0xe9, 0x88, 0xd1, 0x1c, 0xf8, 0x0c, 0x05, 0xfe, 0x22, 0x3e, 0x1d, 0x0d, 0xb8,
-	0xa6, 0x57, 0x3d, 0xb8, 0xbf, 0x43, 0x80, 0xbb, 0xcd, 0x5a, 0x77, 0x5f, 0x04, 0xba, 0xc2, 0xee,
-	0xbe, 0x08, 0xf6, 0x7e, 0xe2, 0x34, 0x85, 0x7b, 0x0a, 0x9f, 0xec, 0x05, 0x97, 0x37, 0x46, 0xa0,
-	0xc6, 0xee, 0x5b, 0x60, 0xa0, 0xc6, 0xbe, 0xee, 0x2f, 0x50, 0x63, 0x7f, 0x2f, 0x16, 0x5d, 0x63,
-	0x0e, 0xba, 0xa1, 0x18, 0xa6, 0x75, 0x9f, 0x75, 0x70, 0xff, 0x8b, 0xe0, 0x78, 0x24, 0x93, 0x83,
-	0xa7, 0x23, 0x21, 0x0b, 0x38, 0x89, 0x84, 0x73, 0x7d, 0xce, 0xe6, 0x3c, 0x0b, 0x94, 0x67, 0x1e,
-	0xbf, 0x12, 0x93, 0x67, 0x51, 0x27, 0x9d, 0xfd, 0x45, 0xf4, 0x5a, 0xdb, 0xa1, 0xfe, 0x0b, 0x72,
-	0x5e, 0x28, 0x74, 0x3b, 0x1a, 0x3c, 0x19, 0xda, 0xec, 0x3e, 0x06, 0x4d, 0x98, 0x8a, 0x31, 0x83,
-	0xd3, 0xca, 0x51, 0x5a, 0xe7, 0xf1, 0x74, 0xb4, 0x47, 0x44, 0xad, 0x14, 0x4b, 0x34, 0x49, 0xd1,
-	0xb5, 0x86, 0xbf, 0x22, 0xcf, 0x4b, 0x0d, 0x97, 0x03, 0xc1, 0x53, 0x91, 0xa4, 0xef, 0x3c, 0x20,
-	0x85, 0x4c, 0x9c, 0x29, 0x9c, 0xcb, 0x4b, 0x94, 0xcb, 0x0c, 0x3e, 0x17, 0x77, 0x89, 0xe8, 0x09,
-	0xe8, 0x90, 0xf9, 0x04, 0xc1, 0xce, 0x0e, 0xc3, 0x81, 0x45, 0x2f, 0x94, 0x6e, 0x37, 0x24, 0x1c,
-	0x0d, 0x8d, 0xe1, 0xf8, 0x26, 0x28, 0xbe, 0x71, 0x7c, 0x2c, 0x08, 0x1f, 0xc7, 0xc5, 0xac, 0xd4,
-	0x3d, 0x04, 0xc0, 0xb2, 0x64, 0xdb, 0x0b, 0x39, 0x7c, 0xd8, 0xbf, 0x82, 0x0d, 0x20, 0x11, 0x34,
-	0xcc, 0x6b, 0x9f, 0xa2, 0xb5, 0x27, 0xb1, 0xd4, 0xa3, 0x76, 0xa9, 0x5d, 0xd4, 0x2a, 0xf2, 0x2d,
-	0xee, 0x37, 0x6e, 0xe3, 0xdf, 0x10, 0x08, 0xc1, 0x1e, 0xa3, 0x7b, 0x65, 0x7b, 0xba, 0x99, 0xee,
-	0x95, 0xed, 0x6d, 0x61, 0xc4, 0x79, 0x8a, 0x7e, 0x16, 0x9f, 0x0f, 0x42, 0xef, 0x36, 0x38, 0xad,
-	0x86, 0x61, 0x11, 0xe1, 0x24, 0x3a, 0xd8, 0xfc, 0x8e, 0xe0, 0x60, 0xc8, 0x2d, 0x07, 0x87, 0x77,
-	0x9d, 0xaf, 0xd3, 0x11, 0x4e, 0xc4, 0x9a, 0x13, 0x95, 0x90, 0xa7, 0x55, 0x6b, 0x34, 0x4d, 0xd1,
-	0xbe, 0xc3, 0x05, 0x6f, 0xfa, 0x0e, 0x95, 0xf0, 0x4d, 0xdf, 0x4b, 0x22, 0x1d, 0x31, 0xba, 0xcf,
-	0x4d, 0xbf, 0x0b, 0xf7, 0x67, 0x5b, 0xe0, 0x85, 0x18, 0x77, 0x73, 0x9c, 0x8d, 0x21, 0x72, 0xd0,
-	0x01, 0x30, 0x37, 0x50, 0x0e, 0xce, 0xfc, 0x2d, 0xca, 0xfc, 0x32, 0xbe, 0xd4, 0xdf, 0xc2, 0x85,
-	0x9d, 0x06, 0x2b, 0x6b, 0xef, 0xe0, 0x02, 0xaf, 0xe0, 0xf8, 0x74, 0x0c, 0x12, 0xae, 0x1d, 0xea,
-	0x4c, 0xfc, 0x89, 0x9c, 0x72, 0x9e, 0x52, 0x9e, 0xc7, 0xb9, 0x3e, 0x29, 0xbb, 0x76, 0xd7, 0x6c,
-	0xfe, 0xe1, 0x72, 0x02, 0x3d, 0x5a, 0x4e, 0xa0, 0xbf, 0x97, 0x13, 0xe8, 0xfe, 0x4a, 0x62, 0xe8,
-	0xd1, 0x4a, 0x62, 0xe8, 0xcf, 0x95, 0xc4, 0xd0, 0xdb, 0x99, 0x8e, 0x17, 0x37, 0xbc, 0x52, 0xba,
-	0xa6, 0x94, 0x0c, 0xa7, 0xec, 0xcd, 0xa9, 0x49, 0xf9, 0x03, 0xbb, 0x38, 0x7d, 0x91, 0x53, 0xda,
-	0x4e, 0x5d, 0xd0, 0x89, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x70, 0x24, 0x96, 0x44, 0x1b,
-	0x00, 0x00,
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0xdb, 0x44,
+	0x14, 0xdf, 0x69, 0xbb, 0x0b, 0x7d, 0xa5, 0x1f, 0x9a, 0x6e, 0x97, 0x5d, 0xef, 0x6e, 0xb2, 0x75,
+	0xdb, 0x25, 0xb4, 0x1b, 0x7b, 0x37, 0xfd, 0xa4, 0xda, 0x7e, 0xa5, 0x4b, 0xd1, 0x42, 0x0a, 0x6d,
+	0x5a, 0xa8, 0xf8, 0x52, 0xe4, 0x24, 0x6e, 0x6a, 0x6d, 0xe2, 0x49, 0x63, 0xa7, 0x10, 0xaa, 0x52,
+	0xa9, 0xe5, 0xc8, 0xa1, 0x88, 0x0b, 0xe2, 0x80, 0x00, 0xc1, 0x01, 0x0e, 0x88, 0x0b, 0x87, 0x8a,
+	0x3b, 0xaa, 0x40, 0x42, 0x95, 0xb8, 0x20, 0x0e, 0x5b, 0xd4, 0xe5, 0x2f, 0xe8, 0x89, 0x23, 0xf2,
+	0xcc, 0xd8, 0x8d, 0x1d, 0xdb, 0xb1, 0x13, 0xba, 0xda, 0x53, 0x62, 0xbf, 0x37, 0xbf, 0xf7, 0xfb,
+	0x3d, 0x8f, 0x67, 0xe6, 0x67, 0x10, 0x88, 0x51, 0x23, 0x86, 0x66, 0xc8, 0x55, 0x52, 0x5a, 0x6a,
+	0xd6, 0xe5, 0xab, 0x4d, 0xb5, 0xd1, 0x92, 0xea, 0x0d, 0x62, 0x12, 0xbc, 0x85, 0xc7, 0x24, 0x16,
+	0x13, 0x86, 0x2b, 0xa4, 0x42, 0x68, 0x48, 0xb6, 0xfe, 0xb1, 0x2c, 0x21, 0x51, 0xa2, 0x69, 0x72,
+	0x51, 0x31, 0x54, 0xf9, 0xda, 0x5c, 0x51, 0x35, 0x95, 0x39, 0xb9, 0x44, 0x34, 0x9d, 0xc7, 0x27,
+	0x2a, 0x84, 0x54, 0xaa, 0xaa, 0xac, 0xd4, 0x35, 0x59, 0xd1, 0x75, 0x62, 0x2a, 0xa6, 0x46, 0x74,
+	0x83, 0x47, 0x93, 0x3c, 0x4a, 0xaf, 0x8a, 0xcd, 0xcb, 0xb2, 0xa9, 0xd5, 0x54, 0xc3, 0x54, 0x6a,
+	0x75, 0x1b, 0xde, 0x9b, 0x50, 0x6e, 0x36, 0x28, 0x02, 0x8f, 0x8f, 0x79, 0x04, 0x58, 0x3f, 0x3c,
+	0x34, 0xee, 0x09, 0xd5, 0x95, 0x86, 0x52, 0xe3, 0x85, 0xc5, 0x11, 0x18, 0x3e, 0x4b, 0xca, 0xcd,
+	0xaa, 0x9a, 0x55, 0xaa, 0x8a, 0x5e, 0x52, 0xf3, 0xea, 0xd5, 0xa6, 0x6a, 0x98, 0xe2, 0x07, 0xb0,
+	0xc3, 0x73, 0xdf, 0xa8, 0x13, 0xdd, 0x50, 0xb1, 0x02, 0x83, 0x96, 0x2a, 0x63, 0x14, 0x4d, 0xad,
+	0x4f, 0x6d, 0xca, 0x8c, 0x49, 0x4c, 0xb7, 0x64, 0xe9, 0x96, 0xb8, 0x6e, 0xe9, 0x34, 0xd1, 0xf4,
+	0xec, 0xec, 0xbd, 0xe5, 0xe4, 0xc0, 0xf7, 0x0f, 0x92, 0xa9, 0x8a, 0x66, 0x5e, 0x69, 0x16, 0xa5,
+	0x12, 0xa9, 0xc9, 0xbc, 0x49, 0xec, 0x27, 0x6d, 0x94, 0x97, 0x64, 0xb3, 0x55, 0x57, 0x0d, 0x3a,
+	0xc0, 0xc8, 0x33, 0x64, 0x71, 0x1c, 0xc6, 0x58, 0xed, 0x1c, 0x29, 0x2d, 0xa9, 0xe5, 0x53, 0x35,
+	0xd2, 0xd4, 0x4d, 0x9b, 0xd8, 0x4d, 0x10, 0xfc, 0x82, 0xab, 0xc7, 0xee, 0x25, 0x98, 0x3c, 0x55,
+	0x2a, 0x59, 0x55, 0x5f, 0xd7, 0xad, 0x8e, 0x2a, 0xc5, 0xaa, 0xca, 0x12, 0x18, 0x43, 0x3c, 0x0d,
+	0x83, 0xe4, 0x3d, 0x5d, 0x6d, 0x8c, 0xa2, 0x29, 0x94, 0xda, 0x98, 0xdd, 0xf6, 0x68, 0x39, 0xf9,
+	0x4c, 0x4b, 0xa9, 0x55, 0x8f, 0x8a, 0xf4, 0xb6, 0x98, 0x67, 0x61, 0xf1, 0x36, 0x82, 0x44, 0x10,
+	0xd2, 0xea, 0xc9, 0x39, 0x03, 0x13, 0x2e, 0x12, 0x9a, 0x5e, 0xe9, 0x49, 0xcd, 0x2d, 0x04, 0x93,
+	0x01, 0x40, 0xab, 0x27, 0xe6, 0x34, 0x8c, 0x71, 0x0e, 0x6c, 0x76, 0xf4, 0xa4, 0xe4, 0x26, 0x08,
+	0x7e, 0x20, 0xab, 0xa7, 0xe2, 0x0b, 0x04, 0x13, 0x2e, 0x06, 0xe7, 0x14, 0xc3, 0xbc, 0xa8, 0xd5,
+	0xd4, 0x98, 0x4a, 0xf0, 0x1b, 0xb0, 0xd1, 0x59, 0x47, 0x46, 0xd7, 0x4d, 0xa1, 0xd4, 0xa6, 0x8c,
+	0x20, 0xb1, 0x85, 0x44, 0xb2, 0x17, 0x12, 0xe9, 0xa2, 0x9d, 0x91, 0x9d, 0xb0, 0x08, 0x3f, 0x5a,
+	0x4e, 0x6e, 0x63, 0x58, 0xce, 0x50, 0xf1, 0xce, 0x83, 0x24, 0xca, 0x3f, 0x86, 0x12, 0x2f, 0xc1,
+	0x64, 0x00, 0x3f, 0xde, 0xa4, 0x43, 0x30, 0x68, 0x4d, 0x01, 0xbb, 0x49, 0x82, 0xe4, 0x5e, 0x42,
+	0xa5, 0x73, 0x6a, 0x43, 0x23, 0x65, 0x6b, 0x70, 0x76, 0x83, 0x55, 0x34, 0xcf, 0xd2, 0xc5, 0x1f,
+	0x10, 0xcc, 0xf8, 0x22, 0xbf, 0x4a, 0x1e, 0xcf, 0xaa, 0xd7, 0xf4, 0x6a, 0x6b, 0xad, 0x74, 0xa2,
+	0x02, 0xe9, 0x88, 0x7c, 0xfb, 0xec, 0xcc, 0xd7, 0x08, 0xa6, 0x5c, 0xaf, 0x97, 0x5a, 0xce, 0xaa,
+	0x97, 0x49, 0x43, 0x5d, 0x4b, 0xf3, 0xe2, 0x6d, 0xd8, 0x19, 0xc2, 0xb1, 0xcf, 0x0e, 0xdc, 0x45,
+	0x0e, 0xba, 0xbb, 0xd7, 0x0b, 0xaa, 0x4e, 0x6a, 0x6b, 0xa4, 0x05, 0x78, 0x18, 0x06, 0xcb, 0x16,
+	0x9f, 0xd1, 0xf5, 0x56, 0xfd, 0x3c, 0xbb, 0x10, 0xdf, 0x01, 0x31, 0x8c, 0x7a, 0x9f, 0x9d, 0xf9,
+	0x10, 0x30, 0x83, 0x75, 0x75, 0xc2, 0x61, 0x82, 0xda, 0x98, 0xe0, 0x3c, 0x3c, 0x6d, 0x9f, 0x1c,
+	0xb8, 0xec, 0xb1, 0x0e, 0xd9, 0x0b, 0x3c, 0x21, 0x3b, 0xce, 0x55, 0x6f, 0x65, 0xaa, 0xed, 0x81,
+	0xe2, 0x67, 0x96, 0x68, 0x07, 0x47, 0xd4, 0x61, 0xbb, 0xab, 0x3e, 0x97, 0x73, 0x09, 0x86, 0x14,
+	0xba, 0x3b, 0xf3, 0x67, 0x71, 0xc2, 0x42, 0xfb, 0x6b, 0x39, 0x39, 0x1d, 0x61, 0x3d, 0x5c, 0xd4,
+	0xcd, 0x47, 0xcb, 0xc9, 0xcd, 0xac, 0x2e, 0x43, 0x11, 0xf3, 0x1c, 0x4e, 0x4c, 0xc1, 0x66, 0x56,
+	0xcf, 0x96, 0xfa, 0x2c, 0x3c, 0x65, 0x75, 0xa2, 0xa0, 0x95, 0x69, 0xa9, 0x0d, 0xf9, 0x21, 0xeb,
+	0x72, 0xb1, 0x2c, 0x9e, 0x84, 0x2d, 0x76, 0x26, 0x27, 0x25, 0xc1, 0x06, 0x2b, 0x46, 0xf3, 0x42,
+	0x5b, 0x9c, 0xa7, 0x79, 0xe2, 0x3c, 0xec, 0xbc, 0xd0, 0xd2, 0xcd, 0x2b, 0xaa, 0xa9, 0x95, 0x72,
+	0x34, 0xc7, 0xc8, 0xb6, 0xd8, 0x9f, 0xc5, 0x85, 0xae, 0xf5, 0x1b, 0x20, 0x86, 0x8d, 0xe6, 0x9c,
+	0x72, 0xb0, 0xd5, 0xb0, 0xb3, 0x0a, 0xed, 0x33, 0x60, 0xd2, 0x4b, 0xcf, 0x05, 0xc6, 0x27, 0xc1,
+	0x16, 0xa3, 0xfd, 0xa6, 0x21, 0x7e, 0x89, 0x3c, 0x93, 0x2d, 0x47, 0xf4, 0x8a, 0xda, 0xb0, 0x1f,
+	0x6a, 0xdc, 0x17, 0xe5, 0x49, 0x4c, 0x98, 0x77, 0x61, 0x57, 0x28, 0xc3, 0x3e, 0xdf, 0x87, 0xcf,
+	0xbd, 0xfb, 0xe7, 0x5a, 0xd2, 0xee, 0xdd, 0x3b, 0xff, 0x37, 0xd5, 0x3f, 0x22, 0xc8, 0x84, 0x74,
+	0xb5, 0xdf, 0x1d, 0xf4, 0x49, 0xf4, 0xa2, 0x06, 0xfb, 0x63, 0x31, 0xee, 0xb3, 0x43, 0x3f, 0x23,
+	0x78, 0x2e, 0xa4, 0x5e, 0x4f, 0xfb, 0xc8, 0x13, 0x68, 0x4b, 0xc0, 0x1e, 0x52, 0x84, 0x54, 0x77,
+	0xf2, 0x7d, 0x76, 0x68, 0x02, 0x84, 0xb3, 0x8a, 0xd9, 0x6c, 0xa8, 0x34, 0x66, 0x64, 0x95, 0xd2,
+	0x52, 0x95, 0x54, 0x6c, 0xeb, 0x75, 0x10, 0xc6, 0x7d, 0xa3, 0xbc, 0xe8, 0x08, 0x0c, 0x55, 0x55,
+	0xbd, 0x62, 0x5e, 0x71, 0x16, 0x41, 0x7a, 0x25, 0x0e, 0x03, 0x3e, 0x6f, 0xd9, 0xe9, 0x73, 0xd4,
+	0x77, 0xda, 0x60, 0xaf, 0xc0, 0x76, 0xd7, 0x5d, 0x0e, 0x72, 0x00, 0x86, 0x98, 0x3f, 0xe5, 0x2b,
+	0xf4, 0x48, 0x07, 0x75, 0x1a, 0xe5, 0xb4, 0x79, 0x6e, 0xe6, 0x1b, 0x01, 0x06, 0x29, 0x1a, 0xfe,
+	0x18, 0xc1, 0x66, 0x97, 0x71, 0xc5, 0xbb, 0xbd, 0x08, 0x7e, 0x7e, 0x57, 0xd8, 0xd3, 0x25, 0x8b,
+	0xd1, 0x13, 0xa5, 0x5b, 0x7f, 0xfc, 0xf3, 0xe9, 0xba, 0x14, 0x9e, 0x96, 0x3d, 0xa6, 0xda, 0x76,
+	0xfc, 0x35, 0x3a, 0xac, 0x50, 0xe4, 0xc5, 0xbf, 0x42, 0x80, 0x3b, 0xed, 0x2a, 0x7e, 0xde, 0xbf,
+	0x9a, 0x8f, 0xdf, 0x15, 0xf6, 0x46, 0x49, 0xe5, 0xec, 0x0e, 0x50, 0x76, 0x12, 0x9e, 0xe9, 0xc2,
+	0x8e, 0x9d, 0xcd, 0x0a, 0x6c, 0x3b, 0xc5, 0x77, 0x11, 0x8c, 0xf8, 0xfb, 0x50, 0x9c, 0xf6, 0x16,
+	0x0f, 0x75, 0xbe, 0x82, 0x14, 0x35, 0x9d, 0xf3, 0x3d, 0x49, 0xf9, 0x1e, 0xc5, 0x47, 0x82, 0xf8,
+	0x2a, 0x6c, 0x7c, 0xa1, 0xe9, 0x00, 0x14, 0xa8, 0x45, 0x92, 0xaf, 0xd3, 0xb7, 0xef, 0x06, 0xfe,
+	0x09, 0xc1, 0x0e, 0x5f, 0xd7, 0x89, 0x67, 0x42, 0xb9, 0x78, 0x5c, 0xae, 0x90, 0x8e, 0x98, 0xcd,
+	0x89, 0x9f, 0xa0, 0xc4, 0x5f, 0xc0, 0x87, 0xa3, 0x11, 0xd7, 0xf4, 0x8a, 0x87, 0xf7, 0x77, 0x08,
+	0x70, 0xa7, 0xc9, 0xec, 0x9c, 0x17, 0x81, 0x6e, 0x56, 0xd8, 0x1b, 0x25, 0x95, 0xd3, 0x9d, 0xa7,
+	0x74, 0x0f, 0xe1, 0x03, 0xdd, 0xe8, 0xf2, 0x89, 0x11, 0xd8, 0x63, 0xf7, 0xe9, 0x35, 0xb0, 0xc7,
+	0xbe, 0xae, 0x55, 0x48, 0x47, 0xcc, 0x8e, 0xdb, 0x63, 0x4e, 0xba, 0xae, 0x18, 0xa6, 0x75, 0x0e,
+	0x77, 0x78, 0xff, 0x8b, 0x60, 0x4f, 0x24, 0x73, 0x86, 0xe7, 0x23, 0x31, 0x0b, 0xd8, 0x41, 0x85,
+	0x63, 0x3d, 0x8e, 0xe6, 0x3a, 0xf3, 0x54, 0x67, 0x0e, 0xbf, 0x1c, 0x53, 0x67, 0x41, 0x27, 0xed,
+	0xf3, 0x8b, 0xe8, 0xd5, 0x96, 0x23, 0xfd, 0x17, 0xe4, 0x7c, 0x08, 0xe9, 0x74, 0x62, 0x78, 0x36,
+	0x74, 0xb2, 0xfb, 0x18, 0x4b, 0x61, 0x2e, 0xc6, 0x08, 0x2e, 0x6b, 0x81, 0xca, 0x3a, 0x8e, 0xe7,
+	0xa3, 0xbd, 0x22, 0x6a, 0xb9, 0x50, 0xa4, 0x20, 0x05, 0xd7, 0x33, 0xfc, 0x15, 0x81, 0xe0, 0xdb,
+	0x4e, 0xba, 0xdf, 0xe1, 0xb9, 0x48, 0xad, 0x6f, 0xdf, 0xd8, 0x85, 0x4c, 0x9c, 0x21, 0x5c, 0xcb,
+	0x8b, 0x54, 0xcb, 0x09, 0x7c, 0x2c, 0xee, 0x23, 0xa2, 0x3b, 0xb7, 0x23, 0xe6, 0x23, 0x04, 0x9b,
+	0xda, 0x8c, 0x12, 0x16, 0xbd, 0x54, 0x3a, 0x5d, 0x9c, 0xb0, 0x2b, 0x34, 0x87, 0xf3, 0x9b, 0xa1,
+	0xfc, 0xa6, 0xf1, 0xee, 0x20, 0x7e, 0x9c, 0x17, 0xb3, 0x80, 0xb7, 0x11, 0x00, 0x43, 0xc9, 0xb6,
+	0x16, 0x17, 0xf0, 0xa4, 0x7f, 0x05, 0x9b, 0x40, 0x22, 0x28, 0xcc, 0x6b, 0x1f, 0xa2, 0xb5, 0x67,
+	0xb1, 0xd4, 0xa5, 0x76, 0xb1, 0x55, 0xd0, 0xca, 0xf2, 0x75, 0xee, 0x93, 0x6e, 0xe0, 0xdf, 0x10,
+	0x08, 0xc1, 0xde, 0xa8, 0xf3, 0xc9, 0x76, 0x75, 0x61, 0x42, 0x26, 0xce, 0x10, 0xce, 0xfe, 0x0c,
+	0x65, 0x7f, 0x12, 0x1f, 0x0f, 0x62, 0xef, 0x36, 0x66, 0xcd, 0xba, 0x61, 0x09, 0xe1, 0x22, 0xda,
+	0xd4, 0xfc, 0x8e, 0x60, 0x3c, 0xe4, 0x74, 0x86, 0xc3, 0x67, 0x9d, 0xaf, 0x43, 0x13, 0xf6, 0xc7,
+	0x1a, 0x13, 0x55, 0x90, 0x67, 0xaa, 0x56, 0x29, 0x4c, 0xc1, 0x3e, 0x7b, 0x06, 0x2f, 0xfa, 0x8e,
+	0x94, 0xf0, 0x45, 0xdf, 0x2b, 0x22, 0x1d, 0x31, 0xbb, 0xc7, 0x45, 0xbf, 0x83, 0xf7, 0x27, 0xeb,
+	0x60, 0x5f, 0x0c, 0x4f, 0x81, 0xb3, 0x31, 0x9a, 0x1c, 0xb4, 0x01, 0x9c, 0xee, 0x0b, 0x83, 0x2b,
+	0x7f, 0x93, 0x2a, 0xbf, 0x80, 0xcf, 0xf7, 0xf6, 0xe0, 0xc2, 0x76, 0x83, 0x95, 0xc7, 0xdf, 0x0e,
+	0x03, 0xad, 0x03, 0x3e, 0x1c, 0x43, 0x84, 0x6b, 0x85, 0x3a, 0x12, 0x7f, 0x20, 0x97, 0x9c, 0xa3,
+	0x92, 0xcf, 0xe0, 0x85, 0x1e, 0x25, 0xbb, 0x57, 0xd7, 0x6f, 0x11, 0x6c, 0xf7, 0xb1, 0x27, 0xb8,
+	0xf3, 0x00, 0x1d, 0xe8, 0x70, 0x84, 0x7d, 0x91, 0x72, 0x39, 0xfd, 0x83, 0x94, 0xbe, 0x8c, 0xd3,
+	0x81, 0xa7, 0x6d, 0x36, 0x98, 0xd2, 0x37, 0x0a, 0x45, 0xce, 0xa7, 0x05, 0x43, 0xcc, 0xc3, 0x74,
+	0xae, 0xff, 0x9d, 0x36, 0x49, 0xd8, 0x15, 0x9a, 0xc3, 0x99, 0x4c, 0x53, 0x26, 0x53, 0x38, 0x11,
+	0xc4, 0x84, 0xd9, 0xa4, 0x6c, 0xee, 0xde, 0xc3, 0x04, 0xba, 0xff, 0x30, 0x81, 0xfe, 0x7e, 0x98,
+	0x40, 0x77, 0x56, 0x12, 0x03, 0xf7, 0x57, 0x12, 0x03, 0x7f, 0xae, 0x24, 0x06, 0xde, 0xca, 0xb4,
+	0x7d, 0x93, 0xe3, 0x18, 0xe9, 0xaa, 0x52, 0x34, 0x1c, 0xc0, 0x6b, 0x73, 0xb3, 0xf2, 0xfb, 0x36,
+	0x2c, 0xfd, 0x46, 0x57, 0x1c, 0xa2, 0x06, 0x77, 0xff, 0x7f, 0x03, 0x00, 0x1d, 0x23, 0xd9, 0x1d,
+	0x3c, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1706,6 +1878,10 @@ type QueryClient interface {
 	AccountLockedLongerDurationNotUnlockingOnly(ctx context.Context, in *AccountLockedLongerDurationNotUnlockingOnlyRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns the number of matured locks waiting to be withdrawn
+	MaturedLocksBacklog(ctx context.Context, in *MaturedLocksBacklogRequest, opts ...grpc.CallOption) (*MaturedLocksBacklogResponse, error)
+	// Params returns lockup module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1860,6 +2036,24 @@ func (c *queryClient) AccountLockedLongerDurationDenom(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryClient) MaturedLocksBacklog(ctx context.Context, in *MaturedLocksBacklogRequest, opts ...grpc.CallOption) (*MaturedLocksBacklogResponse, error) {
+	out := new(MaturedLocksBacklogResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/MaturedLocksBacklog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Return full balance of the module
@@ -1896,6 +2090,10 @@ type QueryServer interface {
 	AccountLockedLongerDurationNotUnlockingOnly(context.Context, *AccountLockedLongerDurationNotUnlockingOnlyRequest) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns the number of matured locks waiting to be withdrawn
+	MaturedLocksBacklog(context.Context, *MaturedLocksBacklogRequest) (*MaturedLocksBacklogResponse, error)
+	// Params returns lockup module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1950,6 +2148,12 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationNotUnlockingOnly(ctx
 func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Context, req *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedLongerDurationDenom not implemented")
 }
+func (*UnimplementedQueryServer) MaturedLocksBacklog(ctx context.Context, req *MaturedLocksBacklogRequest) (*MaturedLocksBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaturedLocksBacklog not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2243,6 +2447,42 @@ func _Query_AccountLockedLongerDurationDenom_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MaturedLocksBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaturedLocksBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaturedLocksBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/MaturedLocksBacklog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaturedLocksBacklog(ctx, req.(*MaturedLocksBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2311,6 +2551,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountLockedLongerDurationDenom",
 			Handler:    _Query_AccountLockedLongerDurationDenom_Handler,
 		},
+		{
+			MethodName: "MaturedLocksBacklog",
+			Handler:    _Query_MaturedLocksBacklog_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/query.proto",
@@ -3450,6 +3698,113 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *MaturedLocksBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaturedLocksBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaturedLocksBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MaturedLocksBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaturedLocksBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaturedLocksBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3919,6 +4274,47 @@ func (m *AccountLockedLongerDurationDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *MaturedLocksBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MaturedLocksBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovQuery(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6854,6 +7250,258 @@ func (m *AccountLockedLongerDurationDenomResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MaturedLocksBacklogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaturedLocksBacklogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaturedLocksBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaturedLocksBacklogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaturedLocksBacklogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaturedLocksBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
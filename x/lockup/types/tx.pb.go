@@ -439,6 +439,112 @@ func (m *MsgExtendLockupResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgSetRewardReceiver sets the address that receives the gauge rewards
+// distributed to the lock. Setting it to the lock owner resets the receiver.
+type MsgSetRewardReceiver struct {
+	Owner          string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID             uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	RewardReceiver string `protobuf:"bytes,3,opt,name=reward_receiver,json=rewardReceiver,proto3" json:"reward_receiver,omitempty" yaml:"reward_receiver"`
+}
+
+func (m *MsgSetRewardReceiver) Reset()         { *m = MsgSetRewardReceiver{} }
+func (m *MsgSetRewardReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardReceiver) ProtoMessage()    {}
+func (*MsgSetRewardReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{8}
+}
+func (m *MsgSetRewardReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardReceiver.Merge(m, src)
+}
+func (m *MsgSetRewardReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardReceiver proto.InternalMessageInfo
+
+func (m *MsgSetRewardReceiver) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSetRewardReceiver) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgSetRewardReceiver) GetRewardReceiver() string {
+	if m != nil {
+		return m.RewardReceiver
+	}
+	return ""
+}
+
+type MsgSetRewardReceiverResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgSetRewardReceiverResponse) Reset()         { *m = MsgSetRewardReceiverResponse{} }
+func (m *MsgSetRewardReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardReceiverResponse) ProtoMessage()    {}
+func (*MsgSetRewardReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{9}
+}
+func (m *MsgSetRewardReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardReceiverResponse.Merge(m, src)
+}
+func (m *MsgSetRewardReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardReceiverResponse proto.InternalMessageInfo
+
+func (m *MsgSetRewardReceiverResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
 	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
@@ -448,49 +554,56 @@ func init() {
 	proto.RegisterType((*MsgBeginUnlockingResponse)(nil), "osmosis.lockup.MsgBeginUnlockingResponse")
 	proto.RegisterType((*MsgExtendLockup)(nil), "osmosis.lockup.MsgExtendLockup")
 	proto.RegisterType((*MsgExtendLockupResponse)(nil), "osmosis.lockup.MsgExtendLockupResponse")
+	proto.RegisterType((*MsgSetRewardReceiver)(nil), "osmosis.lockup.MsgSetRewardReceiver")
+	proto.RegisterType((*MsgSetRewardReceiverResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0x8d, 0x1d, 0x4a, 0xcb, 0x51, 0x52, 0x6a, 0x15, 0x35, 0xb1, 0xc0, 0x0e, 0x16, 0xd0, 0x20,
-	0xb5, 0x77, 0x4d, 0x0a, 0x0b, 0x03, 0x12, 0x21, 0x0c, 0x95, 0x1a, 0x09, 0x59, 0x45, 0x42, 0x0c,
-	0x48, 0xb6, 0x73, 0x5c, 0xad, 0x38, 0x3e, 0x2b, 0x67, 0x97, 0x64, 0xe7, 0x0f, 0x60, 0xe4, 0x6f,
-	0x60, 0x60, 0xe1, 0x9f, 0xe8, 0xd8, 0x91, 0x29, 0x45, 0xc9, 0xc6, 0xd8, 0x99, 0x01, 0xf9, 0xec,
-	0xb3, 0xf2, 0x4b, 0x24, 0x42, 0xa2, 0xd3, 0xe5, 0xee, 0x7d, 0xdf, 0xfb, 0xbe, 0xf7, 0xf2, 0x12,
-	0xb0, 0x4d, 0x59, 0x87, 0x32, 0x97, 0x21, 0x8f, 0x3a, 0xed, 0x28, 0x40, 0x61, 0x0f, 0x06, 0x5d,
-	0x1a, 0x52, 0xa5, 0x90, 0x02, 0x30, 0x01, 0xd4, 0x2d, 0x42, 0x09, 0xe5, 0x10, 0x8a, 0x3f, 0x25,
-	0x55, 0xaa, 0x46, 0x28, 0x25, 0x1e, 0x46, 0xfc, 0x66, 0x47, 0x1f, 0x50, 0x2b, 0xea, 0x5a, 0xa1,
-	0x4b, 0x7d, 0x81, 0x3b, 0x9c, 0x06, 0xd9, 0x16, 0xc3, 0xe8, 0xb4, 0x6a, 0xe3, 0xd0, 0xaa, 0x22,
-	0x87, 0xba, 0x02, 0x2f, 0x4d, 0x8d, 0x8f, 0x8f, 0x04, 0x32, 0x3e, 0xc9, 0xe0, 0x56, 0x93, 0x91,
-	0x23, 0xea, 0xb4, 0x8f, 0x69, 0x1b, 0xfb, 0x4c, 0x79, 0x04, 0x56, 0xe8, 0x47, 0x1f, 0x77, 0x8b,
-	0x52, 0x59, 0xaa, 0xdc, 0xa8, 0xdf, 0xbe, 0x1c, 0xe8, 0xeb, 0x7d, 0xab, 0xe3, 0x3d, 0x33, 0xf8,
-	0xb3, 0x61, 0x26, 0xb0, 0x72, 0x02, 0xd6, 0xc4, 0x1a, 0x45, 0xb9, 0x2c, 0x55, 0x6e, 0xd6, 0x4a,
-	0x30, 0xd9, 0x13, 0x8a, 0x3d, 0x61, 0x23, 0x2d, 0xa8, 0x57, 0xcf, 0x06, 0x7a, 0xee, 0xd7, 0x40,
-	0x57, 0x44, 0xcb, 0x2e, 0xed, 0xb8, 0x21, 0xee, 0x04, 0x61, 0xff, 0x72, 0xa0, 0x6f, 0x24, 0xfc,
-	0x02, 0x33, 0xbe, 0x5c, 0xe8, 0x92, 0x99, 0xb1, 0x2b, 0x16, 0x58, 0x89, 0xc5, 0xb0, 0x62, 0xbe,
-	0x9c, 0xe7, 0x63, 0x12, 0xb9, 0x30, 0x96, 0x0b, 0x53, 0xb9, 0xf0, 0x25, 0x75, 0xfd, 0xfa, 0x7e,
-	0x3c, 0xe6, 0xeb, 0x85, 0x5e, 0x21, 0x6e, 0x78, 0x12, 0xd9, 0xd0, 0xa1, 0x1d, 0x94, 0x7a, 0x93,
-	0x1c, 0x7b, 0xac, 0xd5, 0x46, 0x61, 0x3f, 0xc0, 0x8c, 0x37, 0x30, 0x33, 0x61, 0x36, 0x76, 0xc0,
-	0x9d, 0x09, 0x17, 0x4c, 0xcc, 0x02, 0xea, 0x33, 0xac, 0x14, 0x80, 0x7c, 0xd8, 0xe0, 0x56, 0x5c,
-	0x33, 0xe5, 0xc3, 0x86, 0xf1, 0x1c, 0x6c, 0x35, 0x19, 0xa9, 0x63, 0xe2, 0xfa, 0x6f, 0xfc, 0xd8,
-	0x47, 0xd7, 0x27, 0x2f, 0x3c, 0x6f, 0x59, 0xd7, 0x8c, 0x63, 0x70, 0x77, 0x5e, 0x7f, 0x36, 0xef,
-	0x09, 0x58, 0x8d, 0xf8, 0x3b, 0x2b, 0x4a, 0x5c, 0xad, 0x0a, 0x27, 0x23, 0x02, 0x5f, 0xe3, 0xae,
-	0x4b, 0x5b, 0xf1, 0xaa, 0xa6, 0x28, 0x35, 0xbe, 0x49, 0x60, 0x73, 0x86, 0x76, 0xe9, 0x6f, 0x32,
-	0xd1, 0x28, 0x0b, 0x8d, 0x57, 0xe1, 0xf7, 0x53, 0x50, 0x9a, 0xd9, 0x37, 0xf3, 0xa0, 0x08, 0x56,
-	0x59, 0xe4, 0x38, 0x98, 0x31, 0xbe, 0xf9, 0x9a, 0x29, 0xae, 0xc6, 0x77, 0x09, 0x6c, 0x34, 0x19,
-	0x79, 0xd5, 0x0b, 0xb1, 0xcf, 0x2d, 0x88, 0x82, 0x7f, 0x56, 0x39, 0x9e, 0xdf, 0xfc, 0xff, 0xcc,
-	0xaf, 0x71, 0x00, 0xb6, 0xa7, 0x96, 0x5e, 0x2c, 0xb5, 0xf6, 0x5b, 0x06, 0xf9, 0x26, 0x23, 0x8a,
-	0x09, 0xc0, 0xd8, 0x8f, 0xf3, 0xde, 0x74, 0x1a, 0x26, 0x52, 0xab, 0x3e, 0xfc, 0x2b, 0x9c, 0x4d,
-	0x25, 0x60, 0x73, 0x36, 0xc1, 0x0f, 0xe6, 0xf4, 0xce, 0x54, 0xa9, 0xbb, 0xcb, 0x54, 0x65, 0x83,
-	0xde, 0x83, 0xc2, 0x54, 0x26, 0xef, 0x2f, 0xec, 0x57, 0x1f, 0x2f, 0x2c, 0xc9, 0xf8, 0xdf, 0x82,
-	0xf5, 0x89, 0x2c, 0xe8, 0x73, 0x5a, 0xc7, 0x0b, 0xd4, 0x9d, 0x05, 0x05, 0x82, 0xb9, 0x7e, 0x74,
-	0x36, 0xd4, 0xa4, 0xf3, 0xa1, 0x26, 0xfd, 0x1c, 0x6a, 0xd2, 0xe7, 0x91, 0x96, 0x3b, 0x1f, 0x69,
-	0xb9, 0x1f, 0x23, 0x2d, 0xf7, 0xae, 0x36, 0x96, 0xf5, 0x94, 0x6c, 0xcf, 0xb3, 0x6c, 0x26, 0x2e,
-	0xe8, 0xb4, 0xba, 0x8f, 0x7a, 0xd9, 0x3f, 0x7d, 0x9c, 0x7d, 0xfb, 0x3a, 0x4f, 0xd4, 0xc1, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xf8, 0x55, 0x1e, 0x08, 0x06, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x93, 0xaf, 0x5f, 0xdb, 0xa1, 0xa4, 0xd4, 0x2a, 0x34, 0xb5, 0x8a, 0x5d, 0x46, 0x40,
+	0x8b, 0xd4, 0x7a, 0x9a, 0x16, 0x24, 0xc4, 0x02, 0x09, 0xb7, 0x2c, 0x2a, 0x35, 0x12, 0x32, 0x45,
+	0x42, 0x2c, 0x40, 0xb6, 0x33, 0x4c, 0xad, 0x38, 0x1e, 0xcb, 0x63, 0xb7, 0xc9, 0x9e, 0x07, 0x40,
+	0x62, 0xc3, 0x33, 0xb0, 0x60, 0xc3, 0x4b, 0x74, 0xd9, 0x25, 0xab, 0x14, 0x25, 0x62, 0xc3, 0x32,
+	0x4f, 0x80, 0x3c, 0xfe, 0x51, 0x12, 0x47, 0x24, 0xaa, 0x04, 0x2b, 0x67, 0x7c, 0xcf, 0x3d, 0xf7,
+	0x9e, 0x93, 0x7b, 0xc7, 0x60, 0x85, 0xb2, 0x26, 0x65, 0x36, 0x43, 0x0e, 0xb5, 0x1a, 0xa1, 0x87,
+	0x82, 0x96, 0xea, 0xf9, 0x34, 0xa0, 0x62, 0x39, 0x09, 0xa8, 0x71, 0x40, 0x5a, 0x26, 0x94, 0x50,
+	0x1e, 0x42, 0xd1, 0xaf, 0x18, 0x25, 0xc9, 0x84, 0x52, 0xe2, 0x60, 0xc4, 0x4f, 0x66, 0xf8, 0x1e,
+	0xd5, 0x43, 0xdf, 0x08, 0x6c, 0xea, 0xa6, 0x71, 0x8b, 0xd3, 0x20, 0xd3, 0x60, 0x18, 0x9d, 0x56,
+	0x4d, 0x1c, 0x18, 0x55, 0x64, 0x51, 0x3b, 0x8d, 0xaf, 0x8e, 0x94, 0x8f, 0x1e, 0x71, 0x08, 0x7e,
+	0x28, 0x82, 0xeb, 0x35, 0x46, 0x8e, 0xa8, 0xd5, 0x38, 0xa6, 0x0d, 0xec, 0x32, 0xf1, 0x3e, 0x98,
+	0xa1, 0x67, 0x2e, 0xf6, 0x2b, 0xc2, 0xba, 0xb0, 0x39, 0xaf, 0xdd, 0xe8, 0x77, 0x94, 0x85, 0xb6,
+	0xd1, 0x74, 0x9e, 0x40, 0xfe, 0x1a, 0xea, 0x71, 0x58, 0x3c, 0x01, 0x73, 0x69, 0x1b, 0x95, 0xe2,
+	0xba, 0xb0, 0x79, 0x6d, 0x77, 0x55, 0x8d, 0xfb, 0x54, 0xd3, 0x3e, 0xd5, 0x83, 0x04, 0xa0, 0x55,
+	0xcf, 0x3b, 0x4a, 0xe1, 0x57, 0x47, 0x11, 0xd3, 0x94, 0x2d, 0xda, 0xb4, 0x03, 0xdc, 0xf4, 0x82,
+	0x76, 0xbf, 0xa3, 0x2c, 0xc6, 0xfc, 0x69, 0x0c, 0x7e, 0xbe, 0x54, 0x04, 0x3d, 0x63, 0x17, 0x0d,
+	0x30, 0x13, 0x89, 0x61, 0x95, 0xd2, 0x7a, 0x89, 0x97, 0x89, 0xe5, 0xaa, 0x91, 0x5c, 0x35, 0x91,
+	0xab, 0xee, 0x53, 0xdb, 0xd5, 0x76, 0xa2, 0x32, 0x5f, 0x2e, 0x95, 0x4d, 0x62, 0x07, 0x27, 0xa1,
+	0xa9, 0x5a, 0xb4, 0x89, 0x12, 0x6f, 0xe2, 0xc7, 0x36, 0xab, 0x37, 0x50, 0xd0, 0xf6, 0x30, 0xe3,
+	0x09, 0x4c, 0x8f, 0x99, 0xe1, 0x06, 0xb8, 0x39, 0xe4, 0x82, 0x8e, 0x99, 0x47, 0x5d, 0x86, 0xc5,
+	0x32, 0x28, 0x1e, 0x1e, 0x70, 0x2b, 0xfe, 0xd3, 0x8b, 0x87, 0x07, 0xf0, 0x29, 0x58, 0xae, 0x31,
+	0xa2, 0x61, 0x62, 0xbb, 0xaf, 0xdc, 0xc8, 0x47, 0xdb, 0x25, 0xcf, 0x1c, 0x67, 0x5a, 0xd7, 0xe0,
+	0x31, 0x58, 0x1b, 0x97, 0x9f, 0xd5, 0x7b, 0x08, 0x66, 0x43, 0xfe, 0x9e, 0x55, 0x04, 0xae, 0x56,
+	0x52, 0x87, 0x47, 0x44, 0x7d, 0x81, 0x7d, 0x9b, 0xd6, 0xa3, 0x56, 0xf5, 0x14, 0x0a, 0xbf, 0x0a,
+	0x60, 0x29, 0x47, 0x3b, 0xf5, 0x3f, 0x19, 0x6b, 0x2c, 0xa6, 0x1a, 0xff, 0x85, 0xdf, 0x8f, 0xc0,
+	0x6a, 0xae, 0xdf, 0xcc, 0x83, 0x0a, 0x98, 0x65, 0xa1, 0x65, 0x61, 0xc6, 0x78, 0xe7, 0x73, 0x7a,
+	0x7a, 0x84, 0xdf, 0x04, 0xb0, 0x58, 0x63, 0xe4, 0x79, 0x2b, 0xc0, 0x2e, 0xb7, 0x20, 0xf4, 0xae,
+	0xac, 0x72, 0x70, 0x7e, 0x4b, 0x7f, 0x73, 0x7e, 0xe1, 0x1e, 0x58, 0x19, 0x69, 0x7a, 0x0a, 0xa9,
+	0x9f, 0x04, 0x3e, 0x69, 0x2f, 0x71, 0xa0, 0xe3, 0x33, 0xc3, 0xaf, 0xeb, 0xd8, 0xc2, 0xf6, 0x29,
+	0xf6, 0xaf, 0xac, 0x77, 0x1f, 0x2c, 0xfa, 0x9c, 0xe9, 0x9d, 0x9f, 0x50, 0x71, 0xd9, 0xf3, 0x9a,
+	0xd4, 0xef, 0x28, 0xb7, 0x62, 0x86, 0x11, 0x00, 0xd4, 0xcb, 0xfe, 0x50, 0x71, 0xf8, 0x18, 0xac,
+	0x8d, 0x6b, 0x6a, 0xb2, 0x9e, 0xdd, 0x9f, 0x25, 0x50, 0xaa, 0x31, 0x22, 0xea, 0x00, 0x0c, 0x5c,
+	0x36, 0xb7, 0x47, 0xa7, 0x7b, 0x68, 0x0b, 0xa5, 0x7b, 0x7f, 0x0c, 0x67, 0x55, 0x09, 0x58, 0xca,
+	0x6f, 0xe4, 0xdd, 0x31, 0xb9, 0x39, 0x94, 0xb4, 0x35, 0x0d, 0x2a, 0x2b, 0xf4, 0x16, 0x94, 0x47,
+	0x76, 0xec, 0xce, 0xc4, 0x7c, 0xe9, 0xc1, 0x44, 0x48, 0xc6, 0xff, 0x1a, 0x2c, 0x0c, 0xcd, 0xb6,
+	0x32, 0x26, 0x75, 0x10, 0x20, 0x6d, 0x4c, 0x00, 0x0c, 0x5a, 0x94, 0x1f, 0xa5, 0x71, 0x16, 0xe5,
+	0x50, 0xd2, 0xd6, 0x34, 0xa8, 0xb4, 0x90, 0x76, 0x74, 0xde, 0x95, 0x85, 0x8b, 0xae, 0x2c, 0xfc,
+	0xe8, 0xca, 0xc2, 0xc7, 0x9e, 0x5c, 0xb8, 0xe8, 0xc9, 0x85, 0xef, 0x3d, 0xb9, 0xf0, 0x66, 0x77,
+	0xe0, 0x92, 0x48, 0x18, 0xb7, 0x1d, 0xc3, 0x64, 0xe9, 0x01, 0x9d, 0x56, 0x77, 0x50, 0x2b, 0xfb,
+	0x44, 0x46, 0x97, 0x86, 0xf9, 0x3f, 0x5f, 0xc5, 0xbd, 0xdf, 0x03, 0x00, 0x0e, 0xac, 0x20, 0x32,
+	0x41, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -513,6 +626,8 @@ type MsgClient interface {
 	BeginUnlocking(ctx context.Context, in *MsgBeginUnlocking, opts ...grpc.CallOption) (*MsgBeginUnlockingResponse, error)
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
+	// SetRewardReceiver sets the address that receives the rewards of a lock
+	SetRewardReceiver(ctx context.Context, in *MsgSetRewardReceiver, opts ...grpc.CallOption) (*MsgSetRewardReceiverResponse, error)
 }
 
 type msgClient struct {
@@ -559,6 +674,15 @@ func (c *msgClient) ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts
 	return out, nil
 }
 
+func (c *msgClient) SetRewardReceiver(ctx context.Context, in *MsgSetRewardReceiver, opts ...grpc.CallOption) (*MsgSetRewardReceiverResponse, error) {
+	out := new(MsgSetRewardReceiverResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SetRewardReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -569,6 +693,8 @@ type MsgServer interface {
 	BeginUnlocking(context.Context, *MsgBeginUnlocking) (*MsgBeginUnlockingResponse, error)
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
+	// SetRewardReceiver sets the address that receives the rewards of a lock
+	SetRewardReceiver(context.Context, *MsgSetRewardReceiver) (*MsgSetRewardReceiverResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -587,6 +713,9 @@ func (*UnimplementedMsgServer) BeginUnlocking(ctx context.Context, req *MsgBegin
 func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendLockup) (*MsgExtendLockupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExtendLockup not implemented")
 }
+func (*UnimplementedMsgServer) SetRewardReceiver(ctx context.Context, req *MsgSetRewardReceiver) (*MsgSetRewardReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiver not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -664,6 +793,24 @@ func _Msg_ExtendLockup_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRewardReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/SetRewardReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardReceiver(ctx, req.(*MsgSetRewardReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -684,6 +831,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExtendLockup",
 			Handler:    _Msg_ExtendLockup_Handler,
 		},
+		{
+			MethodName: "SetRewardReceiver",
+			Handler:    _Msg_SetRewardReceiver_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -994,6 +1145,81 @@ func (m *MsgExtendLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRewardReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardReceiver) > 0 {
+		i -= len(m.RewardReceiver)
+		copy(dAtA[i:], m.RewardReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1130,6 +1356,38 @@ func (m *MsgExtendLockupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRewardReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.RewardReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1929,6 +2187,209 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRewardReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // GetQueryCmd returns the cli query commands for the minting module.
@@ -25,6 +28,9 @@ func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryEpochProvisions(),
+		GetCmdQueryInflation(),
+		GetCmdQueryProjectedSupply(),
+		GetCmdQueryNextEpochMint(),
 	)
 
 	return mintingQueryCmd
@@ -87,3 +93,101 @@ func GetCmdQueryEpochProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryInflation implements a command to return the current annualized
+// inflation of the mint denom.
+func GetCmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Short: "Query the current annualized inflation of the mint denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Inflation(context.Background(), &types.QueryInflationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProjectedSupply implements a command to return the supply of the
+// mint denom projected at the end of a future epoch, or of the last epoch ending
+// by a date.
+func GetCmdQueryProjectedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-supply [epoch-or-date]",
+		Short: "Query the supply of the mint denom projected at the end of an epoch, or by an RFC3339 date",
+		Example: fmt.Sprintf(`$ %[1]s query mint projected-supply 730
+$ %[1]s query mint projected-supply 2025-06-19T00:00:00Z`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProjectedSupplyRequest{}
+			if epoch, err := strconv.ParseInt(args[0], 10, 64); err == nil {
+				req.Epoch = epoch
+			} else {
+				t, err := time.Parse(time.RFC3339, args[0])
+				if err != nil {
+					return fmt.Errorf("%s is neither an epoch number nor an RFC3339 date", args[0])
+				}
+				req.Time = &t
+			}
+
+			res, err := queryClient.ProjectedSupply(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNextEpochMint implements a command to return the provisions minted
+// at the end of the current epoch, broken down per distribution proportion.
+func GetCmdQueryNextEpochMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-epoch-mint",
+		Short: "Query the provisions minted at the end of the current epoch per distribution proportion",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextEpochMint(context.Background(), &types.QueryNextEpochMintRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
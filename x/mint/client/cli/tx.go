@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
+)
+
+const FlagDistributionProportions = "distribution-proportions"
+
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "mint transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdSubmitUpdateDeveloperRewardsReceiversProposal(),
+	)
+
+	return txCmd
+}
+
+func NewCmdSubmitUpdateDeveloperRewardsReceiversProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-developer-rewards-receivers [address:weight,...]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update to the weighted developer rewards receivers, and optionally the distribution proportions",
+		Long: `Submit an update to the weighted developer rewards receivers. The weights must add up to 1, and an empty address
+sends its share to the community pool. The distribution proportions are given as staking,pool_incentives,developer_rewards,community_pool.`,
+		Example: fmt.Sprintf(`$ %s tx mint update-developer-rewards-receivers osmo1...:0.6,osmo1...:0.4 --distribution-proportions 0.25,0.45,0.25,0.05 --title="..." --description="..." --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receivers, err := parseWeightedAddresses(args[0])
+			if err != nil {
+				return err
+			}
+
+			proportionsStr, err := cmd.Flags().GetString(FlagDistributionProportions)
+			if err != nil {
+				return err
+			}
+			var proportions *types.DistributionProportions
+			if proportionsStr != "" {
+				proportions, err = parseDistributionProportions(proportionsStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateDeveloperRewardsReceiversProposal(title, description, receivers, proportions)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagDistributionProportions, "", "new distribution proportions as staking,pool_incentives,developer_rewards,community_pool, unchanged if empty")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+// parseWeightedAddresses parses comma separated address:weight pairs.
+func parseWeightedAddresses(arg string) ([]types.WeightedAddress, error) {
+	pairs := strings.Split(arg, ",")
+	receivers := make([]types.WeightedAddress, len(pairs))
+	for i, pair := range pairs {
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("receiver %q is not formatted as address:weight", pair)
+		}
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of receiver %q: %w", pair, err)
+		}
+		receivers[i] = types.WeightedAddress{Address: parts[0], Weight: weight}
+	}
+	return receivers, nil
+}
+
+// parseDistributionProportions parses comma separated staking, pool incentives, developer rewards
+// and community pool proportions.
+func parseDistributionProportions(arg string) (*types.DistributionProportions, error) {
+	parts := strings.Split(arg, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("distribution proportions %q are not formatted as staking,pool_incentives,developer_rewards,community_pool", arg)
+	}
+	proportions := make([]sdk.Dec, len(parts))
+	for i, part := range parts {
+		proportion, err := sdk.NewDecFromStr(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid distribution proportion %q: %w", part, err)
+		}
+		proportions[i] = proportion
+	}
+	return &types.DistributionProportions{
+		Staking:          proportions[0],
+		PoolIncentives:   proportions[1],
+		DeveloperRewards: proportions[2],
+		CommunityPool:    proportions[3],
+	}, nil
+}
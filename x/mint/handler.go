@@ -0,0 +1,25 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/mint/keeper"
+	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
+)
+
+func NewUpdateDeveloperRewardsReceiversProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateDeveloperRewardsReceiversProposal:
+			return handleUpdateDeveloperRewardsReceiversProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized mint proposal content type: %T", c)
+		}
+	}
+}
+
+func handleUpdateDeveloperRewardsReceiversProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateDeveloperRewardsReceiversProposal) error {
+	return k.HandleUpdateDeveloperRewardsReceiversProposal(ctx, p)
+}
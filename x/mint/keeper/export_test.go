@@ -14,6 +14,7 @@ type (
 const (
 	EmptyWeightedAddressReceiver = emptyWeightedAddressReceiver
 	DeveloperVestingAmount       = developerVestingAmount
+	SupplyProjectionGasPerEpoch  = supplyProjectionGasPerEpoch
 )
 
 var (
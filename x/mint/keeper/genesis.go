@@ -15,6 +15,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 
 	data.Minter.EpochProvisions = data.Params.GenesisEpochProvisions
+	data.Minter.ReductionMode = data.Params.ReductionMode
 	k.SetMinter(ctx, data.Minter)
 	k.SetParams(ctx, data.Params)
 
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
+)
+
+// HandleUpdateDeveloperRewardsReceiversProposal replaces the weighted developer rewards receivers, and the distribution
+// proportions if the proposal has any, and emits an event listing the old and new ones.
+func (k Keeper) HandleUpdateDeveloperRewardsReceiversProposal(ctx sdk.Context, p *types.UpdateDeveloperRewardsReceiversProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	oldReceivers := params.WeightedDeveloperRewardsReceivers
+	oldProportions := params.DistributionProportions
+
+	params.WeightedDeveloperRewardsReceivers = p.WeightedDeveloperRewardsReceivers
+	if p.DistributionProportions != nil {
+		params.DistributionProportions = *p.DistributionProportions
+	}
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateDeveloperRewardsReceivers,
+		sdk.NewAttribute(types.AttributeOldReceivers, types.FormatWeightedAddresses(oldReceivers)),
+		sdk.NewAttribute(types.AttributeNewReceivers, types.FormatWeightedAddresses(params.WeightedDeveloperRewardsReceivers)),
+		sdk.NewAttribute(types.AttributeOldProportions, oldProportions.String()),
+		sdk.NewAttribute(types.AttributeNewProportions, params.DistributionProportions.String()),
+	))
+	return nil
+}
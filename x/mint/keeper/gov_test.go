@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleUpdateDeveloperRewardsReceiversProposal() {
+	proportions := types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(3, 1),
+		PoolIncentives:   sdk.NewDecWithPrec(3, 1),
+		DeveloperRewards: sdk.NewDecWithPrec(3, 1),
+		CommunityPool:    sdk.NewDecWithPrec(1, 1),
+	}
+
+	tests := map[string]struct {
+		receivers   []types.WeightedAddress
+		proportions *types.DistributionProportions
+		expectErr   bool
+	}{
+		"receivers only": {
+			receivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: sdk.NewDecWithPrec(6, 1)},
+				{Address: "", Weight: sdk.NewDecWithPrec(4, 1)},
+			},
+		},
+		"receivers and proportions": {
+			receivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: sdk.OneDec()},
+			},
+			proportions: &proportions,
+		},
+		"no receivers": {},
+		"weights not adding up to 1": {
+			receivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: sdk.NewDecWithPrec(6, 1)},
+				{Address: testAddressTwo.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+			},
+			expectErr: true,
+		},
+		"invalid address": {
+			receivers: []types.WeightedAddress{
+				{Address: "osmo1invalid", Weight: sdk.OneDec()},
+			},
+			expectErr: true,
+		},
+		"duplicate address": {
+			receivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: testAddressOne.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			expectErr: true,
+		},
+		"proportions not adding up to 1": {
+			receivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: sdk.OneDec()},
+			},
+			proportions: &types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(5, 1),
+				PoolIncentives:   sdk.NewDecWithPrec(5, 1),
+				DeveloperRewards: sdk.NewDecWithPrec(5, 1),
+				CommunityPool:    sdk.ZeroDec(),
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			oldParams := suite.App.MintKeeper.GetParams(ctx)
+
+			proposal := types.NewUpdateDeveloperRewardsReceiversProposal("title", "description", tc.receivers, tc.proportions)
+			err := suite.App.MintKeeper.HandleUpdateDeveloperRewardsReceiversProposal(ctx, &proposal)
+
+			params := suite.App.MintKeeper.GetParams(ctx)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Error(proposal.ValidateBasic())
+				suite.Require().Equal(oldParams, params)
+				suite.Require().Empty(ctx.EventManager().Events())
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.receivers, params.WeightedDeveloperRewardsReceivers)
+			expectedProportions := oldParams.DistributionProportions
+			if tc.proportions != nil {
+				expectedProportions = *tc.proportions
+			}
+			suite.Require().Equal(expectedProportions, params.DistributionProportions)
+
+			suite.Require().Len(ctx.EventManager().Events(), 1)
+			event := ctx.EventManager().Events()[0]
+			suite.Require().Equal(types.EventTypeUpdateDeveloperRewardsReceivers, event.Type)
+			suite.Require().Equal([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeOldReceivers, types.FormatWeightedAddresses(oldParams.WeightedDeveloperRewardsReceivers)),
+				sdk.NewAttribute(types.AttributeNewReceivers, types.FormatWeightedAddresses(tc.receivers)),
+				sdk.NewAttribute(types.AttributeOldProportions, oldParams.DistributionProportions.String()),
+				sdk.NewAttribute(types.AttributeNewProportions, expectedProportions.String()),
+			}, eventAttributes(event))
+		})
+	}
+}
+
+func eventAttributes(event sdk.Event) []sdk.Attribute {
+	attributes := make([]sdk.Attribute, len(event.Attributes))
+	for i, attribute := range event.Attributes {
+		attributes[i] = sdk.NewAttribute(string(attribute.Key), string(attribute.Value))
+	}
+	return attributes
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
@@ -35,3 +38,63 @@ func (q Querier) EpochProvisions(c context.Context, _ *types.QueryEpochProvision
 
 	return &types.QueryEpochProvisionsResponse{EpochProvisions: minter.EpochProvisions}, nil
 }
+
+// Inflation returns the current annualized inflation of the mint denom.
+func (q Querier) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	inflation, annualProvisions, supply, err := q.Keeper.GetInflation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInflationResponse{Inflation: inflation, AnnualProvisions: annualProvisions, Supply: supply}, nil
+}
+
+// ProjectedSupply returns the supply of the mint denom projected at the end of an epoch, or of the last epoch ending by a time.
+func (q Querier) ProjectedSupply(c context.Context, req *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if (req.Epoch == 0) == (req.Time == nil) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of epoch and time must be set")
+	}
+	if req.Epoch < 0 {
+		return nil, status.Error(codes.InvalidArgument, "epoch must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch := req.Epoch
+	if req.Time != nil {
+		var err error
+		epoch, err = q.Keeper.GetEpochEndingBy(ctx, *req.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	supply, epochProvisions, err := q.Keeper.GetProjectedSupply(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectedSupplyResponse{Epoch: epoch, ProjectedSupply: supply, EpochProvisions: epochProvisions}, nil
+}
+
+// NextEpochMint returns the provisions minted at the end of the current epoch, broken down per distribution proportion.
+func (q Querier) NextEpochMint(c context.Context, _ *types.QueryNextEpochMintRequest) (*types.QueryNextEpochMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch, mintedCoin := q.Keeper.GetNextEpochMint(ctx)
+	staking, poolIncentives, developerRewards, communityPool, err := q.Keeper.GetDistributionProportionsBreakdown(ctx, mintedCoin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNextEpochMintResponse{
+		Epoch:            epoch,
+		Minted:           mintedCoin,
+		Staking:          staking,
+		PoolIncentives:   poolIncentives,
+		DeveloperRewards: developerRewards,
+		CommunityPool:    communityPool,
+	}, nil
+}
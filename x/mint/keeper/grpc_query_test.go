@@ -2,7 +2,11 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/mint/keeper"
 	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
 )
 
@@ -15,3 +19,94 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 	_, err = queryClient.EpochProvisions(gocontext.Background(), &types.QueryEpochProvisionsRequest{})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestGRPCInflationQueries() {
+	app, ctx, queryClient := suite.App, suite.Ctx, suite.queryClient
+
+	mintParams := app.MintKeeper.GetParams(ctx)
+	mintParams.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	mintParams.MintingRewardsDistributionStartEpoch = 0
+	app.MintKeeper.SetParams(ctx, mintParams)
+	app.MintKeeper.SetLastHalvenEpochNum(ctx, 0)
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(sdk.NewDec(1_000_000)))
+
+	// the mint epoch is a day long and ends epoch 5 next.
+	epochInfo := app.EpochsKeeper.GetEpochInfo(ctx, mintParams.EpochIdentifier)
+	epochInfo.Duration = 24 * time.Hour
+	epochInfo.CurrentEpoch = 5
+	epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+	epochInfo.EpochCountingStarted = true
+	app.EpochsKeeper.SetEpochInfo(ctx, epochInfo)
+
+	suite.FundAcc(testAddressOne, sdk.NewCoins(sdk.NewInt64Coin(mintParams.MintDenom, 1_000_000_000)))
+	supply := app.BankKeeper.GetSupplyWithOffset(ctx, mintParams.MintDenom)
+	suite.Require().True(supply.IsPositive())
+
+	inflation, err := queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(365_000_000), inflation.AnnualProvisions)
+	suite.Require().Equal(supply.String(), inflation.Supply.String())
+	suite.Require().Equal(sdk.NewDec(365_000_000).QuoInt(supply.Amount), inflation.Inflation)
+
+	nextEpochMint, err := queryClient.NextEpochMint(gocontext.Background(), &types.QueryNextEpochMintRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(5), nextEpochMint.Epoch)
+	suite.Require().Equal(sdk.NewInt64Coin(mintParams.MintDenom, 1_000_000), nextEpochMint.Minted)
+	expectedStaking, err := keeper.GetProportions(nextEpochMint.Minted, mintParams.DistributionProportions.Staking)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedStaking, nextEpochMint.Staking)
+	suite.Require().Equal(nextEpochMint.Minted, nextEpochMint.Staking.Add(nextEpochMint.PoolIncentives).Add(nextEpochMint.DeveloperRewards).Add(nextEpochMint.CommunityPool))
+
+	// epochs 5 to 9 mint 1_000_000 each, the provisions are halved at epoch 10, then epochs 10 to 12 mint 500_000 each.
+	expectedSupply := supply.AddAmount(sdk.NewInt(6_500_000))
+	projected, err := queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Epoch: 12})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(12), projected.Epoch)
+	suite.Require().Equal(expectedSupply.String(), projected.ProjectedSupply.String())
+	suite.Require().Equal(sdk.NewDec(500_000), projected.EpochProvisions)
+
+	// epoch 12 is the last epoch ending 8 days into epoch 5.
+	projectedTime := ctx.BlockTime().Add(8*24*time.Hour + time.Second)
+	projected, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Time: &projectedTime})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(12), projected.Epoch)
+	suite.Require().Equal(expectedSupply.String(), projected.ProjectedSupply.String())
+
+	// the projection matches the supply actually minted by the epochs.
+	cacheCtx, _ := ctx.CacheContext()
+	for epoch := int64(5); epoch <= 12; epoch++ {
+		app.MintKeeper.AfterEpochEnd(cacheCtx, mintParams.EpochIdentifier, epoch)
+	}
+	suite.Require().Equal(expectedSupply.String(), app.BankKeeper.GetSupplyWithOffset(cacheCtx, mintParams.MintDenom).String())
+
+	// projecting the 8 epochs consumes gas for each of them.
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, err = app.MintKeeper.GetProjectedSupply(gasCtx, 12)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(gasCtx.GasMeter().GasConsumed(), uint64(8*keeper.SupplyProjectionGasPerEpoch))
+
+	// the projection matches the supply minted under continuous reduction too.
+	continuousCtx, _ := ctx.CacheContext()
+	continuousParams := mintParams
+	continuousParams.ReductionMode = types.ReductionModeContinuous
+	app.MintKeeper.SetParams(continuousCtx, continuousParams)
+	continuousSupply, continuousProvisions, err := app.MintKeeper.GetProjectedSupply(continuousCtx, 12)
+	suite.Require().NoError(err)
+	for epoch := int64(5); epoch <= 12; epoch++ {
+		app.MintKeeper.AfterEpochEnd(continuousCtx, continuousParams.EpochIdentifier, epoch)
+	}
+	suite.Require().Equal(continuousSupply.String(), app.BankKeeper.GetSupplyWithOffset(continuousCtx, continuousParams.MintDenom).String())
+	suite.Require().Equal(continuousProvisions, app.MintKeeper.GetMinter(continuousCtx).EpochProvisions)
+
+	// an epoch that already ended projects the current supply.
+	projected, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Epoch: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply.String(), projected.ProjectedSupply.String())
+
+	_, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Epoch: 12, Time: &projectedTime})
+	suite.Require().Error(err)
+	_, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Epoch: 5 + keeper.MaxSupplyProjectionEpochs})
+	suite.Require().ErrorIs(err, types.ErrProjectionTooLong)
+}
@@ -30,11 +30,16 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		// Check if we have hit an epoch where we update the inflation parameter.
 		// Since epochs only update based on BFT time data, it is safe to store the "halvening period time"
 		// in terms of the number of epochs that have transpired.
-		if epochNumber >= k.GetParams(ctx).ReductionPeriodInEpochs+k.GetLastHalvenEpochNum(ctx) {
-			// Halven the reward per halven period
-			minter.EpochProvisions = minter.NextEpochProvisions(params)
+		lastHalvenEpoch := k.GetLastHalvenEpochNum(ctx)
+		nextMinter, nextLastHalvenEpoch := minter.NextEpochMinter(params, lastHalvenEpoch, epochNumber)
+		if nextMinter.ReductionMode != minter.ReductionMode {
+			// The reduction mode was changed by governance since the last minting epoch.
+			k.Logger(ctx).Info("switched mint reduction mode", "from", minter.ReductionMode, "to", nextMinter.ReductionMode, "epoch", epochNumber, "epoch_provisions", nextMinter.EpochProvisions)
+		}
+		if nextLastHalvenEpoch != lastHalvenEpoch || nextMinter.ReductionMode != minter.ReductionMode {
+			minter = nextMinter
 			k.SetMinter(ctx, minter)
-			k.SetLastHalvenEpochNum(ctx, epochNumber)
+			k.SetLastHalvenEpochNum(ctx, nextLastHalvenEpoch)
 		}
 
 		// mint coins, update supply
@@ -406,3 +406,63 @@ func (suite *KeeperTestSuite) TestAfterEpochEnd_FirstYearThirdening_RealParamete
 	suite.Require().Equal(mainnetThirdenedProvisions, expectedThirdenedProvisions.String())
 	suite.Require().Equal(expectedThirdenedProvisions, app.MintKeeper.GetMinter(ctx).EpochProvisions)
 }
+
+func (suite *KeeperTestSuite) TestAfterEpochEnd_ContinuousReduction() {
+	app := suite.App
+	ctx := suite.Ctx
+
+	mintParams := app.MintKeeper.GetParams(ctx)
+	mintParams.ReductionPeriodInEpochs = 10
+	mintParams.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	mintParams.MintingRewardsDistributionStartEpoch = 0
+	app.MintKeeper.SetParams(ctx, mintParams)
+	app.MintKeeper.SetLastHalvenEpochNum(ctx, 0)
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(sdk.NewDec(1_000_000)))
+
+	// mint periodically in the middle of the first reduction period.
+	for epoch := int64(1); epoch <= 6; epoch++ {
+		app.MintKeeper.AfterEpochEnd(ctx, mintParams.EpochIdentifier, epoch)
+	}
+	suite.Require().Equal(sdk.NewDec(1_000_000), app.MintKeeper.GetMinter(ctx).EpochProvisions)
+	suite.Require().Equal(int64(0), app.MintKeeper.GetLastHalvenEpochNum(ctx))
+
+	// governance switches to continuous reduction.
+	mintParams.ReductionMode = types.ReductionModeContinuous
+	app.MintKeeper.SetParams(ctx, mintParams)
+
+	// the switch keeps the provisions expected from this epoch on: epochs 7 to 9 left in the period, then 10 * (1/2 + 1/4 + ...).
+	app.MintKeeper.AfterEpochEnd(ctx, mintParams.EpochIdentifier, 7)
+	minter := app.MintKeeper.GetMinter(ctx)
+	suite.Require().Equal(types.ReductionModeContinuous, minter.ReductionMode)
+	suite.Require().Equal(int64(7), app.MintKeeper.GetLastHalvenEpochNum(ctx))
+	continuousMultiplier, bounded := types.RemainingProvisionsMultiplier(mintParams, types.ReductionModeContinuous, 0)
+	suite.Require().True(bounded)
+	expectedRemaining := sdk.NewDec(13_000_000)
+	suite.Require().True(minter.EpochProvisions.Mul(continuousMultiplier).Sub(expectedRemaining).Abs().LT(sdk.OneDec()))
+
+	// the same epoch ending again does not reduce the provisions twice.
+	app.MintKeeper.AfterEpochEnd(ctx, mintParams.EpochIdentifier, 7)
+	suite.Require().Equal(minter.EpochProvisions, app.MintKeeper.GetMinter(ctx).EpochProvisions)
+
+	// from then on, the provisions are reduced every epoch.
+	continuousFactor := mintParams.ContinuousReductionFactor()
+	for epoch := int64(8); epoch <= 10; epoch++ {
+		app.MintKeeper.AfterEpochEnd(ctx, mintParams.EpochIdentifier, epoch)
+		expectedProvisions := minter.EpochProvisions.Mul(continuousFactor)
+		minter = app.MintKeeper.GetMinter(ctx)
+		suite.Require().Equal(expectedProvisions, minter.EpochProvisions)
+		suite.Require().Equal(epoch, app.MintKeeper.GetLastHalvenEpochNum(ctx))
+	}
+
+	// switching back to periodic reduction starts a new reduction period.
+	mintParams.ReductionMode = types.ReductionModePeriodic
+	app.MintKeeper.SetParams(ctx, mintParams)
+	app.MintKeeper.AfterEpochEnd(ctx, mintParams.EpochIdentifier, 11)
+	periodicMultiplier, bounded := types.RemainingProvisionsMultiplier(mintParams, types.ReductionModePeriodic, mintParams.ReductionPeriodInEpochs)
+	suite.Require().True(bounded)
+	expectedRemaining = minter.EpochProvisions.Mul(continuousFactor).Mul(continuousMultiplier)
+	minter = app.MintKeeper.GetMinter(ctx)
+	suite.Require().Equal(types.ReductionModePeriodic, minter.ReductionMode)
+	suite.Require().Equal(int64(11), app.MintKeeper.GetLastHalvenEpochNum(ctx))
+	suite.Require().True(minter.EpochProvisions.Mul(periodicMultiplier).Sub(expectedRemaining).Abs().LT(sdk.OneDec()))
+}
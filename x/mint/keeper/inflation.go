@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v10/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// year is the duration the epoch provisions are annualized over.
+	year = 365 * 24 * time.Hour
+
+	// MaxSupplyProjectionEpochs is the maximum number of epochs a supply projection can span.
+	MaxSupplyProjectionEpochs = 10_000
+
+	// supplyProjectionGasPerEpoch is the gas consumed to project the supply minted at an epoch.
+	supplyProjectionGasPerEpoch = 100
+)
+
+// GetInflation returns the current epoch provisions annualized over the duration of the mint epoch, and
+// the inflation they represent over the supply adjusted for supply offsets.
+// Both are zero before minting starts.
+func (k Keeper) GetInflation(ctx sdk.Context) (inflation sdk.Dec, annualProvisions sdk.Dec, supply sdk.Coin, err error) {
+	params := k.GetParams(ctx)
+	supply = k.bankKeeper.GetSupplyWithOffset(ctx, params.MintDenom)
+
+	epochDuration := k.epochKeeper.GetEpochInfo(ctx, params.EpochIdentifier).Duration
+	if epochDuration <= 0 {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidEpochDuration, "epoch %s has duration %s", params.EpochIdentifier, epochDuration)
+	}
+
+	if k.getNextMintEpoch(ctx, params) < params.MintingRewardsDistributionStartEpoch {
+		return sdk.ZeroDec(), sdk.ZeroDec(), supply, nil
+	}
+
+	annualProvisions = k.GetMinter(ctx).EpochProvisions.MulInt64(int64(year)).QuoInt64(int64(epochDuration))
+	if !supply.Amount.IsPositive() {
+		return sdk.ZeroDec(), annualProvisions, supply, nil
+	}
+	return annualProvisions.QuoInt(supply.Amount), annualProvisions, supply, nil
+}
+
+// GetProjectedSupply returns the supply adjusted for supply offsets, and the epoch provisions, at the end of epoch,
+// under the current reduction schedule. It returns the current supply and epoch provisions for an epoch that already ended.
+func (k Keeper) GetProjectedSupply(ctx sdk.Context, epoch int64) (sdk.Coin, sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	supply := k.bankKeeper.GetSupplyWithOffset(ctx, params.MintDenom)
+	minter := k.GetMinter(ctx)
+	lastHalvenEpoch := k.GetLastHalvenEpochNum(ctx)
+
+	nextEpoch := k.getNextMintEpoch(ctx, params)
+	if epoch-nextEpoch >= MaxSupplyProjectionEpochs {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrProjectionTooLong, "projecting epoch %d from epoch %d, maximum is %d epochs", epoch, nextEpoch, MaxSupplyProjectionEpochs)
+	}
+
+	continuousFactor := params.ContinuousReductionFactor()
+	for e := nextEpoch; e <= epoch; e++ {
+		ctx.GasMeter().ConsumeGas(supplyProjectionGasPerEpoch, "supply projection")
+
+		var mintedCoin sdk.Coin
+		minter, lastHalvenEpoch, mintedCoin = mintEpoch(minter, params, continuousFactor, lastHalvenEpoch, e)
+		supply = supply.Add(mintedCoin)
+	}
+	return supply, minter.EpochProvisions, nil
+}
+
+// GetNextEpochMint returns the next mint epoch to end, and the coin minted at its end.
+// The minted coin is zero if minting has not started by then.
+func (k Keeper) GetNextEpochMint(ctx sdk.Context) (int64, sdk.Coin) {
+	params := k.GetParams(ctx)
+	epoch := k.getNextMintEpoch(ctx, params)
+	_, _, mintedCoin := mintEpoch(k.GetMinter(ctx), params, params.ContinuousReductionFactor(), k.GetLastHalvenEpochNum(ctx), epoch)
+	return epoch, mintedCoin
+}
+
+// GetEpochEndingBy returns the number of the last mint epoch ending by t, assuming the mint epoch keeps its
+// current duration. It returns the epoch before the current epoch if the current epoch does not end by t.
+func (k Keeper) GetEpochEndingBy(ctx sdk.Context, t time.Time) (int64, error) {
+	params := k.GetParams(ctx)
+	epochInfo := k.epochKeeper.GetEpochInfo(ctx, params.EpochIdentifier)
+	if epochInfo.Duration <= 0 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidEpochDuration, "epoch %s has duration %s", params.EpochIdentifier, epochInfo.Duration)
+	}
+
+	currentEpoch, currentEpochStartTime := epochInfo.CurrentEpoch, epochInfo.CurrentEpochStartTime
+	if !epochInfo.EpochCountingStarted {
+		currentEpoch, currentEpochStartTime = 1, epochInfo.StartTime
+	}
+	if t.Before(currentEpochStartTime) {
+		return currentEpoch - 1, nil
+	}
+	return currentEpoch - 1 + int64(t.Sub(currentEpochStartTime)/epochInfo.Duration), nil
+}
+
+// GetDistributionProportionsBreakdown returns the coins DistributeMintedCoin allocates out of mintedCoin to
+// staking, pool incentives, developer rewards and the community pool.
+func (k Keeper) GetDistributionProportionsBreakdown(ctx sdk.Context, mintedCoin sdk.Coin) (staking, poolIncentives, developerRewards, communityPool sdk.Coin, err error) {
+	proportions := k.GetParams(ctx).DistributionProportions
+	if staking, err = getProportions(mintedCoin, proportions.Staking); err != nil {
+		return
+	}
+	if poolIncentives, err = getProportions(mintedCoin, proportions.PoolIncentives); err != nil {
+		return
+	}
+	if developerRewards, err = getProportions(mintedCoin, proportions.DeveloperRewards); err != nil {
+		return
+	}
+	communityPool = mintedCoin.Sub(staking).Sub(poolIncentives).Sub(developerRewards)
+	return
+}
+
+// getNextMintEpoch returns the number of the next mint epoch to end.
+func (k Keeper) getNextMintEpoch(ctx sdk.Context, params types.Params) int64 {
+	epochInfo := k.epochKeeper.GetEpochInfo(ctx, params.EpochIdentifier)
+	if !epochInfo.EpochCountingStarted {
+		return 1
+	}
+	return epochInfo.CurrentEpoch
+}
+
+// mintEpoch returns the minter, last halven epoch number and minted coin of epoch as AfterEpochEnd
+// computes them, given the minter and the last halven epoch number of the previous epoch,
+// and the continuous reduction factor of params.
+func mintEpoch(minter types.Minter, params types.Params, continuousFactor sdk.Dec, lastHalvenEpoch, epoch int64) (types.Minter, int64, sdk.Coin) {
+	if epoch < params.MintingRewardsDistributionStartEpoch {
+		return minter, lastHalvenEpoch, sdk.NewCoin(params.MintDenom, sdk.ZeroInt())
+	} else if epoch == params.MintingRewardsDistributionStartEpoch {
+		lastHalvenEpoch = epoch
+	}
+	minter, lastHalvenEpoch = minter.NextEpochMinterWithFactor(params, continuousFactor, lastHalvenEpoch, epoch)
+	return minter, lastHalvenEpoch, minter.EpochProvision(params)
+}
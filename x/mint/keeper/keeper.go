@@ -136,7 +136,9 @@ func (k *Keeper) SetHooks(h types.MintHooks) *Keeper {
 	return k
 }
 
-// GetLastHalvenEpochNum returns last halven epoch number.
+// GetLastHalvenEpochNum returns last halven epoch number: the epoch the current reduction period started at
+// for periodic reduction, and the last epoch whose provisions were reduced, i.e. the last minting epoch,
+// for continuous reduction.
 func (k Keeper) GetLastHalvenEpochNum(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(types.LastHalvenEpochKey)
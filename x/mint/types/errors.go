@@ -8,4 +8,6 @@ var (
 	ErrAmountNilOrZero           = sdkerrors.Register(ModuleName, 2, "amount cannot be nil or zero")
 	ErrModuleAccountAlreadyExist = sdkerrors.Register(ModuleName, 3, "module account already exists")
 	ErrModuleDoesnotExist        = sdkerrors.Register(ModuleName, 4, "module account does not exist")
+	ErrInvalidEpochDuration      = sdkerrors.Register(ModuleName, 5, "mint epoch duration must be positive")
+	ErrProjectionTooLong         = sdkerrors.Register(ModuleName, 6, "supply projection spans too many epochs")
 )
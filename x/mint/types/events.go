@@ -2,8 +2,13 @@ package types
 
 // Minting module event types.
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint                            = ModuleName
+	EventTypeUpdateDeveloperRewardsReceivers = "update_developer_rewards_receivers"
 
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	AttributeEpochNumber        = "epoch_number"
+	AttributeOldReceivers       = "old_receivers"
+	AttributeNewReceivers       = "new_receivers"
+	AttributeOldProportions     = "old_distribution_proportions"
+	AttributeNewProportions     = "new_distribution_proportions"
 )
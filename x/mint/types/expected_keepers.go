@@ -26,6 +26,7 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	AddSupplyOffset(ctx sdk.Context, denom string, offsetAmount sdk.Int)
+	GetSupplyWithOffset(ctx sdk.Context, denom string) sdk.Coin
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper.
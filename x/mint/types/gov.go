@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateDeveloperRewardsReceivers = "UpdateDeveloperRewardsReceivers"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateDeveloperRewardsReceivers)
+	govtypes.RegisterProposalTypeCodec(&UpdateDeveloperRewardsReceiversProposal{}, "osmosis/UpdateDeveloperRewardsReceiversProposal")
+}
+
+var _ govtypes.Content = &UpdateDeveloperRewardsReceiversProposal{}
+
+func NewUpdateDeveloperRewardsReceiversProposal(title, description string, receivers []WeightedAddress, proportions *DistributionProportions) UpdateDeveloperRewardsReceiversProposal {
+	return UpdateDeveloperRewardsReceiversProposal{
+		Title:                             title,
+		Description:                       description,
+		WeightedDeveloperRewardsReceivers: receivers,
+		DistributionProportions:           proportions,
+	}
+}
+
+func (p *UpdateDeveloperRewardsReceiversProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateDeveloperRewardsReceiversProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateDeveloperRewardsReceiversProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateDeveloperRewardsReceiversProposal) ProposalType() string {
+	return ProposalTypeUpdateDeveloperRewardsReceivers
+}
+
+func (p *UpdateDeveloperRewardsReceiversProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers); err != nil {
+		return err
+	}
+
+	if p.DistributionProportions != nil {
+		return ValidateDistributionProportions(*p.DistributionProportions)
+	}
+	return nil
+}
+
+func (p UpdateDeveloperRewardsReceiversProposal) String() string {
+	proportions := "unchanged"
+	if p.DistributionProportions != nil {
+		proportions = p.DistributionProportions.String()
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Developer Rewards Receivers Proposal:
+  Title:                    %s
+  Description:              %s
+  Receivers:                %s
+  Distribution Proportions: %s
+`, p.Title, p.Description, FormatWeightedAddresses(p.WeightedDeveloperRewardsReceivers), proportions))
+	return b.String()
+}
+
+// FormatWeightedAddresses returns the weighted addresses as comma separated address:weight pairs.
+func FormatWeightedAddresses(receivers []WeightedAddress) string {
+	pairs := make([]string, len(receivers))
+	for i, w := range receivers {
+		pairs[i] = fmt.Sprintf("%s:%s", w.Address, w.Weight)
+	}
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/mint/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdateDeveloperRewardsReceiversProposal is a gov Content type for replacing
+// the weighted developer rewards receivers. The receivers are validated when
+// the proposal is submitted: their weights must add up to 1, their addresses
+// must be valid bech32 addresses and must not be duplicated. An empty address
+// sends its share to the community pool. If distribution proportions are
+// given, they also replace the mint distribution proportions.
+type UpdateDeveloperRewardsReceiversProposal struct {
+	Title                             string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description                       string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	WeightedDeveloperRewardsReceivers []WeightedAddress        `protobuf:"bytes,3,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers" yaml:"developer_rewards_receiver"`
+	DistributionProportions           *DistributionProportions `protobuf:"bytes,4,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions,omitempty" yaml:"distribution_proportions"`
+}
+
+func (m *UpdateDeveloperRewardsReceiversProposal) Reset() {
+	*m = UpdateDeveloperRewardsReceiversProposal{}
+}
+func (*UpdateDeveloperRewardsReceiversProposal) ProtoMessage() {}
+func (*UpdateDeveloperRewardsReceiversProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8660c72d025408ac, []int{0}
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDeveloperRewardsReceiversProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeveloperRewardsReceiversProposal.Merge(m, src)
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeveloperRewardsReceiversProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeveloperRewardsReceiversProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdateDeveloperRewardsReceiversProposal)(nil), "osmosis.mint.v1beta1.UpdateDeveloperRewardsReceiversProposal")
+}
+
+func init() { proto.RegisterFile("osmosis/mint/v1beta1/gov.proto", fileDescriptor_8660c72d025408ac) }
+
+var fileDescriptor_8660c72d025408ac = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3d, 0x6f, 0xda, 0x40,
+	0x18, 0xc7, 0x7d, 0xa5, 0xad, 0x5a, 0xd3, 0xa1, 0xb2, 0x50, 0x6b, 0x31, 0xf8, 0xc0, 0x7d, 0xa3,
+	0x03, 0x36, 0x90, 0x25, 0x62, 0x8b, 0xc5, 0x94, 0x09, 0x59, 0x8a, 0x22, 0x65, 0xb1, 0x6c, 0xee,
+	0x64, 0x4e, 0xb2, 0x39, 0xeb, 0xee, 0x30, 0xe1, 0x1b, 0x64, 0x89, 0x94, 0x31, 0x23, 0xf9, 0x36,
+	0x64, 0x63, 0xcc, 0x64, 0x45, 0xf0, 0x0d, 0xfc, 0x09, 0x22, 0xbf, 0x29, 0x0c, 0x66, 0x3b, 0xfb,
+	0xff, 0xf2, 0xfc, 0xf4, 0xe8, 0x91, 0x35, 0xca, 0x43, 0xca, 0x09, 0x37, 0x43, 0xb2, 0x10, 0x66,
+	0x3c, 0xf4, 0xb0, 0x70, 0x87, 0xa6, 0x4f, 0x63, 0x23, 0x62, 0x54, 0x50, 0xa5, 0x55, 0xea, 0x46,
+	0xa6, 0x1b, 0xa5, 0xde, 0x6e, 0xf9, 0xd4, 0xa7, 0xb9, 0xc1, 0xcc, 0x5e, 0x85, 0xb7, 0x0d, 0x6b,
+	0xbb, 0xf2, 0x60, 0x6e, 0xd0, 0x9f, 0x1b, 0xf2, 0xbf, 0xab, 0x08, 0xb9, 0x02, 0x4f, 0x70, 0x8c,
+	0x03, 0x1a, 0x61, 0x66, 0xe3, 0x95, 0xcb, 0x10, 0xb7, 0xf1, 0x0c, 0x93, 0x18, 0x33, 0x3e, 0x65,
+	0x34, 0xa2, 0xdc, 0x0d, 0x94, 0xbf, 0xf2, 0x27, 0x41, 0x44, 0x80, 0x55, 0xd0, 0x01, 0xbd, 0xaf,
+	0xd6, 0xf7, 0x34, 0x81, 0xdf, 0xd6, 0x6e, 0x18, 0x8c, 0xf5, 0xfc, 0xb7, 0x6e, 0x17, 0xb2, 0x72,
+	0x2e, 0x37, 0x11, 0xe6, 0x33, 0x46, 0x22, 0x41, 0xe8, 0x42, 0xfd, 0x90, 0xbb, 0x7f, 0xa4, 0x09,
+	0x54, 0x0a, 0xf7, 0x91, 0xa8, 0xdb, 0xc7, 0x56, 0xe5, 0x09, 0xc8, 0xbf, 0x57, 0x98, 0xf8, 0x73,
+	0x81, 0x91, 0x83, 0x2a, 0x20, 0x87, 0x15, 0x44, 0x0e, 0xab, 0x90, 0xd4, 0x46, 0xa7, 0xd1, 0x6b,
+	0x8e, 0xfe, 0x18, 0x75, 0xab, 0x30, 0xae, 0xcb, 0x86, 0x0b, 0x84, 0x18, 0xe6, 0xdc, 0xfa, 0xbf,
+	0x4d, 0xa0, 0x94, 0x26, 0xb0, 0x5b, 0x8d, 0x3f, 0xd5, 0xab, 0xdb, 0xdd, 0x6a, 0xfa, 0xc9, 0x6d,
+	0x28, 0xf7, 0x40, 0x56, 0x11, 0xe1, 0x82, 0x11, 0x6f, 0x99, 0x41, 0x3b, 0x51, 0xb6, 0x1f, 0x96,
+	0x3d, 0xb9, 0xfa, 0xb1, 0x03, 0x7a, 0xcd, 0x51, 0xbf, 0x9e, 0x6b, 0x72, 0x94, 0x9a, 0xbe, 0x87,
+	0xac, 0x5f, 0x69, 0x02, 0x61, 0xc9, 0x76, 0xa2, 0x58, 0xb7, 0x7f, 0xa2, 0xfa, 0xf4, 0xf8, 0xcb,
+	0xdd, 0x06, 0x4a, 0x8f, 0x1b, 0x28, 0x59, 0x97, 0xdb, 0xbd, 0x06, 0x76, 0x7b, 0x0d, 0xbc, 0xee,
+	0x35, 0xf0, 0x70, 0xd0, 0xa4, 0xdd, 0x41, 0x93, 0x5e, 0x0e, 0x9a, 0x74, 0x33, 0xf0, 0x89, 0x98,
+	0x2f, 0x3d, 0x63, 0x46, 0x43, 0xb3, 0x44, 0xeb, 0x07, 0xae, 0xc7, 0xab, 0x0f, 0x33, 0x1e, 0x0e,
+	0xcc, 0xdb, 0xe2, 0x48, 0xc4, 0x3a, 0xc2, 0xdc, 0xfb, 0x9c, 0x9f, 0xc7, 0xd9, 0xdb, 0x00, 0x38,
+	0xe3, 0x35, 0xe5, 0x8d, 0x02, 0x00, 0x00,
+}
+
+func (m *UpdateDeveloperRewardsReceiversProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDeveloperRewardsReceiversProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDeveloperRewardsReceiversProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionProportions != nil {
+		{
+			size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for _, e := range m.WeightedDeveloperRewardsReceivers {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.DistributionProportions != nil {
+		l = m.DistributionProportions.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateDeveloperRewardsReceiversProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDeveloperRewardsReceiversProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDeveloperRewardsReceiversProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, WeightedAddress{})
+			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionProportions == nil {
+				m.DistributionProportions = &DistributionProportions{}
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
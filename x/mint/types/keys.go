@@ -18,6 +18,9 @@ const (
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
+	// RouterKey is the gov proposal routing key for mint.
+	RouterKey = ModuleName
+
 	// Query endpoints supported by the minting querier.
 	QueryParameters      = "parameters"
 	QueryEpochProvisions = "epoch_provisions"
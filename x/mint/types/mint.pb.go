@@ -27,10 +27,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReductionMode is how the epoch provisions are reduced over time.
+type ReductionMode int32
+
+const (
+	// reduce the epoch provisions by the reduction factor once every reduction
+	// period
+	ReductionModePeriodic ReductionMode = 0
+	// reduce the epoch provisions every epoch, by the factor that compounds to
+	// the reduction factor over a reduction period
+	ReductionModeContinuous ReductionMode = 1
+)
+
+var ReductionMode_name = map[int32]string{
+	0: "ReductionModePeriodic",
+	1: "ReductionModeContinuous",
+}
+
+var ReductionMode_value = map[string]int32{
+	"ReductionModePeriodic":   0,
+	"ReductionModeContinuous": 1,
+}
+
+func (x ReductionMode) String() string {
+	return proto.EnumName(ReductionMode_name, int32(x))
+}
+
+func (ReductionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current epoch provisions
 	EpochProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_provisions" yaml:"epoch_provisions"`
+	// reduction mode the current epoch provisions follow
+	ReductionMode ReductionMode `protobuf:"varint,2,opt,name=reduction_mode,json=reductionMode,proto3,enum=osmosis.mint.v1beta1.ReductionMode" json:"reduction_mode,omitempty" yaml:"reduction_mode"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -66,6 +98,13 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetReductionMode() ReductionMode {
+	if m != nil {
+		return m.ReductionMode
+	}
+	return ReductionModePeriodic
+}
+
 type WeightedAddress struct {
 	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
@@ -177,6 +216,8 @@ type Params struct {
 	WeightedDeveloperRewardsReceivers []WeightedAddress `protobuf:"bytes,7,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers" yaml:"developer_rewards_receiver"`
 	// start epoch to distribute minting rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,8,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// how the epoch provisions are reduced over time
+	ReductionMode ReductionMode `protobuf:"varint,9,opt,name=reduction_mode,json=reductionMode,proto3,enum=osmosis.mint.v1beta1.ReductionMode" json:"reduction_mode,omitempty" yaml:"reduction_mode"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -253,7 +294,15 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	return 0
 }
 
+func (m *Params) GetReductionMode() ReductionMode {
+	if m != nil {
+		return m.ReductionMode
+	}
+	return ReductionModePeriodic
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.mint.v1beta1.ReductionMode", ReductionMode_name, ReductionMode_value)
 	proto.RegisterType((*Minter)(nil), "osmosis.mint.v1beta1.Minter")
 	proto.RegisterType((*WeightedAddress)(nil), "osmosis.mint.v1beta1.WeightedAddress")
 	proto.RegisterType((*DistributionProportions)(nil), "osmosis.mint.v1beta1.DistributionProportions")
@@ -263,55 +312,61 @@ func init() {
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/mint.proto", fileDescriptor_ccb38f8335e0f45b) }
 
 var fileDescriptor_ccb38f8335e0f45b = []byte{
-	// 768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xf3, 0x44,
-	0x14, 0x8d, 0x49, 0x49, 0xf9, 0xe6, 0x53, 0x9b, 0x62, 0x95, 0xc6, 0x14, 0x11, 0xb7, 0x56, 0x8b,
-	0x82, 0x44, 0xe3, 0xfe, 0xec, 0xba, 0x01, 0xa2, 0x50, 0x48, 0x25, 0xa4, 0x30, 0x2c, 0x2a, 0x75,
-	0x63, 0x39, 0xf6, 0xd4, 0x1d, 0x35, 0x9e, 0x31, 0x33, 0x93, 0x84, 0x6c, 0x78, 0x01, 0x36, 0x2c,
-	0xbb, 0x84, 0xb7, 0xe9, 0xb2, 0xec, 0x10, 0x8b, 0x08, 0xb5, 0x6f, 0x90, 0x17, 0x00, 0xcd, 0x8f,
-	0x93, 0xd6, 0x6d, 0x24, 0xa2, 0x6f, 0x15, 0xcf, 0xb9, 0x27, 0xe7, 0x5c, 0xdf, 0xb9, 0xf7, 0x1a,
-	0xb8, 0x94, 0xa7, 0x94, 0x63, 0xee, 0xa7, 0x98, 0x08, 0x7f, 0x78, 0xd4, 0x43, 0x22, 0x3c, 0x52,
-	0x87, 0x66, 0xc6, 0xa8, 0xa0, 0xf6, 0xa6, 0x21, 0x34, 0x15, 0x66, 0x08, 0xdb, 0x9b, 0x09, 0x4d,
-	0xa8, 0x22, 0xf8, 0xf2, 0x49, 0x73, 0xb7, 0xdd, 0x84, 0xd2, 0xa4, 0x8f, 0x7c, 0x75, 0xea, 0x0d,
-	0xae, 0x7c, 0x81, 0x53, 0xc4, 0x45, 0x98, 0x66, 0x86, 0xf0, 0x71, 0x91, 0x10, 0x92, 0xb1, 0x09,
-	0xd5, 0x8b, 0xa1, 0x78, 0xc0, 0x42, 0x81, 0x29, 0xd1, 0x71, 0xef, 0x17, 0x50, 0xf9, 0x1e, 0x13,
-	0x81, 0x98, 0x2d, 0xc0, 0x06, 0xca, 0x68, 0x74, 0x1d, 0x64, 0x8c, 0x0e, 0x31, 0xc7, 0x94, 0x70,
-	0xc7, 0xda, 0xb1, 0x1a, 0x6f, 0x5a, 0x9d, 0xbb, 0x89, 0x5b, 0xfa, 0x7b, 0xe2, 0x7e, 0x96, 0x60,
-	0x71, 0x3d, 0xe8, 0x35, 0x23, 0x9a, 0xfa, 0x91, 0xca, 0xdf, 0xfc, 0x1c, 0xf0, 0xf8, 0xc6, 0x17,
-	0xe3, 0x0c, 0xf1, 0x66, 0x1b, 0x45, 0xd3, 0x89, 0x5b, 0x1b, 0x87, 0x69, 0xff, 0xd4, 0x2b, 0xea,
-	0x79, 0xb0, 0xaa, 0xa0, 0xee, 0x1c, 0xb9, 0xb5, 0x40, 0xf5, 0x02, 0xe1, 0xe4, 0x5a, 0xa0, 0xf8,
-	0xeb, 0x38, 0x66, 0x88, 0x73, 0xfb, 0x0b, 0xb0, 0x1a, 0xea, 0x47, 0x93, 0x80, 0x3d, 0x9d, 0xb8,
-	0xeb, 0x5a, 0xd2, 0x04, 0x3c, 0x98, 0x53, 0xec, 0x0b, 0x50, 0x19, 0x29, 0x01, 0xe7, 0x3d, 0x45,
-	0xfe, 0x72, 0xe9, 0x6c, 0xd7, 0xb4, 0xb4, 0x56, 0xf1, 0xa0, 0x91, 0xf3, 0xfe, 0x2c, 0x83, 0x5a,
-	0x1b, 0x73, 0xc1, 0x70, 0x6f, 0x20, 0x2b, 0xd6, 0x65, 0x34, 0xa3, 0x4c, 0x3e, 0x71, 0xfb, 0x12,
-	0xac, 0x72, 0x11, 0xde, 0x60, 0x92, 0x98, 0x14, 0xbf, 0x5a, 0xda, 0xd5, 0xbc, 0x90, 0x91, 0xf1,
-	0x60, 0x2e, 0x68, 0xff, 0x04, 0xaa, 0x19, 0xa5, 0xfd, 0x00, 0x93, 0x08, 0x11, 0x81, 0x87, 0x88,
-	0x9b, 0x37, 0xfb, 0x6e, 0x69, 0x8f, 0x2d, 0xed, 0x51, 0x90, 0xf3, 0xe0, 0xba, 0x44, 0x3a, 0x33,
-	0xc0, 0x1e, 0x81, 0x0f, 0x63, 0x34, 0x44, 0x7d, 0x9a, 0x21, 0x16, 0x30, 0x34, 0x0a, 0x59, 0xcc,
-	0x9d, 0xb2, 0x32, 0x3d, 0x5f, 0xda, 0xd4, 0xd1, 0xa6, 0x2f, 0x04, 0x3d, 0xb8, 0x31, 0xc3, 0xa0,
-	0x86, 0x6c, 0x02, 0xd6, 0x23, 0x9a, 0xa6, 0x03, 0x82, 0xc5, 0x38, 0x90, 0x49, 0x39, 0x2b, 0xca,
-	0xf5, 0xdb, 0xa5, 0x5d, 0x3f, 0xd2, 0xae, 0xcf, 0xd5, 0x3c, 0xb8, 0x36, 0x03, 0xba, 0xf2, 0xfc,
-	0x6f, 0x05, 0x54, 0xba, 0x21, 0x0b, 0x53, 0x6e, 0x7f, 0x0a, 0x80, 0x9c, 0xbd, 0x20, 0x46, 0x84,
-	0xa6, 0xfa, 0x16, 0xe1, 0x1b, 0x89, 0xb4, 0x25, 0x60, 0xff, 0x6a, 0x01, 0x27, 0x41, 0x04, 0x71,
-	0xcc, 0x83, 0x17, 0x73, 0xa1, 0xef, 0xe3, 0x87, 0xa5, 0x93, 0x74, 0x75, 0x92, 0x8b, 0x74, 0x3d,
-	0xb8, 0x65, 0x42, 0xdf, 0x3c, 0x1f, 0x13, 0xfb, 0x2c, 0x1f, 0x4e, 0x1c, 0xcb, 0x3b, 0xbb, 0xc2,
-	0x88, 0x99, 0xfb, 0xf9, 0xa4, 0x38, 0x6e, 0x73, 0x46, 0x3e, 0x6e, 0x9d, 0x19, 0x62, 0xf7, 0xc0,
-	0x36, 0x43, 0xf1, 0x20, 0x92, 0x5d, 0x1c, 0x64, 0x88, 0x61, 0x1a, 0x07, 0x98, 0xe8, 0x44, 0xb8,
-	0xaa, 0x7d, 0xb9, 0xb5, 0x3f, 0x9d, 0xb8, 0xbb, 0x5a, 0x71, 0x31, 0xd7, 0x83, 0xb5, 0x59, 0xb0,
-	0xab, 0x62, 0x1d, 0xa2, 0x92, 0xe6, 0x72, 0x91, 0xcc, 0xff, 0x77, 0x15, 0x46, 0x82, 0x32, 0xe7,
-	0xfd, 0x77, 0x5b, 0x24, 0x45, 0x3d, 0x0f, 0x56, 0x67, 0xd0, 0x99, 0x42, 0x6c, 0x02, 0x9c, 0xf8,
-	0xc9, 0xb0, 0xca, 0xaa, 0xe6, 0xd3, 0xea, 0x54, 0x76, 0xac, 0xc6, 0xdb, 0xe3, 0x83, 0xe6, 0x6b,
-	0x3b, 0xb7, 0xb9, 0x60, 0xc4, 0x5b, 0x2b, 0x32, 0x59, 0x58, 0x8b, 0x17, 0x6c, 0x80, 0x3f, 0x2c,
-	0xb0, 0x37, 0x32, 0x8b, 0x2b, 0x78, 0xd1, 0xeb, 0x01, 0x43, 0x11, 0xc2, 0x43, 0xc4, 0xb8, 0xb3,
-	0xba, 0x53, 0x6e, 0xbc, 0x3d, 0xde, 0x7f, 0xdd, 0xbc, 0xb0, 0xfa, 0x5a, 0x9f, 0x4b, 0xd3, 0x79,
-	0xfd, 0x17, 0xeb, 0x7a, 0x70, 0x37, 0x77, 0x6f, 0x17, 0x86, 0x0a, 0xe6, 0xd6, 0xb2, 0x87, 0x1b,
-	0xd2, 0x0e, 0x93, 0x64, 0x26, 0xf0, 0xac, 0x48, 0x5c, 0x84, 0x4c, 0xe8, 0x1b, 0x75, 0x3e, 0x50,
-	0x97, 0x7f, 0x32, 0x9d, 0xb8, 0xbe, 0x36, 0xff, 0xbf, 0xff, 0xf4, 0xe0, 0x9e, 0xa1, 0x9a, 0x04,
-	0x9e, 0x56, 0xf4, 0x47, 0xc9, 0x53, 0x8d, 0x71, 0xba, 0x72, 0xfb, 0xbb, 0x5b, 0x6a, 0x9d, 0xdf,
-	0x3d, 0xd4, 0xad, 0xfb, 0x87, 0xba, 0xf5, 0xcf, 0x43, 0xdd, 0xfa, 0xed, 0xb1, 0x5e, 0xba, 0x7f,
-	0xac, 0x97, 0xfe, 0x7a, 0xac, 0x97, 0x2e, 0x0f, 0x9f, 0x74, 0x85, 0x29, 0xd6, 0x41, 0x3f, 0xec,
-	0xf1, 0xfc, 0xe0, 0x0f, 0x8f, 0x0e, 0xfd, 0x9f, 0xf5, 0x17, 0x55, 0xf5, 0x48, 0xaf, 0xa2, 0xbe,
-	0x61, 0x27, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xae, 0x9c, 0x03, 0xa2, 0x6e, 0x07, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x92, 0xe0, 0x90, 0xa9, 0xe2, 0x84, 0x55, 0x5b, 0x6f, 0x52, 0xe1, 0x4d, 0x87, 0x16,
+	0x19, 0x44, 0xbc, 0x4d, 0x7a, 0xeb, 0x05, 0x30, 0xa1, 0x90, 0x4a, 0x91, 0xcc, 0x70, 0xa8, 0xd4,
+	0xcb, 0x6a, 0xbd, 0x3b, 0xd9, 0x8c, 0xea, 0x9d, 0x59, 0x66, 0x66, 0x1d, 0xf2, 0x0d, 0x90, 0xb8,
+	0x70, 0xec, 0x11, 0xc4, 0x97, 0xe9, 0xb1, 0xdc, 0x10, 0x07, 0x0b, 0x25, 0x82, 0x0f, 0xe0, 0x4f,
+	0x80, 0xe6, 0xcf, 0xae, 0xe3, 0x4d, 0x2c, 0x61, 0xa1, 0x9e, 0xbc, 0xf3, 0x7b, 0xbf, 0xfd, 0xfd,
+	0xde, 0xcc, 0xbc, 0xf7, 0xd6, 0xc0, 0x67, 0x22, 0x63, 0x82, 0x88, 0x20, 0x23, 0x54, 0x06, 0xe3,
+	0xfd, 0x21, 0x96, 0xd1, 0xbe, 0x5e, 0xf4, 0x72, 0xce, 0x24, 0x73, 0x6f, 0x5b, 0x42, 0x4f, 0x63,
+	0x96, 0xb0, 0x73, 0x3b, 0x65, 0x29, 0xd3, 0x84, 0x40, 0x3d, 0x19, 0xee, 0x8e, 0x9f, 0x32, 0x96,
+	0x8e, 0x70, 0xa0, 0x57, 0xc3, 0xe2, 0x24, 0x90, 0x24, 0xc3, 0x42, 0x46, 0x59, 0x6e, 0x09, 0xdb,
+	0x75, 0x42, 0x44, 0xcf, 0x6d, 0xa8, 0x53, 0x0f, 0x25, 0x05, 0x8f, 0x24, 0x61, 0xd4, 0xc4, 0xe1,
+	0xdf, 0x0e, 0x68, 0x1e, 0x13, 0x2a, 0x31, 0x77, 0x25, 0xd8, 0xc2, 0x39, 0x8b, 0x4f, 0xc3, 0x9c,
+	0xb3, 0x31, 0x11, 0x84, 0x51, 0xe1, 0x39, 0xbb, 0x4e, 0x77, 0xbd, 0x7f, 0xf4, 0x7a, 0xe2, 0x37,
+	0xfe, 0x9c, 0xf8, 0x1f, 0xa5, 0x44, 0x9e, 0x16, 0xc3, 0x5e, 0xcc, 0xb2, 0x20, 0xd6, 0x1b, 0xb0,
+	0x3f, 0x7b, 0x22, 0x79, 0x19, 0xc8, 0xf3, 0x1c, 0x8b, 0xde, 0x21, 0x8e, 0xa7, 0x13, 0xbf, 0x7d,
+	0x1e, 0x65, 0xa3, 0x27, 0xb0, 0xae, 0x07, 0xd1, 0xa6, 0x86, 0x06, 0x15, 0xe2, 0x62, 0xd0, 0xe2,
+	0x38, 0x29, 0x62, 0x95, 0x53, 0x98, 0xb1, 0x04, 0x7b, 0xef, 0xec, 0x3a, 0xdd, 0xd6, 0xc1, 0x87,
+	0xbd, 0x9b, 0x4e, 0xa8, 0x87, 0x4a, 0xee, 0x31, 0x4b, 0x70, 0x7f, 0x7b, 0x3a, 0xf1, 0xef, 0x18,
+	0xab, 0x79, 0x11, 0x88, 0x36, 0xf8, 0x55, 0x26, 0x7c, 0xe5, 0x80, 0xcd, 0xe7, 0x98, 0xa4, 0xa7,
+	0x12, 0x27, 0x5f, 0x24, 0x09, 0xc7, 0x42, 0xb8, 0x9f, 0x82, 0xb5, 0xc8, 0x3c, 0xda, 0x7d, 0xba,
+	0xd3, 0x89, 0xdf, 0x32, 0x72, 0x36, 0x00, 0x51, 0x49, 0x71, 0x9f, 0x83, 0xe6, 0x99, 0x16, 0xd0,
+	0x09, 0xae, 0xf7, 0x3f, 0x5b, 0xfa, 0x50, 0x36, 0x8c, 0xb4, 0x51, 0x81, 0xc8, 0xca, 0xc1, 0xdf,
+	0x57, 0x40, 0xfb, 0x90, 0x08, 0xc9, 0xc9, 0xb0, 0x50, 0xf9, 0x0e, 0x38, 0xcb, 0x19, 0x97, 0xfa,
+	0x74, 0x5e, 0x80, 0x35, 0x21, 0xa3, 0x97, 0x84, 0xa6, 0x36, 0xc5, 0xcf, 0x97, 0x76, 0xb5, 0x1b,
+	0xb2, 0x32, 0x10, 0x95, 0x82, 0xee, 0xf7, 0x60, 0x33, 0x67, 0x6c, 0x14, 0x12, 0x1a, 0x63, 0x2a,
+	0xc9, 0x18, 0x0b, 0xbb, 0xb3, 0x6f, 0x96, 0xf6, 0xb8, 0x6b, 0x3c, 0x6a, 0x72, 0x10, 0xb5, 0x14,
+	0x72, 0x54, 0x01, 0xee, 0x19, 0x78, 0x3f, 0xc1, 0x63, 0x3c, 0x62, 0x39, 0xe6, 0x21, 0xc7, 0x67,
+	0x11, 0x4f, 0x84, 0xb7, 0xa2, 0x4d, 0x9f, 0x2d, 0x6d, 0xea, 0x19, 0xd3, 0x6b, 0x82, 0x10, 0x6d,
+	0x55, 0x18, 0x32, 0x90, 0x4b, 0x41, 0x2b, 0x66, 0x59, 0x56, 0x50, 0x22, 0xcf, 0x43, 0x95, 0x94,
+	0xb7, 0xaa, 0x5d, 0xbf, 0x5e, 0xda, 0xd5, 0x96, 0xdb, 0xbc, 0x1a, 0x44, 0x1b, 0x15, 0x30, 0x50,
+	0xeb, 0x7f, 0xd6, 0x40, 0x73, 0x10, 0xf1, 0x28, 0x13, 0xee, 0x07, 0x00, 0xa8, 0x0a, 0x0e, 0x13,
+	0x4c, 0x59, 0x66, 0x6e, 0x11, 0xad, 0x2b, 0xe4, 0x50, 0x01, 0xee, 0x4f, 0x0e, 0xf0, 0x52, 0x4c,
+	0xb1, 0x20, 0x22, 0xbc, 0xd6, 0x7e, 0xe6, 0x3e, 0xbe, 0x5d, 0x3a, 0x49, 0xdf, 0x24, 0xb9, 0x48,
+	0x17, 0xa2, 0xbb, 0x36, 0xf4, 0x55, 0xad, 0x1b, 0x9f, 0x96, 0x33, 0x80, 0x24, 0xea, 0xce, 0x4e,
+	0x08, 0xe6, 0xf6, 0x7e, 0xee, 0xd5, 0xbb, 0x7a, 0xc6, 0x28, 0xbb, 0xfa, 0xa8, 0x42, 0xdc, 0x21,
+	0xd8, 0x99, 0x35, 0x64, 0x8e, 0x39, 0x61, 0x49, 0x48, 0xa8, 0x49, 0x44, 0xe8, 0xb3, 0x5f, 0xe9,
+	0x3f, 0x9c, 0x4e, 0xfc, 0xfb, 0xf5, 0xe6, 0xad, 0x73, 0x21, 0x6a, 0x57, 0xc1, 0x81, 0x8e, 0x1d,
+	0x51, 0x9d, 0xb4, 0x50, 0xf3, 0x6a, 0xf6, 0xde, 0x49, 0x14, 0x4b, 0xc6, 0xbd, 0x77, 0xff, 0xdf,
+	0xbc, 0xaa, 0xeb, 0x41, 0xb4, 0x59, 0x41, 0x4f, 0x35, 0xe2, 0x52, 0xe0, 0x25, 0x57, 0x9a, 0x35,
+	0xcc, 0x67, 0xdd, 0xea, 0x35, 0x77, 0x9d, 0xee, 0xad, 0x83, 0xbd, 0x9b, 0x27, 0xd7, 0x82, 0x16,
+	0xef, 0xaf, 0xaa, 0x64, 0x51, 0x3b, 0x59, 0x30, 0x01, 0x7e, 0x75, 0xc0, 0x83, 0x33, 0x3b, 0xb8,
+	0xc2, 0x6b, 0xb5, 0x1e, 0x72, 0x1c, 0x63, 0x32, 0xc6, 0x5c, 0x78, 0x6b, 0xbb, 0x2b, 0xdd, 0x5b,
+	0x07, 0x0f, 0x6f, 0x36, 0xaf, 0x8d, 0xbe, 0xfe, 0xc7, 0xca, 0x74, 0x76, 0xfe, 0x8b, 0x75, 0x21,
+	0xba, 0x5f, 0xba, 0x1f, 0xd6, 0x9a, 0x0a, 0x95, 0xd6, 0xaa, 0x86, 0xbb, 0xca, 0x8e, 0xd0, 0xb4,
+	0x12, 0x98, 0x3b, 0x24, 0x21, 0x23, 0x2e, 0xcd, 0x8d, 0x7a, 0xef, 0xe9, 0xcb, 0x7f, 0x3c, 0x9d,
+	0xf8, 0x81, 0x31, 0xff, 0xaf, 0x6f, 0x42, 0xf4, 0xc0, 0x52, 0x6d, 0x02, 0x57, 0x4f, 0xf4, 0x3b,
+	0xc5, 0xd3, 0x85, 0x71, 0xc3, 0x17, 0x65, 0xfd, 0x2d, 0x7c, 0x51, 0x9e, 0xac, 0xbe, 0xfa, 0xc5,
+	0x6f, 0x7c, 0x72, 0x0c, 0x36, 0xe6, 0x04, 0xdc, 0x6d, 0x70, 0x67, 0x0e, 0x30, 0x45, 0x4b, 0xe2,
+	0xad, 0x86, 0x7b, 0x0f, 0xb4, 0xe7, 0x42, 0x5f, 0x32, 0xb5, 0x9b, 0x82, 0x15, 0x62, 0xcb, 0xd9,
+	0x59, 0xfd, 0xf1, 0xb7, 0x4e, 0xa3, 0xff, 0xec, 0xf5, 0x45, 0xc7, 0x79, 0x73, 0xd1, 0x71, 0xfe,
+	0xba, 0xe8, 0x38, 0x3f, 0x5f, 0x76, 0x1a, 0x6f, 0x2e, 0x3b, 0x8d, 0x3f, 0x2e, 0x3b, 0x8d, 0x17,
+	0x8f, 0xae, 0xd4, 0xb2, 0xdd, 0xc7, 0xde, 0x28, 0x1a, 0x8a, 0x72, 0x11, 0x8c, 0xf7, 0x1f, 0x05,
+	0x3f, 0x98, 0xff, 0x1b, 0xba, 0xb2, 0x87, 0x4d, 0xfd, 0x85, 0x7f, 0xfc, 0xef, 0x00, 0xaf, 0xcd,
+	0xaf, 0xca, 0x8c, 0x08, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -334,6 +389,11 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReductionMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ReductionMode))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.EpochProvisions.Size()
 		i -= size
@@ -470,6 +530,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReductionMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ReductionMode))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
 		i--
@@ -560,6 +625,9 @@ func (m *Minter) Size() (n int) {
 	_ = l
 	l = m.EpochProvisions.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.ReductionMode != 0 {
+		n += 1 + sovMint(uint64(m.ReductionMode))
+	}
 	return n
 }
 
@@ -627,6 +695,9 @@ func (m *Params) Size() (n int) {
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
 	}
+	if m.ReductionMode != 0 {
+		n += 1 + sovMint(uint64(m.ReductionMode))
+	}
 	return n
 }
 
@@ -699,6 +770,25 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionMode", wireType)
+			}
+			m.ReductionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionMode |= ReductionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -1288,6 +1378,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionMode", wireType)
+			}
+			m.ReductionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionMode |= ReductionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -27,11 +27,101 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
-// NextEpochProvisions returns the epoch provisions.
+// NextEpochProvisions returns the epoch provisions after a reduction, by the reduction factor
+// for periodic reduction, or by the continuous reduction factor for continuous reduction.
 func (m Minter) NextEpochProvisions(params Params) sdk.Dec {
+	if params.ReductionMode == ReductionModeContinuous {
+		return m.EpochProvisions.Mul(params.ContinuousReductionFactor())
+	}
 	return m.EpochProvisions.Mul(params.ReductionFactor)
 }
 
+// ContinuousReductionFactor returns the per epoch reduction factor that compounds to the
+// reduction factor over a reduction period.
+func (p Params) ContinuousReductionFactor() sdk.Dec {
+	factor, err := p.ReductionFactor.ApproxRoot(uint64(p.ReductionPeriodInEpochs))
+	if err != nil {
+		panic(err)
+	}
+	return factor
+}
+
+// RemainingProvisionsMultiplier returns the provisions left to mint from an epoch on, that epoch included,
+// as a multiple of the provisions of that epoch, when reducing them by mode.
+// For periodic reduction, epochsLeft is the number of epochs left in the current reduction period,
+// that epoch included. It returns false if the provisions left are unbounded.
+func RemainingProvisionsMultiplier(params Params, mode ReductionMode, epochsLeft int64) (sdk.Dec, bool) {
+	if mode == ReductionModeContinuous {
+		// 1 + f + f^2 + ... = 1 / (1 - f)
+		factor := params.ContinuousReductionFactor()
+		if factor.GTE(sdk.OneDec()) {
+			return sdk.Dec{}, false
+		}
+		return sdk.OneDec().Quo(sdk.OneDec().Sub(factor)), true
+	}
+
+	// epochsLeft + N * (r + r^2 + ...) = epochsLeft + N * r / (1 - r)
+	if params.ReductionFactor.GTE(sdk.OneDec()) {
+		return sdk.Dec{}, false
+	}
+	nextPeriods := params.ReductionFactor.MulInt64(params.ReductionPeriodInEpochs).Quo(sdk.OneDec().Sub(params.ReductionFactor))
+	return nextPeriods.Add(sdk.NewDec(epochsLeft)), true
+}
+
+// NextEpochMinter returns the minter whose provisions are minted at epochNumber and the last halven epoch number,
+// given the minter and the last halven epoch number of the previous minting epoch.
+// Provisions are reduced once a reduction period has passed for periodic reduction, and every epoch for
+// continuous reduction. When the reduction mode of the params differs from the one of the minter, the provisions
+// are rescaled, so that the provisions expected to be minted from epochNumber on stay the same, and a new reduction
+// period starts at epochNumber.
+func (m Minter) NextEpochMinter(params Params, lastHalvenEpoch, epochNumber int64) (Minter, int64) {
+	continuousFactor := sdk.Dec{}
+	if params.ReductionMode == ReductionModeContinuous {
+		continuousFactor = params.ContinuousReductionFactor()
+	}
+	return m.NextEpochMinterWithFactor(params, continuousFactor, lastHalvenEpoch, epochNumber)
+}
+
+// NextEpochMinterWithFactor is NextEpochMinter given the continuous reduction factor of params,
+// so that it is computed once when the minters of many epochs are computed.
+func (m Minter) NextEpochMinterWithFactor(params Params, continuousFactor sdk.Dec, lastHalvenEpoch, epochNumber int64) (Minter, int64) {
+	if m.ReductionMode == params.ReductionMode {
+		if params.ReductionMode == ReductionModeContinuous {
+			if epochNumber > lastHalvenEpoch {
+				m.EpochProvisions = m.EpochProvisions.Mul(continuousFactor)
+				lastHalvenEpoch = epochNumber
+			}
+		} else if epochNumber >= lastHalvenEpoch+params.ReductionPeriodInEpochs {
+			m.EpochProvisions = m.NextEpochProvisions(params)
+			lastHalvenEpoch = epochNumber
+		}
+		return m, lastHalvenEpoch
+	}
+
+	oldParams := params
+	oldParams.ReductionMode = m.ReductionMode
+
+	// first apply the reduction of this epoch in the old mode
+	epochsLeft := params.ReductionPeriodInEpochs
+	if m.ReductionMode == ReductionModeContinuous {
+		if epochNumber > lastHalvenEpoch {
+			m.EpochProvisions = m.NextEpochProvisions(oldParams)
+		}
+	} else if epochNumber >= lastHalvenEpoch+params.ReductionPeriodInEpochs {
+		m.EpochProvisions = m.NextEpochProvisions(oldParams)
+	} else {
+		epochsLeft = lastHalvenEpoch + params.ReductionPeriodInEpochs - epochNumber
+	}
+
+	oldMultiplier, oldBounded := RemainingProvisionsMultiplier(params, m.ReductionMode, epochsLeft)
+	newMultiplier, newBounded := RemainingProvisionsMultiplier(params, params.ReductionMode, params.ReductionPeriodInEpochs)
+	if oldBounded && newBounded {
+		m.EpochProvisions = m.EpochProvisions.Mul(oldMultiplier).Quo(newMultiplier)
+	}
+	m.ReductionMode = params.ReductionMode
+	return m, epochNumber
+}
+
 // EpochProvision returns the provisions for a block based on the epoch
 // provisions rate.
 func (m Minter) EpochProvision(params Params) sdk.Coin {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 )
 
 // Benchmarking :)
@@ -40,3 +41,42 @@ func BenchmarkNextEpochProvisions(b *testing.B) {
 		minter.NextEpochProvisions(params)
 	}
 }
+
+func TestContinuousReductionFactor(t *testing.T) {
+	params := DefaultParams()
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	params.ReductionPeriodInEpochs = 156
+
+	// compounding the continuous reduction factor over a reduction period gives the reduction factor.
+	factor := params.ContinuousReductionFactor()
+	require.True(t, factor.LT(sdk.OneDec()))
+	require.True(t, factor.Power(uint64(params.ReductionPeriodInEpochs)).Sub(params.ReductionFactor).Abs().LT(sdk.NewDecWithPrec(1, 12)))
+
+	minter := NewMinter(sdk.NewDec(1000))
+	params.ReductionMode = ReductionModeContinuous
+	require.Equal(t, sdk.NewDec(1000).Mul(factor), minter.NextEpochProvisions(params))
+	params.ReductionMode = ReductionModePeriodic
+	require.Equal(t, sdk.NewDec(500), minter.NextEpochProvisions(params))
+}
+
+func TestRemainingProvisionsMultiplier(t *testing.T) {
+	params := DefaultParams()
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	params.ReductionPeriodInEpochs = 10
+
+	// 4 epochs left in the period, then 10 * (1/2 + 1/4 + ...) = 10.
+	multiplier, bounded := RemainingProvisionsMultiplier(params, ReductionModePeriodic, 4)
+	require.True(t, bounded)
+	require.Equal(t, sdk.NewDec(14), multiplier)
+
+	// 1 / (1 - f), with f^10 = 1/2.
+	multiplier, bounded = RemainingProvisionsMultiplier(params, ReductionModeContinuous, 4)
+	require.True(t, bounded)
+	require.Equal(t, sdk.OneDec().Quo(sdk.OneDec().Sub(params.ContinuousReductionFactor())), multiplier)
+
+	params.ReductionFactor = sdk.OneDec()
+	_, bounded = RemainingProvisionsMultiplier(params, ReductionModePeriodic, 4)
+	require.False(t, bounded)
+	_, bounded = RemainingProvisionsMultiplier(params, ReductionModeContinuous, 4)
+	require.False(t, bounded)
+}
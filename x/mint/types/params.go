@@ -23,6 +23,7 @@ var (
 	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyReductionMode                        = []byte("ReductionMode")
 )
 
 // ParamTable for minting module.
@@ -63,6 +64,7 @@ func DefaultParams() Params {
 		},
 		WeightedDeveloperRewardsReceivers:    []WeightedAddress{},
 		MintingRewardsDistributionStartEpoch: 0,
+		ReductionMode:                        ReductionModePeriodic,
 	}
 }
 
@@ -92,6 +94,9 @@ func (p Params) Validate() error {
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
 		return err
 	}
+	if err := validateReductionMode(p.ReductionMode); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -113,6 +118,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyReductionMode, &p.ReductionMode, validateReductionMode),
 	}
 }
 
@@ -244,6 +250,29 @@ func validateWeightedDeveloperRewardsReceivers(i interface{}) error {
 	return nil
 }
 
+// ValidateDeveloperRewardsReceivers validates weighted developer rewards receivers as a param would be,
+// and also rejects duplicate addresses.
+func ValidateDeveloperRewardsReceivers(receivers []WeightedAddress) error {
+	if err := validateWeightedDeveloperRewardsReceivers(receivers); err != nil {
+		return err
+	}
+
+	addresses := make(map[string]bool, len(receivers))
+	for i, w := range receivers {
+		if addresses[w.Address] {
+			return fmt.Errorf("duplicate address %q at %dth", w.Address, i)
+		}
+		addresses[w.Address] = true
+	}
+
+	return nil
+}
+
+// ValidateDistributionProportions validates distribution proportions as a param would be.
+func ValidateDistributionProportions(proportions DistributionProportions) error {
+	return validateDistributionProportions(proportions)
+}
+
 func validateMintingRewardsDistributionStartEpoch(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -256,3 +285,16 @@ func validateMintingRewardsDistributionStartEpoch(i interface{}) error {
 
 	return nil
 }
+
+func validateReductionMode(i interface{}) error {
+	v, ok := i.(ReductionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ReductionMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid reduction mode: %d", v)
+	}
+
+	return nil
+}
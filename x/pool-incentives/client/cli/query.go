@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -32,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdLockableDurations(),
 		GetCmdIncentivizedPools(),
 		GetCmdExternalIncentiveGauges(),
+		GetCmdPoolAPR(),
 	)
 
 	return cmd
@@ -259,3 +261,52 @@ $ %s query pool-incentives external-incentivized-gauges
 
 	return cmd
 }
+
+// GetCmdPoolAPR returns the estimated incentives APR of locking a pool's shares for a lockable duration.
+func GetCmdPoolAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-apr [pool-id] [lockable-duration]",
+		Short: "Query the estimated incentives APR of locking a pool's shares for a lockable duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the estimated annualized incentives APR of locking a pool's shares for a lockable duration.
+
+Example:
+$ %s query pool-incentives pool-apr 1 336h
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolAPR(cmd.Context(), &types.QueryPoolAPRRequest{
+				PoolId:           poolId,
+				LockableDuration: duration,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -28,6 +31,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewCmdSubmitUpdatePoolIncentivesProposal(),
 		NewCmdSubmitReplacePoolIncentivesProposal(),
+		NewCmdSubmitSetPoolLockableDurationsProposal(),
 	)
 
 	return txCmd
@@ -171,3 +175,65 @@ func NewCmdSubmitReplacePoolIncentivesProposal() *cobra.Command {
 
 	return cmd
 }
+
+func NewCmdSubmitSetPoolLockableDurationsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-lockable-durations [pool-id] [lockable-durations]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to set the lockable durations of a pool",
+		Long: `Submit a proposal to set the lockable durations of a pool, for which gauges are created.
+Omitting the lockable durations resets the pool to the module's lockable durations.`,
+		Example: "set-pool-lockable-durations 1 24h,168h",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var lockableDurations []time.Duration
+			if len(args) == 2 {
+				for _, durationStr := range strings.Split(args[1], ",") {
+					duration, err := time.ParseDuration(strings.TrimSpace(durationStr))
+					if err != nil {
+						return err
+					}
+					lockableDurations = append(lockableDurations, duration)
+				}
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal, err := osmoutils.ParseProposalFlags(cmd.Flags())
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolLockableDurationsProposal(proposal.Title, proposal.Description, poolId, lockableDurations)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+
+	return cmd
+}
@@ -8,3 +8,5 @@ import (
 )
 
 var UpdatePoolIncentivesHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdatePoolIncentivesProposal, rest.ProposalUpdatePoolIncentivesRESTHandler)
+
+var SetPoolLockableDurationsHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolLockableDurationsProposal, rest.ProposalSetPoolLockableDurationsRESTHandler)
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -104,3 +105,50 @@ func newReplacePoolIncentivesHandler(clientCtx client.Context) http.HandlerFunc
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+type SetPoolLockableDurationsRequest struct {
+	BaseReq           rest.BaseReq    `json:"base_req" yaml:"base_req"`
+	Title             string          `json:"title" yaml:"title"`
+	Description       string          `json:"description" yaml:"description"`
+	Deposit           sdk.Coins       `json:"deposit" yaml:"deposit"`
+	PoolId            uint64          `json:"pool_id" yaml:"pool_id"`
+	LockableDurations []time.Duration `json:"lockable_durations" yaml:"lockable_durations"`
+}
+
+func ProposalSetPoolLockableDurationsRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-pool-lockable-durations",
+		Handler:  newSetPoolLockableDurationsHandler(clientCtx),
+	}
+}
+
+func newSetPoolLockableDurationsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetPoolLockableDurationsRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewSetPoolLockableDurationsProposal(req.Title, req.Description, req.PoolId, req.LockableDurations)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -16,6 +16,8 @@ func NewPoolIncentivesProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleUpdatePoolIncentivesProposal(ctx, k, c)
 		case *types.ReplacePoolIncentivesProposal:
 			return handleReplacePoolIncentivesProposal(ctx, k, c)
+		case *types.SetPoolLockableDurationsProposal:
+			return handleSetPoolLockableDurationsProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized pool incentives proposal content type: %T", c)
@@ -30,3 +32,7 @@ func handleReplacePoolIncentivesProposal(ctx sdk.Context, k keeper.Keeper, p *ty
 func handleUpdatePoolIncentivesProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdatePoolIncentivesProposal) error {
 	return k.HandleUpdatePoolIncentivesProposal(ctx, p)
 }
+
+func handleSetPoolLockableDurationsProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetPoolLockableDurationsProposal) error {
+	return k.HandleSetPoolLockableDurationsProposal(ctx, p)
+}
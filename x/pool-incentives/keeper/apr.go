@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v10/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// year is the period APRs are annualized over.
+const year = 365 * 24 * time.Hour
+
+// GetPoolAPR estimates the annualized incentives APR of locking the shares of a pool for a lockable duration.
+// A lock is rewarded by every gauge of the pool's shares with a duration up to its own, so for each of these
+// gauges the yearly rewards per locked share are added up, and divided by the value of a share.
+// The internal APR comes from the minted denom distributed to the pool gauges, at the current epoch provisions
+// and distribution weights. The volume weighted proportion of the pool incentives depends on future swaps and is
+// left out, although its unallocated part goes to the distribution records. The external APR comes from the active non-perpetual external gauges, at the rate of
+// their next epoch. Rewards and liquidity are valued in the minted denom, gauges without locked shares are skipped.
+func (k Keeper) GetPoolAPR(ctx sdk.Context, poolId uint64, lockableDuration time.Duration) (internalAPR, externalAPR sdk.Dec, err error) {
+	if !k.isLockableDuration(ctx, poolId, lockableDuration) {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("duration %s is not a lockable duration", lockableDuration)
+	}
+
+	pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	mintedDenom := k.GetParams(ctx).MintedDenom
+	liquidityValue := sdk.ZeroDec()
+	for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+		value, err := k.valueInMintedDenom(ctx, mintedDenom, pool, coin)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		liquidityValue = liquidityValue.Add(value)
+	}
+	if !liquidityValue.IsPositive() || !pool.GetTotalShares().IsPositive() {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("pool %d has no liquidity", poolId)
+	}
+	shareValue := liquidityValue.QuoInt(pool.GetTotalShares())
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+
+	internalPerShare, poolGaugeIds, err := k.getInternalYearlyRewardsPerShare(ctx, poolId, shareDenom, lockableDuration)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	externalPerShare, err := k.getExternalYearlyRewardsPerShare(ctx, mintedDenom, pool, shareDenom, lockableDuration, poolGaugeIds)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return internalPerShare.Quo(shareValue), externalPerShare.Quo(shareValue), nil
+}
+
+// getInternalYearlyRewardsPerShare returns the yearly minted denom rewards per share locked for the lockable duration
+// from the pool gauges, along with the ids of all the pool gauges.
+func (k Keeper) getInternalYearlyRewardsPerShare(ctx sdk.Context, poolId uint64, shareDenom string, lockableDuration time.Duration) (sdk.Dec, map[uint64]bool, error) {
+	distrInfo := k.GetDistrInfo(ctx)
+	gaugeWeights := make(map[uint64]sdk.Int, len(distrInfo.Records))
+	for _, record := range distrInfo.Records {
+		gaugeWeights[record.GaugeId] = record.Weight
+	}
+
+	mintParams := k.mintKeeper.GetParams(ctx)
+	recordsProportion := sdk.OneDec().Sub(k.GetParams(ctx).VolumeWeightedProportion)
+	poolIncentivesPerEpoch := k.mintKeeper.GetMinter(ctx).EpochProvisions.Mul(mintParams.DistributionProportions.PoolIncentives).Mul(recordsProportion)
+	mintEpochsPerYear, err := epochsPerYear(k.epochKeeper.GetEpochInfo(ctx, mintParams.EpochIdentifier))
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	rewardsPerShare := sdk.ZeroDec()
+	poolGaugeIds := map[uint64]bool{}
+	for _, duration := range k.GetPoolLockableDurations(ctx, poolId) {
+		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, duration)
+		if err != nil {
+			return sdk.Dec{}, nil, err
+		}
+		poolGaugeIds[gaugeId] = true
+
+		weight, ok := gaugeWeights[gaugeId]
+		if duration > lockableDuration || !ok || distrInfo.TotalWeight.IsZero() {
+			continue
+		}
+		lockedShares := k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         shareDenom,
+			Duration:      duration,
+		})
+		if !lockedShares.IsPositive() {
+			continue
+		}
+		yearlyRewards := poolIncentivesPerEpoch.MulInt(weight).QuoInt(distrInfo.TotalWeight).Mul(mintEpochsPerYear)
+		rewardsPerShare = rewardsPerShare.Add(yearlyRewards.QuoInt(lockedShares))
+	}
+	return rewardsPerShare, poolGaugeIds, nil
+}
+
+// getExternalYearlyRewardsPerShare returns the yearly rewards per share locked for the lockable duration from the
+// active external gauges, valued in the minted denom. Perpetual gauges are skipped as their refill rate is unknown,
+// and so are rewards that can't be valued.
+func (k Keeper) getExternalYearlyRewardsPerShare(ctx sdk.Context, mintedDenom string, pool gammtypes.PoolI, shareDenom string, lockableDuration time.Duration, poolGaugeIds map[uint64]bool) (sdk.Dec, error) {
+	distrEpochsPerYear, err := epochsPerYear(k.incentivesKeeper.GetEpochInfo(ctx))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	rewardsPerShare := sdk.ZeroDec()
+	for _, gauge := range k.incentivesKeeper.GetActiveGauges(ctx) {
+		if poolGaugeIds[gauge.Id] || gauge.IsPerpetual ||
+			gauge.DistributeTo.LockQueryType != lockuptypes.ByDuration ||
+			gauge.DistributeTo.Denom != shareDenom || gauge.DistributeTo.Duration > lockableDuration {
+			continue
+		}
+		if _, remainWeight := gauge.EpochEmissionWeights(); remainWeight.IsZero() {
+			continue
+		}
+		lockedShares := k.lockupKeeper.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+		if !lockedShares.IsPositive() {
+			continue
+		}
+
+		epochRewardsValue := sdk.ZeroDec()
+		for _, epochCoin := range gauge.EpochEmissionCoins(gauge.Coins.Sub(gauge.DistributedCoins)) {
+			value, err := k.valueInMintedDenom(ctx, mintedDenom, pool, epochCoin)
+			if err != nil {
+				continue
+			}
+			epochRewardsValue = epochRewardsValue.Add(value)
+		}
+		rewardsPerShare = rewardsPerShare.Add(epochRewardsValue.Mul(distrEpochsPerYear).QuoInt(lockedShares))
+	}
+	return rewardsPerShare, nil
+}
+
+// valueInMintedDenom returns the value of a coin in the minted denom. Coins are priced by the pool when it holds
+// both the coin and the minted denom, and by the txfees fee tokens otherwise.
+func (k Keeper) valueInMintedDenom(ctx sdk.Context, mintedDenom string, pool gammtypes.PoolI, coin sdk.Coin) (sdk.Dec, error) {
+	if coin.Denom == mintedDenom {
+		return coin.Amount.ToDec(), nil
+	}
+
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	if liquidity.AmountOf(mintedDenom).IsPositive() && liquidity.AmountOf(coin.Denom).IsPositive() {
+		spotPrice, err := pool.SpotPrice(ctx, mintedDenom, coin.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return spotPrice.MulInt(coin.Amount), nil
+	}
+
+	baseCoin, err := k.txFeesKeeper.ConvertToBaseToken(ctx, coin)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if baseCoin.Denom != mintedDenom {
+		return sdk.Dec{}, fmt.Errorf("can't value %s in %s", coin.Denom, mintedDenom)
+	}
+	return baseCoin.Amount.ToDec(), nil
+}
+
+func (k Keeper) isLockableDuration(ctx sdk.Context, poolId uint64, duration time.Duration) bool {
+	for _, lockableDuration := range k.GetPoolLockableDurations(ctx, poolId) {
+		if lockableDuration == duration {
+			return true
+		}
+	}
+	return false
+}
+
+// epochsPerYear returns the number of epochs of the given epoch in a year.
+// Epochs without a positive duration, such as epochs that don't exist, are rejected.
+func epochsPerYear(epochInfo epochstypes.EpochInfo) (sdk.Dec, error) {
+	if epochInfo.Duration <= 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidEpochDuration, "epoch %s has duration %s", epochInfo.Identifier, epochInfo.Duration)
+	}
+	return sdk.NewDec(int64(year)).QuoInt64(int64(epochInfo.Duration)), nil
+}
@@ -23,6 +23,7 @@ func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, asset sdk.Coin) err
 }
 
 // AllocateAsset allocates and distributes coin according a gauge’s proportional weight that is recorded in the record.
+// When volume weighted incentives are enabled, their proportion of the coin is first allocated by pool swap volume.
 func (k Keeper) AllocateAsset(ctx sdk.Context) error {
 	logger := k.Logger(ctx)
 	params := k.GetParams(ctx)
@@ -33,6 +34,15 @@ func (k Keeper) AllocateAsset(ctx sdk.Context) error {
 		return nil
 	}
 
+	volumeWeightedAmount, err := k.allocateVolumeWeightedAsset(ctx, asset)
+	if err != nil {
+		return err
+	}
+	asset.Amount = asset.Amount.Sub(volumeWeightedAmount)
+	if asset.Amount.IsZero() {
+		return nil
+	}
+
 	distrInfo := k.GetDistrInfo(ctx)
 
 	if distrInfo.TotalWeight.IsZero() {
@@ -86,7 +96,7 @@ func (k Keeper) SetDistrInfo(ctx sdk.Context, distrInfo types.DistrInfo) {
 // Validates a list of records to ensure that:
 // 1) there are no duplicates,
 // 2) the records are in sorted order.
-// 3) the records only pay to gauges that exist.
+// 3) the records only pay to perpetual gauges that exist and have not finished.
 func (k Keeper) validateRecords(ctx sdk.Context, records ...types.DistrRecord) error {
 	lastGaugeID := uint64(0)
 	gaugeIdFlags := make(map[uint64]bool)
@@ -121,6 +131,11 @@ func (k Keeper) validateRecords(ctx sdk.Context, records ...types.DistrRecord) e
 					"Gauge ID #%d is not perpetual.",
 					record.GaugeId)
 			}
+			if k.incentivesKeeper.IsFinishedGauge(ctx, *gauge) {
+				return sdkerrors.Wrapf(types.ErrDistrRecordRegisteredGauge,
+					"Gauge ID #%d has finished.",
+					record.GaugeId)
+			}
 		}
 
 		gaugeIdFlags[record.GaugeId] = true
@@ -194,3 +209,25 @@ func (k Keeper) UpdateDistrRecords(ctx sdk.Context, records ...types.DistrRecord
 	})
 	return nil
 }
+
+// removeDistrRecord drops the DistrRecord of the given gauge, if any.
+func (k Keeper) removeDistrRecord(ctx sdk.Context, gaugeId uint64) {
+	distrInfo := k.GetDistrInfo(ctx)
+	records := []types.DistrRecord{}
+	totalWeight := sdk.ZeroInt()
+	for _, record := range distrInfo.Records {
+		if record.GaugeId == gaugeId {
+			continue
+		}
+		records = append(records, record)
+		totalWeight = totalWeight.Add(record.Weight)
+	}
+	if len(records) == len(distrInfo.Records) {
+		return
+	}
+
+	k.SetDistrInfo(ctx, types.DistrInfo{
+		Records:     records,
+		TotalWeight: totalWeight,
+	})
+}
@@ -333,3 +333,158 @@ func (suite *KeeperTestSuite) TestUpdateDistrRecords() uint64 {
 
 	return gaugeId
 }
+
+func (suite *KeeperTestSuite) TestAllocateAssetVolumeWeighted() {
+	keeper := suite.App.PoolIncentivesKeeper
+	mintKeeper := suite.App.MintKeeper
+	mintParams := suite.App.MintKeeper.GetParams(suite.Ctx)
+	mintParams.WeightedDeveloperRewardsReceivers = []minttypes.WeightedAddress{
+		{
+			Address: sdk.AccAddress([]byte("addr1---------------")).String(),
+			Weight:  sdk.NewDec(1),
+		},
+	}
+	suite.App.MintKeeper.SetParams(suite.Ctx, mintParams)
+
+	pool1Id := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	pool2Id := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	pool3Id := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("foo", 1000000))
+
+	// half of the pool incentives are volume weighted, with at most 60% of them to a single pool
+	params := keeper.GetParams(suite.Ctx)
+	params.VolumeWeightedProportion = sdk.NewDecWithPrec(5, 1)
+	params.VolumeWeightedPools = []uint64{pool1Id, pool2Id}
+	params.MaxPoolVolumeShare = sdk.NewDecWithPrec(6, 1)
+	keeper.SetParams(suite.Ctx, params)
+
+	lockableDurations := keeper.GetLockableDurations(suite.Ctx)
+	shortestGaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, pool1Id, lockableDurations[0])
+	suite.NoError(err)
+	err = keeper.ReplaceDistrRecords(suite.Ctx, types.DistrRecord{
+		GaugeId: shortestGaugeId,
+		Weight:  sdk.NewInt(100),
+	})
+	suite.NoError(err)
+
+	// swaps into non whitelisted pools aren't tracked
+	swapper := suite.TestAccs[1]
+	suite.FundAcc(swapper, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)))
+	for poolId, amount := range map[uint64]int64{pool1Id: 3000, pool2Id: 1000, pool3Id: 5000} {
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, sdk.NewInt64Coin("stake", amount), "foo", sdk.OneInt())
+		suite.NoError(err)
+	}
+	suite.Equal(sdk.NewDec(3000), keeper.GetPoolVolume(suite.Ctx, pool1Id))
+	suite.Equal(sdk.NewDec(1000), keeper.GetPoolVolume(suite.Ctx, pool2Id))
+	suite.Equal(sdk.ZeroDec(), keeper.GetPoolVolume(suite.Ctx, pool3Id))
+
+	mintCoin := sdk.NewCoin("stake", sdk.NewInt(100000))
+	err = mintKeeper.MintCoins(suite.Ctx, sdk.Coins{mintCoin})
+	suite.NoError(err)
+	err = mintKeeper.DistributeMintedCoin(suite.Ctx, mintCoin) // this calls AllocateAsset via hook
+	suite.NoError(err)
+
+	// 15000stake of the 30000stake pool incentives are volume weighted, pool 1 is capped at 9000stake
+	// and pool 2 gets a quarter of them, the remaining 17250stake go to the distr records.
+	expectedCoins := map[uint64]string{
+		pool1Id: "9000stake",
+		pool2Id: "3750stake",
+		pool3Id: "",
+	}
+	for poolId, coins := range expectedCoins {
+		gaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDurations[len(lockableDurations)-1])
+		suite.NoError(err)
+		gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+		suite.NoError(err)
+		suite.Equal(coins, gauge.Coins.String())
+	}
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, shortestGaugeId)
+	suite.NoError(err)
+	suite.Equal("17250stake", gauge.Coins.String())
+
+	// volumes are reset after each allocation
+	suite.Equal(sdk.ZeroDec(), keeper.GetPoolVolume(suite.Ctx, pool1Id))
+	suite.Equal(sdk.ZeroDec(), keeper.GetPoolVolume(suite.Ctx, pool2Id))
+}
+
+// TestCancelledPoolGaugeMintEpoch tests that cancelling a pool gauge drops its distribution record, so that
+// the next mint epoch doesn't try to pay the finished gauge, and that records can't pay finished gauges.
+func (suite *KeeperTestSuite) TestCancelledPoolGaugeMintEpoch() {
+	keeper := suite.App.PoolIncentivesKeeper
+	mintKeeper := suite.App.MintKeeper
+
+	pool1Id := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	pool2Id := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("foo", 1000000))
+
+	params := keeper.GetParams(suite.Ctx)
+	params.VolumeWeightedProportion = sdk.NewDecWithPrec(5, 1)
+	params.VolumeWeightedPools = []uint64{pool1Id, pool2Id}
+	params.MaxPoolVolumeShare = sdk.OneDec()
+	keeper.SetParams(suite.Ctx, params)
+
+	lockableDurations := keeper.GetLockableDurations(suite.Ctx)
+	longestDuration := lockableDurations[len(lockableDurations)-1]
+	cancelledGaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, pool1Id, longestDuration)
+	suite.NoError(err)
+	otherGaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, pool2Id, longestDuration)
+	suite.NoError(err)
+	err = keeper.ReplaceDistrRecords(suite.Ctx,
+		types.DistrRecord{GaugeId: cancelledGaugeId, Weight: sdk.NewInt(100)},
+		types.DistrRecord{GaugeId: otherGaugeId, Weight: sdk.NewInt(100)},
+	)
+	suite.NoError(err)
+
+	swapper := suite.TestAccs[1]
+	suite.FundAcc(swapper, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)))
+	for _, poolId := range []uint64{pool1Id, pool2Id} {
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, sdk.NewInt64Coin("stake", 1000), "foo", sdk.OneInt())
+		suite.NoError(err)
+	}
+
+	_, err = suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, cancelledGaugeId)
+	suite.NoError(err)
+
+	distrInfo := keeper.GetDistrInfo(suite.Ctx)
+	suite.Equal([]types.DistrRecord{{GaugeId: otherGaugeId, Weight: sdk.NewInt(100)}}, distrInfo.Records)
+	suite.Equal(sdk.NewInt(100), distrInfo.TotalWeight)
+
+	// the mint epoch neither pays the cancelled gauge through its record nor through its volume
+	mintCoin := sdk.NewCoin("stake", sdk.NewInt(100000))
+	err = mintKeeper.MintCoins(suite.Ctx, sdk.Coins{mintCoin})
+	suite.NoError(err)
+	suite.NotPanics(func() {
+		err = mintKeeper.DistributeMintedCoin(suite.Ctx, mintCoin) // this calls AllocateAsset via hook
+	})
+	suite.NoError(err)
+
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, cancelledGaugeId)
+	suite.NoError(err)
+	suite.True(gauge.Coins.IsZero())
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, otherGaugeId)
+	suite.NoError(err)
+	suite.False(gauge.Coins.IsZero())
+
+	// records can't be set to pay the cancelled gauge again
+	err = keeper.ReplaceDistrRecords(suite.Ctx, types.DistrRecord{GaugeId: cancelledGaugeId, Weight: sdk.NewInt(100)})
+	suite.Error(err)
+	err = keeper.UpdateDistrRecords(suite.Ctx, types.DistrRecord{GaugeId: cancelledGaugeId, Weight: sdk.NewInt(100)})
+	suite.Error(err)
+}
+
+// TestRecordSwapVolumePreSwapPrice tests that tokens swapped into a volume weighted pool are valued
+// at the pool's price before the swap.
+func (suite *KeeperTestSuite) TestRecordSwapVolumePreSwapPrice() {
+	keeper := suite.App.PoolIncentivesKeeper
+	poolId := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("foo", 1000000))
+
+	params := keeper.GetParams(suite.Ctx)
+	params.VolumeWeightedProportion = sdk.NewDecWithPrec(5, 1)
+	params.VolumeWeightedPools = []uint64{poolId}
+	keeper.SetParams(suite.Ctx, params)
+
+	// the swap lowers the price of foo, but the volume is valued at the price of one stake per foo before it
+	swapper := suite.TestAccs[1]
+	suite.FundAcc(swapper, sdk.NewCoins(sdk.NewInt64Coin("foo", 100000)))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, sdk.NewInt64Coin("foo", 100000), "stake", sdk.OneInt())
+	suite.NoError(err)
+	suite.Equal(sdk.NewDec(100000), keeper.GetPoolVolume(suite.Ctx, poolId))
+}
@@ -19,7 +19,10 @@ var (
 	now         = time.Now().UTC()
 	testGenesis = types.GenesisState{
 		Params: types.Params{
-			MintedDenom: "uosmo",
+			MintedDenom:              "uosmo",
+			VolumeWeightedProportion: sdk.NewDecWithPrec(2, 1),
+			VolumeWeightedPools:      []uint64{1, 2},
+			MaxPoolVolumeShare:       sdk.NewDecWithPrec(5, 1),
 		},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -17,6 +17,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	} else {
 		k.SetDistrInfo(ctx, *genState.DistrInfo)
 	}
+	for _, poolLockableDurations := range genState.PoolLockableDurations {
+		k.setPoolLockableDurationsInfo(ctx, poolLockableDurations)
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -26,5 +29,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Params:            k.GetParams(ctx),
 		LockableDurations: k.GetLockableDurations(ctx),
 		DistrInfo:         &distrInfo,
+
+		PoolLockableDurations: k.GetAllPoolLockableDurations(ctx),
 	}
 }
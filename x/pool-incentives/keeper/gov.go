@@ -13,3 +13,7 @@ func (k Keeper) HandleReplacePoolIncentivesProposal(ctx sdk.Context, p *types.Re
 func (k Keeper) HandleUpdatePoolIncentivesProposal(ctx sdk.Context, p *types.UpdatePoolIncentivesProposal) error {
 	return k.UpdateDistrRecords(ctx, p.Records...)
 }
+
+func (k Keeper) HandleSetPoolLockableDurationsProposal(ctx sdk.Context, p *types.SetPoolLockableDurationsProposal) error {
+	return k.SetPoolLockableDurations(ctx, p.PoolId, p.LockableDurations)
+}
@@ -31,7 +31,7 @@ func (q Querier) GaugeIds(ctx context.Context, req *types.QueryGaugeIdsRequest)
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	lockableDurations := q.Keeper.GetLockableDurations(sdkCtx)
+	lockableDurations := q.Keeper.GetPoolLockableDurations(sdkCtx, req.PoolId)
 	gaugeIdsWithDuration := make([]*types.QueryGaugeIdsResponse_GaugeIdWithDuration, len(lockableDurations))
 
 	for i, duration := range lockableDurations {
@@ -75,17 +75,21 @@ func (q Querier) IncentivizedPools(ctx context.Context, _ *types.QueryIncentiviz
 	incentivizedPools := make([]types.IncentivizedPool, 0, len(distrInfo.Records)/len(lockableDurations))
 
 	for _, record := range distrInfo.Records {
-		for _, lockableDuration := range lockableDurations {
-			poolId, err := q.Keeper.GetPoolIdFromGaugeId(sdkCtx, record.GaugeId, lockableDuration)
-			if err == nil {
-				incentivizedPool := types.IncentivizedPool{
-					PoolId:           poolId,
-					LockableDuration: lockableDuration,
-					GaugeId:          record.GaugeId,
-				}
-
-				incentivizedPools = append(incentivizedPools, incentivizedPool)
+		// pools may have their own lockable durations, so the gauge's duration is looked up
+		gauge, err := q.Keeper.incentivesKeeper.GetGaugeByID(sdkCtx, record.GaugeId)
+		if err != nil {
+			continue
+		}
+		lockableDuration := gauge.DistributeTo.Duration
+		poolId, err := q.Keeper.GetPoolIdFromGaugeId(sdkCtx, record.GaugeId, lockableDuration)
+		if err == nil {
+			incentivizedPool := types.IncentivizedPool{
+				PoolId:           poolId,
+				LockableDuration: lockableDuration,
+				GaugeId:          record.GaugeId,
 			}
+
+			incentivizedPools = append(incentivizedPools, incentivizedPool)
 		}
 	}
 
@@ -125,3 +129,22 @@ func (q Querier) ExternalIncentiveGauges(ctx context.Context, req *types.QueryEx
 
 	return &types.QueryExternalIncentiveGaugesResponse{Data: gauges}, nil
 }
+
+// PoolAPR returns the estimated annualized incentives APR of locking the shares of a pool for a lockable duration.
+func (q Querier) PoolAPR(ctx context.Context, req *types.QueryPoolAPRRequest) (*types.QueryPoolAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	internalAPR, externalAPR, err := q.Keeper.GetPoolAPR(sdkCtx, req.PoolId, req.LockableDuration)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPoolAPRResponse{
+		InternalApr: internalAPR,
+		ExternalApr: externalAPR,
+		TotalApr:    internalAPR.Add(externalAPR),
+	}, nil
+}
@@ -285,3 +285,79 @@ func (suite *KeeperTestSuite) TestIncentivizedPools2() {
 	})
 	suite.Error(err)
 }
+
+func (suite *KeeperTestSuite) TestPoolAPR() {
+	suite.SetupTest()
+
+	keeper := suite.App.PoolIncentivesKeeper
+	queryClient := suite.queryClient
+	mintedDenom := keeper.GetParams(suite.Ctx).MintedDenom
+	lockableDuration := keeper.GetLockableDurations(suite.Ctx)[0]
+
+	// the pool's liquidity is worth 2000000 of the minted denom, foo being worth half of it
+	poolId := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin(mintedDenom, 1000000), sdk.NewInt64Coin("foo", 2000000))
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	shareValue := sdk.NewDec(2000000).QuoInt(pool.GetTotalShares())
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+
+	// half of the shares are locked
+	lockedShares := pool.GetTotalShares().QuoRaw(2)
+	_, err = suite.App.LockupKeeper.CreateLock(suite.Ctx, suite.TestAccs[0], sdk.Coins{sdk.NewCoin(shareDenom, lockedShares)}, lockableDuration)
+	suite.Require().NoError(err)
+
+	// unincentivized pools have no APR
+	res, err := queryClient.PoolAPR(context.Background(), &types.QueryPoolAPRRequest{PoolId: poolId, LockableDuration: lockableDuration})
+	suite.Require().NoError(err)
+	suite.Require().True(res.TotalApr.IsZero())
+
+	// all of the pool incentives go to the pool's gauge for the lockable duration
+	gaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDuration)
+	suite.Require().NoError(err)
+	err = keeper.ReplaceDistrRecords(suite.Ctx, types.DistrRecord{GaugeId: gaugeId, Weight: sdk.NewInt(100)})
+	suite.Require().NoError(err)
+	minter := suite.App.MintKeeper.GetMinter(suite.Ctx)
+	minter.EpochProvisions = sdk.NewDec(1000000)
+	suite.App.MintKeeper.SetMinter(suite.Ctx, minter)
+	mintParams := suite.App.MintKeeper.GetParams(suite.Ctx)
+	mintEpochDuration := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, mintParams.EpochIdentifier).Duration
+	yearlyMinted := sdk.NewDec(1000000).Mul(mintParams.DistributionProportions.PoolIncentives).MulInt64(int64(365 * 24 * time.Hour)).QuoInt64(int64(mintEpochDuration))
+	expectedInternalAPR := yearlyMinted.QuoInt(lockedShares).Quo(shareValue)
+
+	// an external gauge paying 1000000 foo in each of its 3 epochs, with 1 epoch already distributed
+	suite.FundAcc(suite.TestAccs[1], sdk.Coins{sdk.NewInt64Coin("foo", 3000000)})
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, notPerpetual, suite.TestAccs[1], sdk.Coins{sdk.NewInt64Coin("foo", 3000000)},
+		lockuptypes.QueryCondition{LockQueryType: lockuptypes.ByDuration, Denom: shareDenom, Duration: lockableDuration}, suite.Ctx.BlockTime(), 3)
+	suite.Require().NoError(err)
+	suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, suite.App.IncentivesKeeper.GetParams(suite.Ctx).DistrEpochIdentifier, 1)
+	distrEpochDuration := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).Duration
+	yearlyExternal := sdk.NewDec(500000).MulInt64(int64(365 * 24 * time.Hour)).QuoInt64(int64(distrEpochDuration))
+	expectedExternalAPR := yearlyExternal.QuoInt(lockedShares).Quo(shareValue)
+
+	res, err = queryClient.PoolAPR(context.Background(), &types.QueryPoolAPRRequest{PoolId: poolId, LockableDuration: lockableDuration})
+	suite.Require().NoError(err)
+	suite.Require().True(res.InternalApr.IsPositive())
+	suite.Require().Equal(expectedInternalAPR, res.InternalApr)
+	suite.Require().Equal(expectedExternalAPR, res.ExternalApr)
+	suite.Require().Equal(expectedInternalAPR.Add(expectedExternalAPR), res.TotalApr)
+
+	// the volume weighted proportion of the pool incentives isn't paid to the pool's gauge by its distribution record
+	params := keeper.GetParams(suite.Ctx)
+	params.VolumeWeightedProportion = sdk.NewDecWithPrec(25, 2)
+	keeper.SetParams(suite.Ctx, params)
+	res, err = queryClient.PoolAPR(context.Background(), &types.QueryPoolAPRRequest{PoolId: poolId, LockableDuration: lockableDuration})
+	suite.Require().NoError(err)
+	yearlyMinted = sdk.NewDec(1000000).Mul(mintParams.DistributionProportions.PoolIncentives).Mul(sdk.NewDecWithPrec(75, 2)).MulInt64(int64(365 * 24 * time.Hour)).QuoInt64(int64(mintEpochDuration))
+	suite.Require().Equal(yearlyMinted.QuoInt(lockedShares).Quo(shareValue), res.InternalApr)
+	suite.Require().Equal(expectedExternalAPR, res.ExternalApr)
+
+	// only lockable durations have an APR
+	_, err = queryClient.PoolAPR(context.Background(), &types.QueryPoolAPRRequest{PoolId: poolId, LockableDuration: lockableDuration + time.Second})
+	suite.Require().Error(err)
+
+	// neither do pools when the mint epoch doesn't exist
+	mintParams.EpochIdentifier = "nonexistent"
+	suite.App.MintKeeper.SetParams(suite.Ctx, mintParams)
+	_, _, err = keeper.GetPoolAPR(suite.Ctx, poolId, lockableDuration)
+	suite.Require().ErrorIs(err, types.ErrInvalidEpochDuration)
+}
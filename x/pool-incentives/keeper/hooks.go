@@ -4,13 +4,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v10/x/incentives/types"
 )
 
 type Hooks struct {
 	k Keeper
 }
 
-var _ gammtypes.GammHooks = Hooks{}
+var (
+	_ gammtypes.GammHooks            = Hooks{}
+	_ incentivestypes.IncentiveHooks = Hooks{}
+)
 
 // Create new pool incentives hooks.
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
@@ -31,8 +35,9 @@ func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
 }
 
-// AfterSwap hook is a noop.
+// AfterSwap records the swap volume of volume weighted pools.
 func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.k.RecordSwapVolume(ctx, poolId, input, output)
 }
 
 // Distribute coins after minter module allocate assets to pool-incentives module.
@@ -56,3 +61,21 @@ func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
 		panic(err)
 	}
 }
+
+// AfterCreateGauge hook is a noop.
+func (h Hooks) AfterCreateGauge(ctx sdk.Context, gaugeId uint64) {}
+
+// AfterAddToGauge hook is a noop.
+func (h Hooks) AfterAddToGauge(ctx sdk.Context, gaugeId uint64) {}
+
+// AfterStartDistribution hook is a noop.
+func (h Hooks) AfterStartDistribution(ctx sdk.Context, gaugeId uint64) {}
+
+// AfterFinishDistribution drops the distribution record of a finished gauge, so that a cancelled pool gauge
+// isn't paid pool incentives anymore.
+func (h Hooks) AfterFinishDistribution(ctx sdk.Context, gaugeId uint64) {
+	h.k.removeDistrRecord(ctx, gaugeId)
+}
+
+// AfterEpochDistribution hook is a noop.
+func (h Hooks) AfterEpochDistribution(ctx sdk.Context) {}
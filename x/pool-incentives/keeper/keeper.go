@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -27,12 +28,17 @@ type Keeper struct {
 	bankKeeper       types.BankKeeper
 	incentivesKeeper types.IncentivesKeeper
 	distrKeeper      types.DistrKeeper
+	gammKeeper       types.GAMMKeeper
+	mintKeeper       types.MintKeeper
+	epochKeeper      types.EpochKeeper
+	lockupKeeper     types.LockupKeeper
+	txFeesKeeper     types.TxFeesKeeper
 
 	communityPoolName string // name of the Community pool ModuleAccount (Maybe the distribution module)
 	feeCollectorName  string // name of the FeeCollector ModuleAccount
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, communityPoolName string, feeCollectorName string) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, gammKeeper types.GAMMKeeper, mintKeeper types.MintKeeper, epochKeeper types.EpochKeeper, lockupKeeper types.LockupKeeper, txFeesKeeper types.TxFeesKeeper, communityPoolName string, feeCollectorName string) Keeper {
 	// ensure pool-incentives module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -53,6 +59,11 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		bankKeeper:       bankKeeper,
 		incentivesKeeper: incentivesKeeper,
 		distrKeeper:      distrKeeper,
+		gammKeeper:       gammKeeper,
+		mintKeeper:       mintKeeper,
+		epochKeeper:      epochKeeper,
+		lockupKeeper:     lockupKeeper,
+		txFeesKeeper:     txFeesKeeper,
 
 		communityPoolName: communityPoolName,
 		feeCollectorName:  feeCollectorName,
@@ -64,31 +75,39 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// CreatePoolGauges creates a gauge for each of the pool's lockable durations.
 func (k Keeper) CreatePoolGauges(ctx sdk.Context, poolId uint64) error {
 	// Create the same number of gaugeges as there are LockableDurations
-	for _, lockableDuration := range k.GetLockableDurations(ctx) {
-		gaugeId, err := k.incentivesKeeper.CreateGauge(
-			ctx,
-			true,
-			k.accountKeeper.GetModuleAddress(types.ModuleName),
-			sdk.Coins{},
-			lockuptypes.QueryCondition{
-				LockQueryType: lockuptypes.ByDuration,
-				Denom:         gammtypes.GetPoolShareDenom(poolId),
-				Duration:      lockableDuration,
-				Timestamp:     time.Time{},
-			},
-			// QUESTION: Should we set the startTime as the epoch start time that the modules share or the current block time?
-			ctx.BlockTime(),
-			1,
-		)
-		if err != nil {
+	for _, lockableDuration := range k.GetPoolLockableDurations(ctx, poolId) {
+		if err := k.createPoolGauge(ctx, poolId, lockableDuration); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		k.SetPoolGaugeId(ctx, poolId, lockableDuration, gaugeId)
+func (k Keeper) createPoolGauge(ctx sdk.Context, poolId uint64, lockableDuration time.Duration) error {
+	gaugeId, err := k.incentivesKeeper.CreateGauge(
+		ctx,
+		true,
+		k.accountKeeper.GetModuleAddress(types.ModuleName),
+		sdk.Coins{},
+		lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         gammtypes.GetPoolShareDenom(poolId),
+			Duration:      lockableDuration,
+			Timestamp:     time.Time{},
+		},
+		// QUESTION: Should we set the startTime as the epoch start time that the modules share or the current block time?
+		ctx.BlockTime(),
+		1,
+	)
+	if err != nil {
+		return err
 	}
 
+	k.SetPoolGaugeId(ctx, poolId, lockableDuration, gaugeId)
 	return nil
 }
 
@@ -147,6 +166,90 @@ func (k Keeper) GetLockableDurations(ctx sdk.Context) []time.Duration {
 	return info.LockableDurations
 }
 
+// SetPoolLockableDurations sets the lockable durations of a pool, overriding the module's lockable durations.
+// Gauges are created for the durations the pool doesn't have a gauge for yet. The gauges of removed durations
+// are kept, but their DistrRecords are dropped so that they don't receive pool incentives anymore. Empty lockable
+// durations reset the pool to the module's lockable durations.
+func (k Keeper) SetPoolLockableDurations(ctx sdk.Context, poolId uint64, lockableDurations []time.Duration) error {
+	if _, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId); err != nil {
+		return err
+	}
+	prevLockableDurations := k.GetPoolLockableDurations(ctx, poolId)
+
+	store := ctx.KVStore(k.storeKey)
+	if len(lockableDurations) == 0 {
+		store.Delete(types.GetPoolLockableDurationsStoreKey(poolId))
+	} else {
+		k.setPoolLockableDurationsInfo(ctx, types.PoolLockableDurations{PoolId: poolId, LockableDurations: lockableDurations})
+	}
+
+	for _, lockableDuration := range k.GetPoolLockableDurations(ctx, poolId) {
+		if _, err := k.GetPoolGaugeId(ctx, poolId, lockableDuration); err == nil {
+			continue
+		}
+		if err := k.createPoolGauge(ctx, poolId, lockableDuration); err != nil {
+			return err
+		}
+	}
+
+	for _, prevLockableDuration := range prevLockableDurations {
+		if k.isLockableDuration(ctx, poolId, prevLockableDuration) {
+			continue
+		}
+		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, prevLockableDuration)
+		if err != nil {
+			continue
+		}
+		k.removeDistrRecord(ctx, gaugeId)
+	}
+
+	return nil
+}
+
+func (k Keeper) setPoolLockableDurationsInfo(ctx sdk.Context, info types.PoolLockableDurations) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolLockableDurationsStoreKey(info.PoolId), k.cdc.MustMarshal(&info))
+}
+
+// GetPoolLockableDurations returns the lockable durations of a pool, which are the module's lockable durations
+// unless governance set the pool's own.
+func (k Keeper) GetPoolLockableDurations(ctx sdk.Context, poolId uint64) []time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolLockableDurationsStoreKey(poolId))
+	if len(bz) == 0 {
+		return k.GetLockableDurations(ctx)
+	}
+
+	info := types.PoolLockableDurations{}
+	k.cdc.MustUnmarshal(bz, &info)
+	return info.LockableDurations
+}
+
+// GetAllPoolLockableDurations returns the lockable durations of all the pools with their own lockable durations.
+func (k Keeper) GetAllPoolLockableDurations(ctx sdk.Context) []types.PoolLockableDurations {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolLockableDurationsPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allPoolLockableDurations := []types.PoolLockableDurations{}
+	for ; iterator.Valid(); iterator.Next() {
+		info := types.PoolLockableDurations{}
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		allPoolLockableDurations = append(allPoolLockableDurations, info)
+	}
+	return allPoolLockableDurations
+}
+
+// GetLongestLockableDuration returns the longest lockable duration of a pool.
+func (k Keeper) GetLongestLockableDuration(ctx sdk.Context, poolId uint64) (longest time.Duration) {
+	for _, duration := range k.GetPoolLockableDurations(ctx, poolId) {
+		if duration > longest {
+			longest = duration
+		}
+	}
+	return longest
+}
+
 func (k Keeper) GetAllGauges(ctx sdk.Context) []incentivestypes.Gauge {
 	gauges := k.incentivesKeeper.GetGauges(ctx)
 	return gauges
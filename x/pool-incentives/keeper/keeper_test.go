@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v10/app/apptesting"
 	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
@@ -71,3 +74,69 @@ func (suite *KeeperTestSuite) TestCreateBalancerPoolGauges() {
 		suite.Equal(lockableDurations[2], gauge.DistributeTo.Duration)
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetPoolLockableDurations() {
+	suite.SetupTest()
+
+	keeper := suite.App.PoolIncentivesKeeper
+	lockableDurations := keeper.GetLockableDurations(suite.Ctx)
+	poolId := suite.PrepareBalancerPool()
+	gaugeIds := func() []time.Duration {
+		res, err := suite.queryClient.GaugeIds(sdk.WrapSDKContext(suite.Ctx), &types.QueryGaugeIdsRequest{PoolId: poolId})
+		suite.Require().NoError(err)
+		durations := []time.Duration{}
+		for _, gaugeIdWithDuration := range res.GaugeIdsWithDuration {
+			durations = append(durations, gaugeIdWithDuration.Duration)
+		}
+		return durations
+	}
+	suite.Require().Equal(lockableDurations, gaugeIds())
+
+	// the pool only keeps its existing gauge for the shortest duration,
+	// the DistrRecords of the removed durations are dropped
+	shortestGaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDurations[0])
+	suite.Require().NoError(err)
+	longestGaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDurations[len(lockableDurations)-1])
+	suite.Require().NoError(err)
+	err = keeper.ReplaceDistrRecords(suite.Ctx,
+		types.DistrRecord{GaugeId: shortestGaugeId, Weight: sdk.NewInt(100)},
+		types.DistrRecord{GaugeId: longestGaugeId, Weight: sdk.NewInt(200)},
+	)
+	suite.Require().NoError(err)
+	err = keeper.SetPoolLockableDurations(suite.Ctx, poolId, []time.Duration{lockableDurations[0]})
+	suite.Require().NoError(err)
+	distrInfo := keeper.GetDistrInfo(suite.Ctx)
+	suite.Require().Equal([]types.DistrRecord{{GaugeId: shortestGaugeId, Weight: sdk.NewInt(100)}}, distrInfo.Records)
+	suite.Require().Equal(sdk.NewInt(100), distrInfo.TotalWeight)
+	suite.Require().Equal([]time.Duration{lockableDurations[0]}, gaugeIds())
+	gaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDurations[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(shortestGaugeId, gaugeId)
+	suite.Require().Equal(lockableDurations[0], keeper.GetLongestLockableDuration(suite.Ctx, poolId))
+
+	// a gauge is created for a new duration, which must be an incentives lockable duration
+	err = keeper.SetPoolLockableDurations(suite.Ctx, poolId, []time.Duration{24 * time.Hour})
+	suite.Require().Error(err)
+	err = keeper.SetPoolLockableDurations(suite.Ctx, poolId, []time.Duration{time.Second, lockableDurations[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]time.Duration{time.Second, lockableDurations[0]}, gaugeIds())
+	gaugeId, err = keeper.GetPoolGaugeId(suite.Ctx, poolId, time.Second)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+	suite.Require().True(gauge.IsPerpetual)
+	suite.Require().Equal(gammtypes.GetPoolShareDenom(poolId), gauge.DistributeTo.Denom)
+	suite.Require().Equal(time.Second, gauge.DistributeTo.Duration)
+	suite.Require().Equal(lockableDurations[0], keeper.GetLongestLockableDuration(suite.Ctx, poolId))
+	suite.Require().Equal([]types.PoolLockableDurations{{PoolId: poolId, LockableDurations: []time.Duration{time.Second, lockableDurations[0]}}}, keeper.GetAllPoolLockableDurations(suite.Ctx))
+
+	// empty durations reset the pool to the module's lockable durations
+	err = keeper.SetPoolLockableDurations(suite.Ctx, poolId, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lockableDurations, gaugeIds())
+	suite.Require().Empty(keeper.GetAllPoolLockableDurations(suite.Ctx))
+
+	// the pool must exist
+	err = keeper.SetPoolLockableDurations(suite.Ctx, poolId+1, []time.Duration{time.Hour})
+	suite.Require().Error(err)
+}
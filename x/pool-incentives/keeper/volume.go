@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
+)
+
+// GetPoolVolume returns the swap volume of a pool since the previous allocation, valued in the minted denom.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolVolumeStoreKey(poolId))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	volume := sdk.Dec{}
+	if err := volume.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return volume
+}
+
+func (k Keeper) setPoolVolume(ctx sdk.Context, poolId uint64, volume sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := volume.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetPoolVolumeStoreKey(poolId), bz)
+}
+
+// clearPoolVolumes resets the swap volumes of all pools.
+func (k Keeper) clearPoolVolumes(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolVolumePrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// RecordSwapVolume adds the value of the tokens swapped into a volume weighted pool to its volume.
+// Swaps are only tracked while volume weighted incentives are enabled, and tokens that can't be valued
+// in the minted denom are ignored. Tokens are valued at the pool's price before the swap, so that a swap
+// can't inflate its own volume by moving the price.
+func (k Keeper) RecordSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	params := k.GetParams(ctx)
+	if !params.VolumeWeightedProportion.IsPositive() || !params.IsVolumeWeightedPool(poolId) {
+		return
+	}
+
+	pool, err := k.getPreSwapPool(ctx, poolId, input, output)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to get pool %d before swap: %s", poolId, err.Error()))
+		return
+	}
+	volume := k.GetPoolVolume(ctx, poolId)
+	for _, coin := range input {
+		value, err := k.valueInMintedDenom(ctx, params.MintedDenom, pool, coin)
+		if err != nil {
+			continue
+		}
+		volume = volume.Add(value)
+	}
+	k.setPoolVolume(ctx, poolId, volume)
+}
+
+// getPreSwapPool returns the pool as it was before a swap of the given input for the given output,
+// by reverting the swap on the pool's liquidity.
+func (k Keeper) getPreSwapPool(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) (gammtypes.PoolI, error) {
+	// the swap was done with the weights of the block time
+	pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool type %T is not supported", pool)
+	}
+	liquidity, hasNeg := balancerPool.GetTotalPoolLiquidity(ctx).Add(output...).SafeSub(input)
+	if hasNeg {
+		return nil, fmt.Errorf("pool liquidity %s can't have received %s", balancerPool.GetTotalPoolLiquidity(ctx), input)
+	}
+	if err := balancerPool.UpdatePoolAssetBalances(liquidity); err != nil {
+		return nil, err
+	}
+	return balancerPool, nil
+}
+
+// allocateVolumeWeightedAsset allocates the volume weighted proportion of the asset to the volume weighted pools,
+// in proportion to their swap volume since the previous allocation and up to the max pool volume share each.
+// A pool's allocation goes to its gauge of the longest lockable duration. It returns the amount allocated, the rest
+// being left to the DistrRecords, and resets the pool volumes.
+func (k Keeper) allocateVolumeWeightedAsset(ctx sdk.Context, asset sdk.Coin) (sdk.Int, error) {
+	defer k.clearPoolVolumes(ctx)
+
+	params := k.GetParams(ctx)
+	if !params.VolumeWeightedProportion.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+	budget := asset.Amount.ToDec().Mul(params.VolumeWeightedProportion)
+
+	volumes := make([]sdk.Dec, len(params.VolumeWeightedPools))
+	totalVolume := sdk.ZeroDec()
+	for i, poolId := range params.VolumeWeightedPools {
+		volumes[i] = k.GetPoolVolume(ctx, poolId)
+		totalVolume = totalVolume.Add(volumes[i])
+	}
+	if !totalVolume.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	maxPoolAmount := budget.Mul(params.MaxPoolVolumeShare)
+	allocated := sdk.ZeroInt()
+	for i, poolId := range params.VolumeWeightedPools {
+		allocatingAmount := sdk.MinDec(budget.Mul(volumes[i]).Quo(totalVolume), maxPoolAmount).TruncateInt()
+		if !allocatingAmount.IsPositive() {
+			continue
+		}
+
+		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, k.GetLongestLockableDuration(ctx, poolId))
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("no gauge to allocate volume weighted incentives of pool %d", poolId))
+			continue
+		}
+		gauge, err := k.incentivesKeeper.GetGaugeByID(ctx, gaugeId)
+		if err != nil || k.incentivesKeeper.IsFinishedGauge(ctx, *gauge) {
+			k.Logger(ctx).Info(fmt.Sprintf("gauge %d of pool %d can't receive volume weighted incentives", gaugeId, poolId))
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, allocatingAmount))
+		err = k.incentivesKeeper.AddToGaugeRewards(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), coins, gaugeId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		allocated = allocated.Add(allocatingAmount)
+	}
+
+	return allocated, nil
+}
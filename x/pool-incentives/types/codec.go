@@ -14,5 +14,6 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&UpdatePoolIncentivesProposal{},
+		&SetPoolLockableDurationsProposal{},
 	)
 }
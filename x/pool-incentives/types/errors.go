@@ -13,4 +13,6 @@ var (
 
 	ErrEmptyProposalRecords  = sdkerrors.Register(ModuleName, 10, "records are empty")
 	ErrEmptyProposalGaugeIds = sdkerrors.Register(ModuleName, 11, "gauge ids are empty")
+
+	ErrInvalidEpochDuration = sdkerrors.Register(ModuleName, 20, "epoch duration must be positive")
 )
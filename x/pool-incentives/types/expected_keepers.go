@@ -7,10 +7,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	epochstypes "github.com/osmosis-labs/osmosis/v10/x/epochs/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v10/x/incentives/types"
 	types "github.com/osmosis-labs/osmosis/v10/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v10/x/mint/types"
 )
 
 type AccountKeeper interface {
@@ -27,13 +29,16 @@ type BankKeeper interface {
 }
 
 type GAMMKeeper interface {
-	GetPool(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
 }
 
 type IncentivesKeeper interface {
 	CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error)
 	GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*incentivestypes.Gauge, error)
 	GetGauges(ctx sdk.Context) []types.Gauge
+	GetActiveGauges(ctx sdk.Context) []types.Gauge
+	GetEpochInfo(ctx sdk.Context) epochstypes.EpochInfo
+	IsFinishedGauge(ctx sdk.Context, gauge types.Gauge) bool
 
 	AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error
 }
@@ -42,3 +47,20 @@ type DistrKeeper interface {
 	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
 	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
 }
+
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) (params minttypes.Params)
+	GetMinter(ctx sdk.Context) (minter minttypes.Minter)
+}
+
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
+}
+
+type LockupKeeper interface {
+	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) sdk.Int
+}
+
+type TxFeesKeeper interface {
+	ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error)
+}
@@ -57,9 +57,39 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("distrinfo weight should not be negative")
 	}
 
+	poolIds := make(map[uint64]bool, len(data.PoolLockableDurations))
+	for _, poolLockableDurations := range data.PoolLockableDurations {
+		if poolIds[poolLockableDurations.PoolId] {
+			return fmt.Errorf("duplicate lockable durations for pool %d", poolLockableDurations.PoolId)
+		}
+		poolIds[poolLockableDurations.PoolId] = true
+		if len(poolLockableDurations.LockableDurations) == 0 {
+			return fmt.Errorf("empty lockable durations for pool %d", poolLockableDurations.PoolId)
+		}
+		if err := ValidatePoolLockableDurations(poolLockableDurations.LockableDurations); err != nil {
+			return err
+		}
+	}
+
 	return validateLockableDurations(data.LockableDurations)
 }
 
+// ValidatePoolLockableDurations validates the lockable durations of a pool, which must be positive and distinct.
+func ValidatePoolLockableDurations(lockableDurations []time.Duration) error {
+	seen := make(map[time.Duration]bool, len(lockableDurations))
+	for _, duration := range lockableDurations {
+		if duration <= 0 {
+			return fmt.Errorf("lockable duration must be positive: %s", duration)
+		}
+		if seen[duration] {
+			return fmt.Errorf("duplicate lockable duration %s", duration)
+		}
+		seen[duration] = true
+	}
+
+	return nil
+}
+
 func validateLockableDurations(i interface{}) error {
 	_, ok := i.([]time.Duration)
 	if !ok {
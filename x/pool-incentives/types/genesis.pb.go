@@ -33,6 +33,8 @@ type GenesisState struct {
 	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	LockableDurations []time.Duration `protobuf:"bytes,2,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 	DistrInfo         *DistrInfo      `protobuf:"bytes,3,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info,omitempty" yaml:"distr_info"`
+	// pool_lockable_durations are the pools with their own lockable durations.
+	PoolLockableDurations []PoolLockableDurations `protobuf:"bytes,4,rep,name=pool_lockable_durations,json=poolLockableDurations,proto3" json:"pool_lockable_durations" yaml:"pool_lockable_durations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +91,13 @@ func (m *GenesisState) GetDistrInfo() *DistrInfo {
 	return nil
 }
 
+func (m *GenesisState) GetPoolLockableDurations() []PoolLockableDurations {
+	if m != nil {
+		return m.PoolLockableDurations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolincentives.v1beta1.GenesisState")
 }
@@ -98,29 +107,32 @@ func init() {
 }
 
 var fileDescriptor_cc1f078212600632 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xbb, 0xf0, 0x0b, 0xc9, 0xaf, 0x78, 0xa1, 0xf1, 0x00, 0x1c, 0xb6, 0xa4, 0x89, 0x06,
-	0x0f, 0xec, 0x02, 0xde, 0xf4, 0x46, 0x48, 0x8c, 0x37, 0x83, 0xf1, 0xe2, 0x85, 0x6c, 0xcb, 0x52,
-	0x37, 0xb6, 0x9d, 0xa6, 0xbb, 0x10, 0x79, 0x0b, 0x8f, 0x3e, 0x83, 0x4f, 0xc2, 0x91, 0xa3, 0xa7,
-	0x6a, 0xe8, 0x1b, 0xf0, 0x04, 0xa6, 0xff, 0xc4, 0x84, 0x44, 0x6e, 0x9d, 0xcc, 0xe7, 0x3b, 0xf3,
-	0x99, 0xae, 0xde, 0x03, 0xe9, 0x83, 0x14, 0x92, 0x86, 0x00, 0x5e, 0x4f, 0x04, 0x0e, 0x0f, 0x94,
-	0x58, 0x72, 0x49, 0x97, 0x03, 0x9b, 0x2b, 0x36, 0xa0, 0x2e, 0x0f, 0xb8, 0x14, 0x92, 0x84, 0x11,
-	0x28, 0x30, 0x70, 0x81, 0x93, 0x14, 0xdf, 0xd3, 0xa4, 0xa0, 0xdb, 0xa7, 0x2e, 0xb8, 0x90, 0xa1,
-	0x34, 0xfd, 0xca, 0x53, 0x6d, 0xec, 0x02, 0xb8, 0x1e, 0xa7, 0x59, 0x65, 0x2f, 0xe6, 0x74, 0xb6,
-	0x88, 0x98, 0x12, 0x10, 0x14, 0xfd, 0xfe, 0x31, 0x89, 0x5f, 0x9b, 0xb2, 0x84, 0xf5, 0x5e, 0xd1,
-	0x4f, 0x6e, 0x72, 0xb3, 0x7b, 0xc5, 0x14, 0x37, 0xc6, 0x7a, 0x2d, 0x64, 0x11, 0xf3, 0x65, 0x13,
-	0x75, 0x50, 0xb7, 0x3e, 0x3c, 0x27, 0x7f, 0x9b, 0x92, 0xbb, 0x8c, 0x1e, 0xfd, 0x5b, 0xc7, 0xa6,
-	0x36, 0x29, 0xb2, 0x06, 0xe8, 0x86, 0x07, 0xce, 0x33, 0xb3, 0x3d, 0x3e, 0x2d, 0x1d, 0x65, 0xb3,
-	0xd2, 0xa9, 0x76, 0xeb, 0xc3, 0x16, 0xc9, 0xaf, 0x20, 0xe5, 0x15, 0x64, 0x5c, 0x10, 0xa3, 0xb3,
-	0x74, 0xc8, 0x2e, 0x36, 0x5b, 0x2b, 0xe6, 0x7b, 0x57, 0xd6, 0xe1, 0x08, 0xeb, 0xed, 0xd3, 0x44,
-	0x93, 0x46, 0xd9, 0x28, 0x83, 0xd2, 0x70, 0x74, 0x7d, 0x26, 0xa4, 0x8a, 0xa6, 0x22, 0x98, 0x43,
-	0xb3, 0x9a, 0xa9, 0x5f, 0x1c, 0x53, 0x1f, 0xa7, 0x89, 0xdb, 0x60, 0x0e, 0xa3, 0xd6, 0x3a, 0x36,
-	0xd1, 0x2e, 0x36, 0x1b, 0xf9, 0xe2, 0xfd, 0x28, 0x6b, 0xf2, 0x7f, 0xf6, 0x43, 0x3d, 0xac, 0xb7,
-	0x18, 0x6d, 0xb6, 0x18, 0x7d, 0x6d, 0x31, 0x7a, 0x4d, 0xb0, 0xb6, 0x49, 0xb0, 0xf6, 0x91, 0x60,
-	0xed, 0xf1, 0xda, 0x15, 0xea, 0x69, 0x61, 0x13, 0x07, 0x7c, 0x5a, 0x2c, 0xed, 0x79, 0xcc, 0x96,
-	0x65, 0x41, 0x97, 0x83, 0x3e, 0x7d, 0x39, 0x78, 0x16, 0xb5, 0x0a, 0xb9, 0xb4, 0x6b, 0xd9, 0x8f,
-	0xb8, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0x02, 0xfa, 0x34, 0x43, 0x02, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8b, 0xda, 0x40,
+	0x18, 0xc6, 0x33, 0x55, 0x84, 0xc6, 0x5e, 0x0c, 0x2d, 0x55, 0x0f, 0x13, 0x09, 0x54, 0xec, 0xc1,
+	0x19, 0xb5, 0xf4, 0xd2, 0xde, 0x82, 0x50, 0x0a, 0x3d, 0x94, 0x94, 0x5e, 0x7a, 0x91, 0x49, 0x1c,
+	0xd3, 0xa1, 0x49, 0xde, 0x90, 0x19, 0xa5, 0x7e, 0x8b, 0x5e, 0x0a, 0xfb, 0x79, 0xf6, 0xe4, 0xd1,
+	0xe3, 0x9e, 0xb2, 0x8b, 0x7e, 0x03, 0x3f, 0xc1, 0x92, 0x7f, 0xb8, 0xac, 0xee, 0x7a, 0xcb, 0x30,
+	0xbf, 0xe7, 0x7d, 0x7f, 0x4f, 0x12, 0x7d, 0x08, 0x32, 0x04, 0x29, 0x24, 0x8d, 0x01, 0x82, 0xa1,
+	0x88, 0x3c, 0x1e, 0x29, 0xb1, 0xe2, 0x92, 0xae, 0xc6, 0x2e, 0x57, 0x6c, 0x4c, 0x7d, 0x1e, 0x71,
+	0x29, 0x24, 0x89, 0x13, 0x50, 0x60, 0xe0, 0x12, 0x27, 0x19, 0x7e, 0xa4, 0x49, 0x49, 0x77, 0x5f,
+	0xfb, 0xe0, 0x43, 0x8e, 0xd2, 0xec, 0xa9, 0x48, 0x75, 0xb1, 0x0f, 0xe0, 0x07, 0x9c, 0xe6, 0x27,
+	0x77, 0xb9, 0xa0, 0xf3, 0x65, 0xc2, 0x94, 0x80, 0xa8, 0xbc, 0x1f, 0x5d, 0x92, 0x78, 0xb0, 0x29,
+	0x4f, 0x58, 0xd7, 0x35, 0xfd, 0xd5, 0x97, 0xc2, 0xec, 0x87, 0x62, 0x8a, 0x1b, 0x53, 0xbd, 0x11,
+	0xb3, 0x84, 0x85, 0xb2, 0x8d, 0x7a, 0x68, 0xd0, 0x9c, 0xf4, 0xc9, 0xf3, 0xa6, 0xe4, 0x7b, 0x4e,
+	0xdb, 0xf5, 0x4d, 0x6a, 0x6a, 0x4e, 0x99, 0x35, 0x40, 0x37, 0x02, 0xf0, 0xfe, 0x30, 0x37, 0xe0,
+	0xb3, 0xca, 0x51, 0xb6, 0x5f, 0xf4, 0x6a, 0x83, 0xe6, 0xa4, 0x43, 0x8a, 0x16, 0xa4, 0x6a, 0x41,
+	0xa6, 0x25, 0x61, 0xbf, 0xcb, 0x86, 0x1c, 0x52, 0xb3, 0xb3, 0x66, 0x61, 0xf0, 0xc9, 0x3a, 0x1d,
+	0x61, 0x5d, 0xdd, 0x9a, 0xc8, 0x69, 0x55, 0x17, 0x55, 0x50, 0x1a, 0x9e, 0xae, 0xcf, 0x85, 0x54,
+	0xc9, 0x4c, 0x44, 0x0b, 0x68, 0xd7, 0x72, 0xf5, 0xf7, 0x97, 0xd4, 0xa7, 0x59, 0xe2, 0x6b, 0xb4,
+	0x00, 0xbb, 0xb3, 0x49, 0x4d, 0x74, 0x48, 0xcd, 0x56, 0xb1, 0xf8, 0x38, 0xca, 0x72, 0x5e, 0xce,
+	0x2b, 0xca, 0xf8, 0x8f, 0xf4, 0xb7, 0xd9, 0xa8, 0xd9, 0x99, 0x6e, 0xf5, 0xbc, 0xdb, 0xc7, 0x8b,
+	0x6f, 0x0b, 0x20, 0xf8, 0xf6, 0xd8, 0xde, 0xee, 0x97, 0xbd, 0x71, 0xb1, 0xfe, 0x89, 0x1d, 0x96,
+	0xf3, 0x26, 0x3e, 0x1b, 0xff, 0xb9, 0xd9, 0x61, 0xb4, 0xdd, 0x61, 0x74, 0xb7, 0xc3, 0xe8, 0xdf,
+	0x1e, 0x6b, 0xdb, 0x3d, 0xd6, 0x6e, 0xf6, 0x58, 0xfb, 0xf5, 0xd9, 0x17, 0xea, 0xf7, 0xd2, 0x25,
+	0x1e, 0x84, 0xb4, 0x34, 0x1b, 0x06, 0xcc, 0x95, 0xd5, 0x81, 0xae, 0xc6, 0x23, 0xfa, 0xf7, 0xe4,
+	0x77, 0x51, 0xeb, 0x98, 0x4b, 0xb7, 0x91, 0x7f, 0xa0, 0x0f, 0xf7, 0x03, 0x00, 0xb8, 0x0a, 0xbc,
+	0x64, 0xdb, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -143,6 +155,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolLockableDurations) > 0 {
+		for iNdEx := len(m.PoolLockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolLockableDurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.DistrInfo != nil {
 		{
 			size, err := m.DistrInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -209,6 +235,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.DistrInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PoolLockableDurations) > 0 {
+		for _, e := range m.PoolLockableDurations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -350,6 +382,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLockableDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolLockableDurations = append(m.PoolLockableDurations, PoolLockableDurations{})
+			if err := m.PoolLockableDurations[len(m.PoolLockableDurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
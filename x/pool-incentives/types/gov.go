@@ -3,13 +3,15 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
-	ProposalTypeUpdatePoolIncentives  = "UpdatePoolIncentives"
-	ProposalTypeReplacePoolIncentives = "ReplacePoolIncentives"
+	ProposalTypeUpdatePoolIncentives     = "UpdatePoolIncentives"
+	ProposalTypeReplacePoolIncentives    = "ReplacePoolIncentives"
+	ProposalTypeSetPoolLockableDurations = "SetPoolLockableDurations"
 )
 
 func init() {
@@ -17,11 +19,14 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&UpdatePoolIncentivesProposal{}, "osmosis/UpdatePoolIncentivesProposal")
 	govtypes.RegisterProposalType(ProposalTypeReplacePoolIncentives)
 	govtypes.RegisterProposalTypeCodec(&ReplacePoolIncentivesProposal{}, "osmosis/ReplacePoolIncentivesProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetPoolLockableDurations)
+	govtypes.RegisterProposalTypeCodec(&SetPoolLockableDurationsProposal{}, "osmosis/SetPoolLockableDurationsProposal")
 }
 
 var (
 	_ govtypes.Content = &UpdatePoolIncentivesProposal{}
 	_ govtypes.Content = &ReplacePoolIncentivesProposal{}
+	_ govtypes.Content = &SetPoolLockableDurationsProposal{}
 )
 
 func NewReplacePoolIncentivesProposal(title, description string, records []DistrRecord) govtypes.Content {
@@ -125,3 +130,45 @@ func (p UpdatePoolIncentivesProposal) String() string {
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetPoolLockableDurationsProposal(title, description string, poolId uint64, lockableDurations []time.Duration) govtypes.Content {
+	return &SetPoolLockableDurationsProposal{
+		Title:             title,
+		Description:       description,
+		PoolId:            poolId,
+		LockableDurations: lockableDurations,
+	}
+}
+
+func (p *SetPoolLockableDurationsProposal) GetTitle() string { return p.Title }
+
+func (p *SetPoolLockableDurationsProposal) GetDescription() string { return p.Description }
+
+func (p *SetPoolLockableDurationsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPoolLockableDurationsProposal) ProposalType() string {
+	return ProposalTypeSetPoolLockableDurations
+}
+
+func (p *SetPoolLockableDurationsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id cannot be 0")
+	}
+
+	return ValidatePoolLockableDurations(p.LockableDurations)
+}
+
+func (p SetPoolLockableDurationsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Lockable Durations Proposal:
+  Title:              %s
+  Description:        %s
+  PoolId:             %d
+  LockableDurations:  %v
+`, p.Title, p.Description, p.PoolId, p.LockableDurations))
+	return b.String()
+}
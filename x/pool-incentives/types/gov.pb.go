@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,9 +117,56 @@ func (m *UpdatePoolIncentivesProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdatePoolIncentivesProposal proto.InternalMessageInfo
 
+// SetPoolLockableDurationsProposal is a gov Content type for setting the
+// lockable durations of a pool. If a SetPoolLockableDurationsProposal passes,
+// the pool only has gauges for the proposal's lockable durations instead of
+// the module's lockable durations, and gauges are created for the durations
+// the pool doesn't have a gauge for yet, so they must be incentives lockable
+// durations. An empty list of lockable durations resets the pool to the
+// module's lockable durations.
+type SetPoolLockableDurationsProposal struct {
+	Title             string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId            uint64          `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LockableDurations []time.Duration `protobuf:"bytes,4,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
+}
+
+func (m *SetPoolLockableDurationsProposal) Reset()      { *m = SetPoolLockableDurationsProposal{} }
+func (*SetPoolLockableDurationsProposal) ProtoMessage() {}
+func (*SetPoolLockableDurationsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96caede426ba9516, []int{2}
+}
+func (m *SetPoolLockableDurationsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolLockableDurationsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolLockableDurationsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolLockableDurationsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolLockableDurationsProposal.Merge(m, src)
+}
+func (m *SetPoolLockableDurationsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolLockableDurationsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolLockableDurationsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolLockableDurationsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReplacePoolIncentivesProposal)(nil), "osmosis.poolincentives.v1beta1.ReplacePoolIncentivesProposal")
 	proto.RegisterType((*UpdatePoolIncentivesProposal)(nil), "osmosis.poolincentives.v1beta1.UpdatePoolIncentivesProposal")
+	proto.RegisterType((*SetPoolLockableDurationsProposal)(nil), "osmosis.poolincentives.v1beta1.SetPoolLockableDurationsProposal")
 }
 
 func init() {
@@ -123,26 +174,34 @@ func init() {
 }
 
 var fileDescriptor_96caede426ba9516 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0xcd, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9,
-	0x2c, 0x4b, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x83, 0x2a, 0xd5, 0x03, 0x29, 0x45, 0xa8, 0xd4, 0x83,
-	0xaa, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd5, 0x07, 0xb1, 0x20, 0xba, 0xa4, 0x0c,
-	0x08, 0x59, 0x80, 0x64, 0x12, 0x58, 0x87, 0xd2, 0x26, 0x46, 0x2e, 0xd9, 0xa0, 0xd4, 0x82, 0x9c,
-	0xc4, 0xe4, 0xd4, 0x80, 0xfc, 0xfc, 0x1c, 0x4f, 0xb8, 0x7c, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71,
-	0x62, 0x8e, 0x90, 0x08, 0x17, 0x6b, 0x49, 0x66, 0x49, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x67, 0x10, 0x84, 0x23, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92,
-	0x99, 0x9f, 0x27, 0xc1, 0x04, 0x96, 0x43, 0x16, 0x12, 0xf2, 0xe6, 0x62, 0x2f, 0x4a, 0x4d, 0xce,
-	0x2f, 0x4a, 0x29, 0x96, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd6, 0xc3, 0xef, 0x27, 0x3d,
-	0x97, 0xcc, 0xe2, 0x92, 0xa2, 0x20, 0xb0, 0x1e, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0x60,
-	0x26, 0x58, 0xf1, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x46,
-	0xa5, 0x8d, 0x8c, 0x5c, 0x32, 0xa1, 0x05, 0x29, 0x89, 0x25, 0x43, 0xc7, 0xcd, 0x4e, 0xa1, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9d, 0x9e, 0x59, 0x92, 0x51, 0x9a,
-	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb5, 0x4d, 0x37, 0x27, 0x31, 0xa9, 0x18, 0xc6, 0xd1, 0x2f,
-	0x33, 0x34, 0xd0, 0xaf, 0xc0, 0x88, 0xd2, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x34,
-	0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x07, 0xb6, 0xec, 0x5b, 0x02, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0x4d, 0x68, 0xc5, 0x15, 0x21, 0x61, 0x75, 0x70, 0x2b, 0x38, 0x5b, 0x96, 0x90,
+	0x82, 0xaa, 0xde, 0x35, 0xb0, 0x95, 0xcd, 0xea, 0x52, 0xc1, 0x50, 0x19, 0x75, 0x61, 0x89, 0xce,
+	0xf6, 0x61, 0x4e, 0x5c, 0xf2, 0x5a, 0xbe, 0x8b, 0x45, 0xbe, 0x00, 0x62, 0x64, 0xcc, 0x98, 0xaf,
+	0x00, 0x9f, 0x22, 0x63, 0x46, 0xa6, 0x80, 0x92, 0x85, 0x39, 0x9f, 0x00, 0xf9, 0x1f, 0x04, 0x22,
+	0xc1, 0xc0, 0xc4, 0x76, 0xaf, 0xdf, 0xe7, 0x7d, 0xef, 0xf7, 0x9c, 0x1f, 0xfc, 0x08, 0xf4, 0x10,
+	0xb4, 0xd4, 0x2c, 0x03, 0x50, 0x67, 0x72, 0x14, 0x8b, 0x91, 0x91, 0x85, 0xd0, 0xac, 0xe8, 0x47,
+	0xc2, 0xf0, 0x3e, 0x4b, 0xa1, 0xa0, 0x59, 0x0e, 0x06, 0x6c, 0xd2, 0x48, 0x69, 0x29, 0xfd, 0xa9,
+	0xa4, 0x8d, 0xf2, 0xe4, 0x28, 0x85, 0x14, 0x2a, 0x29, 0x2b, 0x4f, 0xf5, 0xd4, 0x09, 0x49, 0x01,
+	0x52, 0x25, 0x58, 0x55, 0x45, 0xe3, 0x57, 0x2c, 0x19, 0xe7, 0xdc, 0x48, 0x18, 0x35, 0xfd, 0xf3,
+	0xbf, 0x01, 0x6c, 0xdd, 0x54, 0x4d, 0xf8, 0x9f, 0x10, 0x7e, 0x10, 0x8a, 0x4c, 0xf1, 0x58, 0x5c,
+	0x03, 0xa8, 0xab, 0x1f, 0xfd, 0xeb, 0x1c, 0x32, 0xd0, 0x5c, 0xd9, 0x47, 0xf8, 0x96, 0x91, 0x46,
+	0x09, 0x07, 0x79, 0xa8, 0x77, 0x3b, 0xac, 0x0b, 0xdb, 0xc3, 0x87, 0x89, 0xd0, 0x71, 0x2e, 0xb3,
+	0xf2, 0x7a, 0x67, 0xaf, 0xea, 0x6d, 0x7f, 0xb2, 0x9f, 0xe1, 0x83, 0x5c, 0xc4, 0x90, 0x27, 0xda,
+	0xe9, 0x78, 0x9d, 0xde, 0xe1, 0xe3, 0x53, 0xfa, 0x67, 0xcf, 0xf4, 0x52, 0x6a, 0x93, 0x87, 0xd5,
+	0x4c, 0xd0, 0x9d, 0x2f, 0x5d, 0x2b, 0x6c, 0x37, 0x5c, 0xdc, 0x79, 0x3f, 0x73, 0xad, 0xe9, 0xcc,
+	0xb5, 0xbe, 0xcd, 0x5c, 0xe4, 0x7f, 0x44, 0xf8, 0xfe, 0x4d, 0x96, 0x70, 0xf3, 0x1f, 0x31, 0xbf,
+	0xdb, 0xc3, 0xde, 0x0b, 0x61, 0x4a, 0xe0, 0xe7, 0x10, 0xbf, 0xe1, 0x91, 0x12, 0x97, 0xcd, 0xcf,
+	0xfb, 0x77, 0xee, 0x53, 0x7c, 0x50, 0xf2, 0x0d, 0x64, 0xe2, 0x74, 0x3c, 0xd4, 0xeb, 0x06, 0xf6,
+	0x66, 0xe9, 0xde, 0x9d, 0xf0, 0xa1, 0xba, 0xf0, 0x9b, 0x86, 0x1f, 0xee, 0x97, 0xa7, 0xab, 0xc4,
+	0x06, 0x6c, 0xab, 0x86, 0x60, 0xd0, 0xe6, 0x47, 0x3b, 0xdd, 0xca, 0xef, 0x31, 0xad, 0x13, 0x46,
+	0xdb, 0x84, 0xd1, 0x16, 0x32, 0x78, 0x58, 0xba, 0xdb, 0x2c, 0xdd, 0xe3, 0x7a, 0xed, 0xee, 0x0a,
+	0x7f, 0xfa, 0xc5, 0x45, 0xe1, 0x3d, 0xf5, 0xbb, 0xbb, 0x5f, 0x1f, 0x22, 0xb8, 0x99, 0xaf, 0x08,
+	0x5a, 0xac, 0x08, 0xfa, 0xba, 0x22, 0xe8, 0xc3, 0x9a, 0x58, 0x8b, 0x35, 0xb1, 0x3e, 0xaf, 0x89,
+	0xf5, 0xf2, 0x69, 0x2a, 0xcd, 0xeb, 0x71, 0x44, 0x63, 0x18, 0xb2, 0xe6, 0xd9, 0xcf, 0x14, 0x8f,
+	0x74, 0x5b, 0xb0, 0xa2, 0x7f, 0xce, 0xde, 0xee, 0x64, 0xdb, 0x4c, 0x32, 0xa1, 0xa3, 0xfd, 0x8a,
+	0xf8, 0xc9, 0xf7, 0x01, 0x00, 0x57, 0x66, 0x18, 0x0a, 0x84, 0x03, 0x00, 0x00,
 }
 
 func (this *ReplacePoolIncentivesProposal) Equal(that interface{}) bool {
@@ -215,6 +274,44 @@ func (this *UpdatePoolIncentivesProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetPoolLockableDurationsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolLockableDurationsProposal)
+	if !ok {
+		that2, ok := that.(SetPoolLockableDurationsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if len(this.LockableDurations) != len(that1.LockableDurations) {
+		return false
+	}
+	for i := range this.LockableDurations {
+		if this.LockableDurations[i] != that1.LockableDurations[i] {
+			return false
+		}
+	}
+	return true
+}
 func (m *ReplacePoolIncentivesProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -317,6 +414,60 @@ func (m *UpdatePoolIncentivesProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *SetPoolLockableDurationsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolLockableDurationsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolLockableDurationsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGov(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -374,6 +525,32 @@ func (m *UpdatePoolIncentivesProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetPoolLockableDurationsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -676,6 +853,173 @@ func (m *UpdatePoolIncentivesProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetPoolLockableDurationsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolLockableDurationsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolLockableDurationsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
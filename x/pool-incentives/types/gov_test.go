@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
@@ -9,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestUpdatePoolIncentivesProposalMarshalUnmarshal(t *testing.T) {
@@ -87,3 +89,44 @@ func TestUpdatePoolIncentivesProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func TestSetPoolLockableDurationsProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		proposal  govtypes.Content
+		expectErr bool
+	}{
+		{
+			name:     "valid lockable durations",
+			proposal: types.NewSetPoolLockableDurationsProposal("title", "description", 1, []time.Duration{time.Hour, 24 * time.Hour}),
+		},
+		{
+			name:     "empty lockable durations reset the pool",
+			proposal: types.NewSetPoolLockableDurationsProposal("title", "description", 1, nil),
+		},
+		{
+			name:      "zero pool id",
+			proposal:  types.NewSetPoolLockableDurationsProposal("title", "description", 0, []time.Duration{time.Hour}),
+			expectErr: true,
+		},
+		{
+			name:      "non positive lockable duration",
+			proposal:  types.NewSetPoolLockableDurationsProposal("title", "description", 1, []time.Duration{0}),
+			expectErr: true,
+		},
+		{
+			name:      "duplicate lockable duration",
+			proposal:  types.NewSetPoolLockableDurationsProposal("title", "description", 1, []time.Duration{time.Hour, time.Hour}),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := test.proposal.ValidateBasic()
+		if test.expectErr {
+			require.Error(t, err, test.name)
+		} else {
+			require.NoError(t, err, test.name)
+		}
+	}
+}
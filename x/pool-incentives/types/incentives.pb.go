@@ -34,6 +34,18 @@ type Params struct {
 	// itself, but rather manages the distribution of coins that matches the
 	// defined minted_denom.
 	MintedDenom string `protobuf:"bytes,1,opt,name=minted_denom,json=mintedDenom,proto3" json:"minted_denom,omitempty" yaml:"minted_denom"`
+	// volume_weighted_proportion is the proportion of the pool incentives
+	// allocated to the volume weighted pools in proportion to their swap volume
+	// since the previous allocation, rather than by the DistrRecords. Zero
+	// disables volume weighted incentives.
+	VolumeWeightedProportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=volume_weighted_proportion,json=volumeWeightedProportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume_weighted_proportion" yaml:"volume_weighted_proportion"`
+	// volume_weighted_pools are the ids of the pools eligible to volume weighted
+	// incentives.
+	VolumeWeightedPools []uint64 `protobuf:"varint,3,rep,packed,name=volume_weighted_pools,json=volumeWeightedPools,proto3" json:"volume_weighted_pools,omitempty" yaml:"volume_weighted_pools"`
+	// max_pool_volume_share is the maximum proportion of the volume weighted
+	// incentives a single pool receives. Incentives above the cap are allocated
+	// by the DistrRecords.
+	MaxPoolVolumeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_pool_volume_share,json=maxPoolVolumeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_pool_volume_share" yaml:"max_pool_volume_share"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +87,13 @@ func (m *Params) GetMintedDenom() string {
 	return ""
 }
 
+func (m *Params) GetVolumeWeightedPools() []uint64 {
+	if m != nil {
+		return m.VolumeWeightedPools
+	}
+	return nil
+}
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -119,6 +138,60 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 	return nil
 }
 
+// PoolLockableDurations are the lockable durations of a pool, overriding the
+// module's lockable durations.
+type PoolLockableDurations struct {
+	PoolId            uint64          `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LockableDurations []time.Duration `protobuf:"bytes,2,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
+}
+
+func (m *PoolLockableDurations) Reset()         { *m = PoolLockableDurations{} }
+func (m *PoolLockableDurations) String() string { return proto.CompactTextString(m) }
+func (*PoolLockableDurations) ProtoMessage()    {}
+func (*PoolLockableDurations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8153bad03e553d1, []int{2}
+}
+func (m *PoolLockableDurations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolLockableDurations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolLockableDurations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolLockableDurations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolLockableDurations.Merge(m, src)
+}
+func (m *PoolLockableDurations) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolLockableDurations) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolLockableDurations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolLockableDurations proto.InternalMessageInfo
+
+func (m *PoolLockableDurations) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolLockableDurations) GetLockableDurations() []time.Duration {
+	if m != nil {
+		return m.LockableDurations
+	}
+	return nil
+}
+
 type DistrInfo struct {
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
 	Records     []DistrRecord                          `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
@@ -128,7 +201,7 @@ func (m *DistrInfo) Reset()         { *m = DistrInfo{} }
 func (m *DistrInfo) String() string { return proto.CompactTextString(m) }
 func (*DistrInfo) ProtoMessage()    {}
 func (*DistrInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{2}
+	return fileDescriptor_a8153bad03e553d1, []int{3}
 }
 func (m *DistrInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +246,7 @@ func (m *DistrRecord) Reset()         { *m = DistrRecord{} }
 func (m *DistrRecord) String() string { return proto.CompactTextString(m) }
 func (*DistrRecord) ProtoMessage()    {}
 func (*DistrRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{3}
+	return fileDescriptor_a8153bad03e553d1, []int{4}
 }
 func (m *DistrRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,6 +285,7 @@ func (m *DistrRecord) GetGaugeId() uint64 {
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolincentives.v1beta1.Params")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.poolincentives.v1beta1.LockableDurationsInfo")
+	proto.RegisterType((*PoolLockableDurations)(nil), "osmosis.poolincentives.v1beta1.PoolLockableDurations")
 	proto.RegisterType((*DistrInfo)(nil), "osmosis.poolincentives.v1beta1.DistrInfo")
 	proto.RegisterType((*DistrRecord)(nil), "osmosis.poolincentives.v1beta1.DistrRecord")
 }
@@ -221,37 +295,46 @@ func init() {
 }
 
 var fileDescriptor_a8153bad03e553d1 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6b, 0xd4, 0x40,
-	0x18, 0xcd, 0xd4, 0x65, 0x6b, 0x67, 0x0b, 0x62, 0xaa, 0xb8, 0xed, 0x21, 0x29, 0x01, 0xa5, 0x20,
-	0x3b, 0xd3, 0xd5, 0xdb, 0x7a, 0x0b, 0xab, 0xb0, 0xea, 0x41, 0x02, 0x22, 0x78, 0x59, 0x26, 0xc9,
-	0x34, 0x3b, 0x34, 0xc9, 0xb7, 0x64, 0x66, 0x57, 0xfb, 0x0f, 0x04, 0x2f, 0x1e, 0x7b, 0xec, 0x6f,
-	0xf1, 0xd4, 0x63, 0x8f, 0xe2, 0x21, 0xca, 0xee, 0xc5, 0xf3, 0xfe, 0x02, 0xc9, 0x64, 0x42, 0x83,
-	0x05, 0xa1, 0xa7, 0xcc, 0xcb, 0xcb, 0x7b, 0xdf, 0x7b, 0xf3, 0x05, 0x1f, 0x83, 0xcc, 0x40, 0x0a,
-	0x49, 0xe7, 0x00, 0xe9, 0x40, 0xe4, 0x11, 0xcf, 0x95, 0x58, 0x72, 0x49, 0x97, 0xc3, 0x90, 0x2b,
-	0x36, 0xa4, 0xd7, 0xaf, 0xc8, 0xbc, 0x00, 0x05, 0xb6, 0x63, 0x14, 0xa4, 0x52, 0xb4, 0x58, 0x23,
-	0x38, 0x78, 0x90, 0x40, 0x02, 0xfa, 0x53, 0x5a, 0x9d, 0x6a, 0xd5, 0x81, 0x93, 0x00, 0x24, 0x29,
-	0xa7, 0x1a, 0x85, 0x8b, 0x13, 0x1a, 0x2f, 0x0a, 0xa6, 0x04, 0xe4, 0x35, 0xef, 0xbd, 0xc6, 0xdd,
-	0x77, 0xac, 0x60, 0x99, 0xb4, 0x47, 0x78, 0x37, 0x13, 0xb9, 0xe2, 0xf1, 0x34, 0xe6, 0x39, 0x64,
-	0x7d, 0x74, 0x88, 0x8e, 0x76, 0xfc, 0x47, 0x9b, 0xd2, 0xdd, 0x3b, 0x63, 0x59, 0x3a, 0xf2, 0xda,
-	0xac, 0x17, 0xf4, 0x6a, 0x38, 0xae, 0xd0, 0xa8, 0x73, 0x7e, 0xe1, 0x5a, 0xde, 0x17, 0x84, 0x1f,
-	0xbe, 0x85, 0xe8, 0x94, 0x85, 0x29, 0x1f, 0x9b, 0x31, 0x72, 0x92, 0x9f, 0x80, 0x0d, 0xd8, 0x4e,
-	0x0d, 0x31, 0x6d, 0x02, 0xc8, 0x3e, 0x3a, 0xbc, 0x73, 0xd4, 0x7b, 0xb6, 0x4f, 0xea, 0x88, 0xa4,
-	0x89, 0x48, 0x1a, 0xad, 0xff, 0xf8, 0xb2, 0x74, 0xad, 0x4d, 0xe9, 0xee, 0xd7, 0x01, 0x6e, 0x5a,
-	0x78, 0xe7, 0xbf, 0x5c, 0x14, 0xdc, 0x4f, 0xff, 0x1d, 0xea, 0x7d, 0x47, 0x78, 0x67, 0x2c, 0xa4,
-	0x2a, 0xf4, 0xf8, 0x19, 0xde, 0x55, 0xa0, 0x58, 0x3a, 0xfd, 0xc4, 0x45, 0x32, 0x53, 0xa6, 0xda,
-	0xcb, 0xca, 0xfd, 0x67, 0xe9, 0x3e, 0x49, 0x84, 0x9a, 0x2d, 0x42, 0x12, 0x41, 0x46, 0x23, 0x7d,
-	0xc9, 0xe6, 0x31, 0x90, 0xf1, 0x29, 0x55, 0x67, 0x73, 0x2e, 0xc9, 0x24, 0x57, 0xd7, 0x17, 0xd1,
-	0xf6, 0xf2, 0x82, 0x9e, 0x86, 0x1f, 0x34, 0xb2, 0xdf, 0xe0, 0xed, 0x82, 0x47, 0x50, 0xc4, 0xb2,
-	0xbf, 0xa5, 0xdb, 0x3d, 0x25, 0xff, 0x5f, 0x1b, 0xd1, 0x29, 0x03, 0xad, 0xf1, 0x3b, 0x55, 0xa2,
-	0xa0, 0x71, 0xf0, 0xbe, 0x22, 0xdc, 0x6b, 0xd1, 0x36, 0xc1, 0x77, 0x13, 0xb6, 0x48, 0xf8, 0x54,
-	0xc4, 0xba, 0x42, 0xc7, 0xdf, 0xdb, 0x94, 0xee, 0xbd, 0x3a, 0x54, 0xc3, 0x78, 0xc1, 0xb6, 0x3e,
-	0x4e, 0x62, 0xfb, 0x15, 0xee, 0x9a, 0xc2, 0x5b, 0xba, 0x30, 0xb9, 0x5d, 0xe1, 0xc0, 0xa8, 0x47,
-	0x9d, 0x3f, 0x17, 0x2e, 0xf2, 0xdf, 0x5f, 0xae, 0x1c, 0x74, 0xb5, 0x72, 0xd0, 0xef, 0x95, 0x83,
-	0xbe, 0xad, 0x1d, 0xeb, 0x6a, 0xed, 0x58, 0x3f, 0xd6, 0x8e, 0xf5, 0xf1, 0x45, 0xcb, 0xcf, 0xb4,
-	0x1d, 0xa4, 0x2c, 0x94, 0x0d, 0xa0, 0xcb, 0xe1, 0x31, 0xfd, 0x7c, 0xe3, 0x4f, 0xd7, 0x83, 0xc2,
-	0xae, 0x5e, 0xfb, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x78, 0x74, 0x71, 0x11, 0x03,
-	0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0x35, 0x51, 0x4a, 0x2f, 0x15, 0x88, 0x2b, 0x11, 0x6e, 0x85, 0xec, 0x70, 0x12, 0xa8,
+	0x52, 0x55, 0xbb, 0x85, 0x2d, 0x6c, 0x56, 0x40, 0x8a, 0x40, 0xa8, 0x72, 0xf9, 0x21, 0xb1, 0x44,
+	0x67, 0xfb, 0xea, 0x58, 0xb5, 0x7d, 0x91, 0xcf, 0x09, 0xed, 0xc8, 0x86, 0xc4, 0x02, 0x5b, 0xc7,
+	0xfe, 0x13, 0x48, 0xcc, 0x4c, 0x1d, 0x3b, 0x22, 0x06, 0x83, 0xda, 0x85, 0xd9, 0x7f, 0x01, 0xba,
+	0xf3, 0x99, 0x46, 0x0d, 0x45, 0xca, 0xc0, 0x94, 0xfb, 0xee, 0xdd, 0x7b, 0xdf, 0x7b, 0xbe, 0xef,
+	0x02, 0xb7, 0x18, 0x8f, 0x19, 0x0f, 0xb9, 0x35, 0x62, 0x2c, 0xda, 0x0c, 0x13, 0x8f, 0x26, 0x59,
+	0x38, 0xa1, 0xdc, 0x9a, 0x6c, 0xbb, 0x34, 0x23, 0xdb, 0xd6, 0xc5, 0x96, 0x39, 0x4a, 0x59, 0xc6,
+	0x90, 0xae, 0x18, 0xa6, 0x60, 0x4c, 0xa1, 0x8a, 0xb0, 0x76, 0x2b, 0x60, 0x01, 0x93, 0x47, 0x2d,
+	0xb1, 0x2a, 0x59, 0x6b, 0x7a, 0xc0, 0x58, 0x10, 0x51, 0x4b, 0x56, 0xee, 0x78, 0xcf, 0xf2, 0xc7,
+	0x29, 0xc9, 0x42, 0x96, 0x94, 0x38, 0xfe, 0x52, 0x87, 0xcd, 0x1d, 0x92, 0x92, 0x98, 0xa3, 0x2e,
+	0x5c, 0x8e, 0xc3, 0x24, 0xa3, 0xfe, 0xc0, 0xa7, 0x09, 0x8b, 0x35, 0xd0, 0x01, 0xeb, 0x4b, 0xf6,
+	0xed, 0x22, 0x37, 0x56, 0x0e, 0x49, 0x1c, 0x75, 0xf1, 0x34, 0x8a, 0x9d, 0x56, 0x59, 0xf6, 0x44,
+	0x85, 0x3e, 0x01, 0xb8, 0x36, 0x61, 0xd1, 0x38, 0xa6, 0x83, 0xb7, 0x34, 0x0c, 0x86, 0xe2, 0xdc,
+	0x28, 0x65, 0x23, 0x96, 0x8a, 0x5e, 0xda, 0x82, 0x94, 0xda, 0x3d, 0xc9, 0x8d, 0xda, 0xf7, 0xdc,
+	0xb8, 0x1f, 0x84, 0xd9, 0x70, 0xec, 0x9a, 0x1e, 0x8b, 0x2d, 0x4f, 0xa6, 0x52, 0x3f, 0x9b, 0xdc,
+	0xdf, 0xb7, 0xb2, 0xc3, 0x11, 0xe5, 0x66, 0x8f, 0x7a, 0x45, 0x6e, 0xdc, 0x2d, 0x1b, 0x5f, 0xad,
+	0x8c, 0x1d, 0xad, 0x04, 0x5f, 0x2b, 0x6c, 0xe7, 0x0f, 0x84, 0x5e, 0xc0, 0xf6, 0x0c, 0x91, 0xb1,
+	0x88, 0x6b, 0xf5, 0x4e, 0x7d, 0xbd, 0x61, 0x77, 0x8a, 0xdc, 0xb8, 0x73, 0x85, 0xbe, 0x38, 0x86,
+	0x9d, 0x95, 0x4b, 0xd2, 0x62, 0x17, 0xbd, 0x03, 0xb0, 0x1d, 0x93, 0x03, 0x79, 0x66, 0xa0, 0x88,
+	0x7c, 0x48, 0x52, 0xaa, 0x35, 0x64, 0xc8, 0xe7, 0x73, 0x87, 0x54, 0x26, 0xfe, 0x2a, 0x8a, 0x1d,
+	0x14, 0x93, 0x03, 0xd1, 0xf9, 0x95, 0xdc, 0xdd, 0x15, 0x9b, 0xdd, 0xc6, 0xd1, 0xb1, 0x51, 0xc3,
+	0xef, 0x01, 0x6c, 0x3f, 0x63, 0xde, 0x3e, 0x71, 0x23, 0xda, 0x53, 0xb7, 0xca, 0xfb, 0xc9, 0x1e,
+	0x43, 0x0c, 0xa2, 0x48, 0x01, 0x83, 0xea, 0xbe, 0xb9, 0x06, 0x3a, 0xf5, 0xf5, 0xd6, 0x83, 0x55,
+	0xb3, 0x9c, 0x08, 0xb3, 0x9a, 0x08, 0xb3, 0xe2, 0xda, 0xf7, 0x84, 0xf5, 0x22, 0x37, 0x56, 0x4b,
+	0x43, 0xb3, 0x12, 0xf8, 0xe8, 0x87, 0x01, 0x9c, 0x9b, 0xd1, 0xe5, 0xa6, 0xf8, 0x33, 0x80, 0x6d,
+	0x61, 0x72, 0xc6, 0x0e, 0xda, 0x80, 0x8b, 0x32, 0x54, 0xe8, 0xcb, 0x79, 0x6a, 0xd8, 0xa8, 0xc8,
+	0x8d, 0xeb, 0x65, 0x03, 0x05, 0x60, 0xa7, 0x29, 0x56, 0x7d, 0xff, 0x0a, 0xdf, 0x0b, 0xff, 0xcf,
+	0xf7, 0x57, 0x00, 0x97, 0x7a, 0x21, 0xcf, 0x52, 0xf9, 0xd9, 0x86, 0x70, 0x39, 0x63, 0x19, 0x89,
+	0xd4, 0x20, 0xa8, 0x07, 0xf0, 0x78, 0x8e, 0x0b, 0xed, 0x27, 0xd9, 0xc5, 0x73, 0x99, 0xd6, 0xc2,
+	0x4e, 0x4b, 0x96, 0xe5, 0x2c, 0xa1, 0xa7, 0x70, 0x31, 0xa5, 0x1e, 0x4b, 0xfd, 0x2a, 0xdd, 0x86,
+	0xf9, 0xef, 0xd7, 0x6d, 0x4a, 0x97, 0x8e, 0xe4, 0xd8, 0x0d, 0xe1, 0xc8, 0xa9, 0x14, 0xf0, 0x07,
+	0x00, 0x5b, 0x53, 0x30, 0x32, 0xe1, 0xb5, 0x80, 0x8c, 0x03, 0x7a, 0xf1, 0xcd, 0x57, 0x8a, 0xdc,
+	0xb8, 0x51, 0x9a, 0xaa, 0x10, 0xec, 0x2c, 0xca, 0x65, 0xdf, 0x47, 0x4f, 0x60, 0x53, 0x05, 0x2e,
+	0x9f, 0xa9, 0x39, 0x5f, 0x60, 0x47, 0xb1, 0xbb, 0x8d, 0x5f, 0xc7, 0x06, 0xb0, 0x5f, 0x9e, 0x9c,
+	0xe9, 0xe0, 0xf4, 0x4c, 0x07, 0x3f, 0xcf, 0x74, 0xf0, 0xf1, 0x5c, 0xaf, 0x9d, 0x9e, 0xeb, 0xb5,
+	0x6f, 0xe7, 0x7a, 0xed, 0xcd, 0xa3, 0x29, 0x3d, 0x95, 0x76, 0x33, 0x22, 0x2e, 0xaf, 0x0a, 0x6b,
+	0xb2, 0xbd, 0x65, 0x1d, 0xcc, 0xfc, 0x21, 0xca, 0x46, 0x6e, 0x53, 0x5e, 0xfb, 0xc3, 0xdf, 0x03,
+	0x00, 0x60, 0x1c, 0x1b, 0x4a, 0x38, 0x05, 0x00, 0x00,
 }
 
 func (this *DistrRecord) Equal(that interface{}) bool {
@@ -301,6 +384,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPoolVolumeShare.Size()
+		i -= size
+		if _, err := m.MaxPoolVolumeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VolumeWeightedPools) > 0 {
+		dAtA2 := make([]byte, len(m.VolumeWeightedPools)*10)
+		var j1 int
+		for _, num := range m.VolumeWeightedPools {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintIncentives(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.VolumeWeightedProportion.Size()
+		i -= size
+		if _, err := m.VolumeWeightedProportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.MintedDenom) > 0 {
 		i -= len(m.MintedDenom)
 		copy(dAtA[i:], m.MintedDenom)
@@ -346,6 +467,46 @@ func (m *LockableDurationsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolLockableDurations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolLockableDurations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolLockableDurations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintIncentives(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DistrInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -452,6 +613,17 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovIncentives(uint64(l))
 	}
+	l = m.VolumeWeightedProportion.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if len(m.VolumeWeightedPools) > 0 {
+		l = 0
+		for _, e := range m.VolumeWeightedPools {
+			l += sovIncentives(uint64(e))
+		}
+		n += 1 + sovIncentives(uint64(l)) + l
+	}
+	l = m.MaxPoolVolumeShare.Size()
+	n += 1 + l + sovIncentives(uint64(l))
 	return n
 }
 
@@ -470,6 +642,24 @@ func (m *LockableDurationsInfo) Size() (n int) {
 	return n
 }
 
+func (m *PoolLockableDurations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovIncentives(uint64(m.PoolId))
+	}
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DistrInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -568,6 +758,150 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MintedDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWeightedProportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeWeightedProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIncentives
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VolumeWeightedPools = append(m.VolumeWeightedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIncentives
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthIncentives
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIncentives
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VolumeWeightedPools) == 0 {
+					m.VolumeWeightedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIncentives
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VolumeWeightedPools = append(m.VolumeWeightedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWeightedPools", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolVolumeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPoolVolumeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -673,6 +1007,109 @@ func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolLockableDurations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolLockableDurations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolLockableDurations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DistrInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,9 @@ const (
 var (
 	LockableDurationsKey = []byte("lockable_durations")
 	DistrInfoKey         = []byte("distr_info")
+	PoolVolumePrefix     = []byte("pool_volume/")
+
+	PoolLockableDurationsPrefix = []byte("pool_lockable_durations/")
 )
 
 func GetPoolGaugeIdStoreKey(poolId uint64, duration time.Duration) []byte {
@@ -27,3 +30,13 @@ func GetPoolGaugeIdStoreKey(poolId uint64, duration time.Duration) []byte {
 func GetPoolIdFromGaugeIdStoreKey(gaugeId uint64, duration time.Duration) []byte {
 	return []byte(fmt.Sprintf("pool-incentives-pool-id/%d/%s", gaugeId, duration.String()))
 }
+
+// GetPoolVolumeStoreKey returns the key of the swap volume of a pool since the previous allocation.
+func GetPoolVolumeStoreKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PoolVolumePrefix, poolId))
+}
+
+// GetPoolLockableDurationsStoreKey returns the key of the lockable durations of a pool.
+func GetPoolLockableDurationsStoreKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PoolLockableDurationsPrefix, poolId))
+}
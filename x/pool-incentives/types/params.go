@@ -12,30 +12,58 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-var KeyMintedDenom = []byte("MintedDenom")
+var (
+	KeyMintedDenom              = []byte("MintedDenom")
+	KeyVolumeWeightedProportion = []byte("VolumeWeightedProportion")
+	KeyVolumeWeightedPools      = []byte("VolumeWeightedPools")
+	KeyMaxPoolVolumeShare       = []byte("MaxPoolVolumeShare")
+)
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintedDenom string) Params {
+func NewParams(mintedDenom string, volumeWeightedProportion sdk.Dec, volumeWeightedPools []uint64, maxPoolVolumeShare sdk.Dec) Params {
 	return Params{
-		MintedDenom: mintedDenom,
+		MintedDenom:              mintedDenom,
+		VolumeWeightedProportion: volumeWeightedProportion,
+		VolumeWeightedPools:      volumeWeightedPools,
+		MaxPoolVolumeShare:       maxPoolVolumeShare,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the pool-incentives module.
+// Volume weighted incentives are disabled by default.
 func DefaultParams() Params {
-	return NewParams(sdk.DefaultBondDenom)
+	return NewParams(sdk.DefaultBondDenom, sdk.ZeroDec(), nil, sdk.OneDec())
 }
 
 func (p Params) Validate() error {
 	if err := validateMintedDenom(p.MintedDenom); err != nil {
 		return err
 	}
+	if err := validateVolumeWeightedProportion(p.VolumeWeightedProportion); err != nil {
+		return err
+	}
+	if err := validateVolumeWeightedPools(p.VolumeWeightedPools); err != nil {
+		return err
+	}
+	if err := validateMaxPoolVolumeShare(p.MaxPoolVolumeShare); err != nil {
+		return err
+	}
 	return nil
 }
 
+// IsVolumeWeightedPool returns true if the pool is eligible to volume weighted incentives.
+func (p Params) IsVolumeWeightedPool(poolId uint64) bool {
+	for _, id := range p.VolumeWeightedPools {
+		if id == poolId {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -58,8 +86,64 @@ func validateMintedDenom(i interface{}) error {
 	return nil
 }
 
+func validateVolumeWeightedProportion(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero proportion disables volume weighted incentives
+	if v.IsNil() {
+		return errors.New("volume weighted proportion must not be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("volume weighted proportion must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateVolumeWeightedPools(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]bool, len(v))
+	for _, poolId := range v {
+		if poolId == 0 {
+			return errors.New("volume weighted pool id cannot be 0")
+		}
+		if seen[poolId] {
+			return fmt.Errorf("duplicate volume weighted pool id %d", poolId)
+		}
+		seen[poolId] = true
+	}
+
+	return nil
+}
+
+func validateMaxPoolVolumeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("max pool volume share must not be nil")
+	}
+	if !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max pool volume share must be greater than 0 and at most 1: %s", v)
+	}
+
+	return nil
+}
+
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintedDenom, &p.MintedDenom, validateMintedDenom),
+		paramtypes.NewParamSetPair(KeyVolumeWeightedProportion, &p.VolumeWeightedProportion, validateVolumeWeightedProportion),
+		paramtypes.NewParamSetPair(KeyVolumeWeightedPools, &p.VolumeWeightedPools, validateVolumeWeightedPools),
+		paramtypes.NewParamSetPair(KeyMaxPoolVolumeShare, &p.MaxPoolVolumeShare, validateMaxPoolVolumeShare),
 	}
 }
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v10/x/pool-incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(*types.Params)
+		expectPass bool
+	}{
+		{
+			name:       "default params",
+			modify:     func(p *types.Params) {},
+			expectPass: true,
+		},
+		{
+			name:       "volume weighted incentives enabled",
+			modify:     func(p *types.Params) { p.VolumeWeightedProportion = sdk.NewDecWithPrec(5, 1) },
+			expectPass: true,
+		},
+		{
+			name:       "nil volume weighted proportion",
+			modify:     func(p *types.Params) { p.VolumeWeightedProportion = sdk.Dec{} },
+			expectPass: false,
+		},
+		{
+			name:       "volume weighted proportion above one",
+			modify:     func(p *types.Params) { p.VolumeWeightedProportion = sdk.NewDec(2) },
+			expectPass: false,
+		},
+		{
+			name:       "nil max pool volume share",
+			modify:     func(p *types.Params) { p.MaxPoolVolumeShare = sdk.Dec{} },
+			expectPass: false,
+		},
+		{
+			name:       "zero max pool volume share",
+			modify:     func(p *types.Params) { p.MaxPoolVolumeShare = sdk.ZeroDec() },
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
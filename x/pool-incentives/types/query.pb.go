@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -638,6 +639,100 @@ func (m *QueryExternalIncentiveGaugesResponse) GetData() []types.Gauge {
 	return nil
 }
 
+type QueryPoolAPRRequest struct {
+	PoolId           uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LockableDuration time.Duration `protobuf:"bytes,2,opt,name=lockable_duration,json=lockableDuration,proto3,stdduration" json:"lockable_duration" yaml:"lockable_duration"`
+}
+
+func (m *QueryPoolAPRRequest) Reset()         { *m = QueryPoolAPRRequest{} }
+func (m *QueryPoolAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRRequest) ProtoMessage()    {}
+func (*QueryPoolAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{13}
+}
+func (m *QueryPoolAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRRequest.Merge(m, src)
+}
+func (m *QueryPoolAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolAPRRequest proto.InternalMessageInfo
+
+func (m *QueryPoolAPRRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryPoolAPRRequest) GetLockableDuration() time.Duration {
+	if m != nil {
+		return m.LockableDuration
+	}
+	return 0
+}
+
+type QueryPoolAPRResponse struct {
+	// APR from the minted denom distributed to the pool's gauges
+	InternalApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=internal_apr,json=internalApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"internal_apr" yaml:"internal_apr"`
+	// APR from the active external gauges rewarding the pool's locks
+	ExternalApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=external_apr,json=externalApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"external_apr" yaml:"external_apr"`
+	// sum of the internal and external APR
+	TotalApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=total_apr,json=totalApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_apr" yaml:"total_apr"`
+}
+
+func (m *QueryPoolAPRResponse) Reset()         { *m = QueryPoolAPRResponse{} }
+func (m *QueryPoolAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRResponse) ProtoMessage()    {}
+func (*QueryPoolAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{14}
+}
+func (m *QueryPoolAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRResponse.Merge(m, src)
+}
+func (m *QueryPoolAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolAPRResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryGaugeIdsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsRequest")
 	proto.RegisterType((*QueryGaugeIdsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsResponse")
@@ -653,6 +748,8 @@ func init() {
 	proto.RegisterType((*QueryIncentivizedPoolsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsResponse")
 	proto.RegisterType((*QueryExternalIncentiveGaugesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesRequest")
 	proto.RegisterType((*QueryExternalIncentiveGaugesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesResponse")
+	proto.RegisterType((*QueryPoolAPRRequest)(nil), "osmosis.poolincentives.v1beta1.QueryPoolAPRRequest")
+	proto.RegisterType((*QueryPoolAPRResponse)(nil), "osmosis.poolincentives.v1beta1.QueryPoolAPRResponse")
 }
 
 func init() {
@@ -660,63 +757,72 @@ func init() {
 }
 
 var fileDescriptor_302873ecccbc7636 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x74, 0x4b, 0x36, 0x9d, 0x4a, 0xb0, 0x99, 0x06, 0xda, 0x5a, 0xe0, 0x94, 0x61, 0x17,
-	0xba, 0xaa, 0x62, 0xb7, 0xc9, 0xee, 0x1e, 0x76, 0x17, 0x90, 0xb2, 0x41, 0x28, 0x12, 0x87, 0xc5,
-	0x12, 0x42, 0x82, 0x83, 0xe5, 0xc4, 0xae, 0x3b, 0xc2, 0xf1, 0x64, 0x33, 0x4e, 0xd9, 0x82, 0xf6,
-	0xb2, 0x07, 0xce, 0x20, 0x2e, 0x9c, 0x11, 0x7b, 0xe6, 0xc4, 0x6f, 0x60, 0x6f, 0xac, 0xc4, 0x85,
-	0x0b, 0x01, 0xb5, 0x1c, 0x38, 0xe7, 0x17, 0x20, 0x8f, 0x9f, 0xdd, 0xc4, 0x6e, 0xe2, 0xa4, 0xdc,
-	0x12, 0xbf, 0xf7, 0xbe, 0xf7, 0x7d, 0xef, 0x3d, 0x7f, 0xc6, 0x7b, 0x5c, 0xf4, 0xb8, 0x60, 0x42,
-	0xef, 0x73, 0xee, 0xd5, 0x98, 0xdf, 0x75, 0xfc, 0x80, 0x1d, 0x3b, 0x42, 0x3f, 0x3e, 0xe8, 0x38,
-	0x81, 0x75, 0xa0, 0x3f, 0x1a, 0x3a, 0x83, 0x13, 0xad, 0x3f, 0xe0, 0x01, 0x27, 0x2a, 0x24, 0x6b,
-	0x61, 0xf2, 0x79, 0xae, 0x06, 0xb9, 0x4a, 0xc5, 0xe5, 0x2e, 0x97, 0xa9, 0x7a, 0xf8, 0x2b, 0xaa,
-	0x52, 0x5e, 0x77, 0x39, 0x77, 0x3d, 0x47, 0xb7, 0xfa, 0x4c, 0xb7, 0x7c, 0x9f, 0x07, 0x56, 0xc0,
-	0xb8, 0x2f, 0x20, 0xaa, 0x42, 0x54, 0xfe, 0xeb, 0x0c, 0x0f, 0x75, 0x7b, 0x38, 0x90, 0x09, 0x71,
-	0x3c, 0x26, 0x38, 0xc1, 0xcd, 0xb5, 0x86, 0xae, 0x03, 0xf1, 0xfd, 0x3c, 0x01, 0x13, 0x3c, 0x65,
-	0x05, 0x7d, 0x80, 0x2b, 0x1f, 0x87, 0xa2, 0x3e, 0x0c, 0x51, 0xda, 0xb6, 0x30, 0x9c, 0x47, 0x43,
-	0x47, 0x04, 0x64, 0x0f, 0x5f, 0x0d, 0x31, 0x4c, 0x66, 0x6f, 0xa1, 0x1d, 0xb4, 0xbb, 0xda, 0x24,
-	0xe3, 0x51, 0xf5, 0xe5, 0x13, 0xab, 0xe7, 0xdd, 0xa5, 0x10, 0xa0, 0x46, 0x31, 0xfc, 0xd5, 0xb6,
-	0xe9, 0xaf, 0x2b, 0xf8, 0xd5, 0x14, 0x8a, 0xe8, 0x73, 0x5f, 0x38, 0xe4, 0x27, 0x84, 0x37, 0x25,
-	0x41, 0x93, 0xd9, 0xc2, 0xfc, 0x92, 0x05, 0x47, 0x66, 0x2c, 0x69, 0x0b, 0xed, 0x5c, 0xd9, 0x5d,
-	0xaf, 0xb7, 0xb5, 0xf9, 0x73, 0xd4, 0x2e, 0x04, 0xd6, 0xe0, 0xc1, 0xa7, 0x2c, 0x38, 0x6a, 0x01,
-	0x60, 0x93, 0x8e, 0x47, 0x55, 0x35, 0xa2, 0x38, 0xa3, 0x27, 0x35, 0x2a, 0x2e, 0x20, 0x4d, 0x56,
-	0x2a, 0xdf, 0x20, 0xbc, 0x71, 0x01, 0x22, 0xd1, 0x70, 0x29, 0x46, 0x82, 0x31, 0x6c, 0x8c, 0x47,
-	0xd5, 0x57, 0xa6, 0x7b, 0x50, 0xe3, 0x2a, 0x80, 0x92, 0xf7, 0x71, 0x29, 0x91, 0xb7, 0xb2, 0x83,
-	0x76, 0xd7, 0xeb, 0xdb, 0x5a, 0xb4, 0x52, 0x2d, 0x5e, 0xa9, 0x96, 0xd0, 0x2d, 0x3d, 0x1f, 0x55,
-	0x0b, 0x3f, 0xfc, 0x55, 0x45, 0x46, 0x52, 0x44, 0x37, 0x61, 0x90, 0x2d, 0x26, 0x82, 0x41, 0xdb,
-	0x3f, 0xe4, 0xb0, 0x0f, 0xfa, 0x04, 0xbf, 0x96, 0x0e, 0xc0, 0x88, 0xbb, 0x18, 0xdb, 0xe1, 0x43,
-	0x93, 0xf9, 0x87, 0x5c, 0xb2, 0x5c, 0xaf, 0xdf, 0xcc, 0x1b, 0x6a, 0x02, 0xd3, 0xdc, 0x0e, 0x59,
-	0x8c, 0x47, 0xd5, 0x72, 0x24, 0xea, 0x1c, 0x8a, 0x1a, 0x6b, 0x76, 0x9c, 0x45, 0x2b, 0x98, 0xc8,
-	0xf6, 0x0f, 0xad, 0x81, 0xd5, 0x8b, 0x8f, 0x84, 0x7e, 0x8e, 0x37, 0xa6, 0x9e, 0x02, 0xa3, 0x16,
-	0x2e, 0xf6, 0xe5, 0x13, 0x60, 0xf3, 0x76, 0x1e, 0x9b, 0xa8, 0xbe, 0xb9, 0x1a, 0x52, 0x31, 0xa0,
-	0x96, 0x56, 0xf1, 0x1b, 0x12, 0xfc, 0x23, 0xde, 0xfd, 0xc2, 0xea, 0x78, 0x4e, 0x3c, 0xb7, 0xa4,
-	0xfb, 0x77, 0x08, 0xab, 0xb3, 0x32, 0x80, 0x09, 0xc7, 0xc4, 0x83, 0x60, 0x72, 0x03, 0x02, 0x0e,
-	0x6f, 0xce, 0x66, 0x6e, 0xc0, 0x4c, 0xb6, 0xa3, 0x99, 0x64, 0x21, 0xa8, 0x5c, 0x5b, 0xd9, 0x4b,
-	0x37, 0x4e, 0x48, 0xb7, 0x41, 0x24, 0xfb, 0xca, 0xb1, 0x1f, 0x72, 0xee, 0x25, 0xa4, 0xff, 0x44,
-	0xf8, 0x5a, 0x3a, 0xb8, 0xd4, 0xcb, 0x46, 0x3c, 0x5c, 0xce, 0x10, 0xca, 0x3f, 0xb6, 0xeb, 0x20,
-	0x69, 0x6b, 0x86, 0xa4, 0x48, 0xd1, 0xb5, 0xb4, 0xa2, 0xa9, 0x37, 0xe0, 0x4a, 0xfe, 0x1b, 0x40,
-	0x9f, 0xc5, 0x4b, 0xb9, 0x60, 0x02, 0xb0, 0x94, 0xa7, 0x08, 0x13, 0x36, 0x11, 0x35, 0x43, 0x61,
-	0xf1, 0x56, 0xf6, 0xf3, 0x6e, 0x25, 0x8d, 0xdb, 0x7c, 0x73, 0x7a, 0x59, 0x59, 0x64, 0x6a, 0x94,
-	0x59, 0x9a, 0x0c, 0xbd, 0x81, 0xdf, 0x92, 0x34, 0x3f, 0x78, 0x1c, 0x38, 0x03, 0xdf, 0xf2, 0x62,
-	0x58, 0x47, 0xda, 0xc0, 0xc4, 0x85, 0x5f, 0x9f, 0x9f, 0x06, 0x9a, 0x1a, 0x78, 0xd5, 0xb6, 0x02,
-	0x2b, 0x39, 0xad, 0x58, 0xc4, 0x84, 0x00, 0x59, 0x01, 0x37, 0x2e, 0x93, 0xeb, 0xcf, 0xd6, 0xf0,
-	0x4b, 0x12, 0x9d, 0xfc, 0x82, 0x70, 0x29, 0xb6, 0x38, 0x72, 0x6b, 0x49, 0x47, 0x94, 0x4c, 0x95,
-	0xdb, 0x97, 0xf2, 0x51, 0x7a, 0xff, 0xe9, 0xef, 0xff, 0x7c, 0xbf, 0x72, 0x87, 0xdc, 0xd2, 0xf3,
-	0x3e, 0x1d, 0x72, 0xc3, 0x35, 0x66, 0x0b, 0xfd, 0x6b, 0xb8, 0xc9, 0x27, 0xe4, 0x67, 0x84, 0xd7,
-	0x12, 0x2b, 0x21, 0x8b, 0x51, 0x48, 0x5b, 0x9b, 0x72, 0x67, 0xd9, 0x32, 0xa0, 0xde, 0x90, 0xd4,
-	0x6b, 0x64, 0x2f, 0x97, 0xfa, 0xb9, 0xa9, 0x91, 0x1f, 0x11, 0x2e, 0x46, 0x76, 0x43, 0xea, 0x0b,
-	0xf5, 0x9d, 0x72, 0x3c, 0xa5, 0xb1, 0x54, 0x0d, 0x10, 0xd5, 0x25, 0xd1, 0x9b, 0xe4, 0x9d, 0x5c,
-	0xa2, 0x91, 0xf5, 0x91, 0xdf, 0x10, 0x2e, 0x67, 0x4c, 0x8d, 0xbc, 0xbb, 0x50, 0xef, 0x59, 0x76,
-	0xa9, 0xbc, 0x77, 0xd9, 0x72, 0x50, 0x71, 0x4f, 0xaa, 0xb8, 0x4d, 0x1a, 0xb9, 0x2a, 0xb2, 0x7e,
-	0x29, 0x15, 0x65, 0x1c, 0x61, 0x41, 0x45, 0xb3, 0xbc, 0x74, 0x41, 0x45, 0x33, 0x8d, 0x68, 0x09,
-	0x45, 0x59, 0x53, 0x21, 0xff, 0x22, 0xbc, 0x39, 0xc3, 0x15, 0xc8, 0x83, 0x85, 0x88, 0xcd, 0xb7,
-	0x1e, 0xa5, 0xf5, 0xff, 0x40, 0x40, 0x63, 0x53, 0x6a, 0xbc, 0x4f, 0xee, 0xe6, 0x6a, 0x74, 0x00,
-	0xc9, 0x4c, 0x62, 0xa6, 0x7c, 0xe5, 0x45, 0xf3, 0x93, 0xe7, 0xa7, 0x2a, 0x7a, 0x71, 0xaa, 0xa2,
-	0xbf, 0x4f, 0x55, 0xf4, 0xed, 0x99, 0x5a, 0x78, 0x71, 0xa6, 0x16, 0xfe, 0x38, 0x53, 0x0b, 0x9f,
-	0xdd, 0x73, 0x59, 0x70, 0x34, 0xec, 0x68, 0x5d, 0xde, 0x8b, 0xf1, 0x6b, 0x9e, 0xd5, 0x11, 0x49,
-	0xb3, 0xe3, 0x83, 0x7d, 0xfd, 0x71, 0xa6, 0x65, 0x70, 0xd2, 0x77, 0x44, 0xa7, 0x28, 0xbf, 0x52,
-	0x8d, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x06, 0x22, 0xf9, 0x9b, 0x76, 0x0b, 0x00, 0x00,
+	// 1040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xee, 0xa4, 0xa5, 0x4d, 0x27, 0x08, 0x9a, 0x69, 0xa0, 0xad, 0x05, 0x4e, 0x19, 0x76, 0x97,
+	0xae, 0xaa, 0xd8, 0x6d, 0xd2, 0xdd, 0xc3, 0xee, 0xb2, 0xa8, 0xd9, 0xac, 0x50, 0x24, 0x0e, 0xc5,
+	0x12, 0x42, 0x82, 0x83, 0xe5, 0xc4, 0xae, 0x6b, 0xad, 0xeb, 0xf1, 0xda, 0x4e, 0x69, 0x41, 0x7b,
+	0xd9, 0x03, 0x67, 0x10, 0x17, 0xce, 0x08, 0xce, 0xc0, 0x81, 0xdf, 0xc0, 0x4a, 0x1c, 0x58, 0x89,
+	0x0b, 0x20, 0x11, 0x50, 0xcb, 0x81, 0x73, 0x7e, 0x01, 0xf2, 0xf8, 0xd9, 0x71, 0x92, 0xa6, 0x4e,
+	0xba, 0xd2, 0x9e, 0xea, 0xcc, 0x7b, 0xef, 0x7b, 0xdf, 0xf7, 0xde, 0x9b, 0x79, 0xc5, 0x9b, 0xcc,
+	0x3f, 0x64, 0xbe, 0xe5, 0xcb, 0x2e, 0x63, 0x76, 0xc5, 0x72, 0xda, 0x86, 0x13, 0x58, 0x47, 0x86,
+	0x2f, 0x1f, 0x6d, 0xb7, 0x8c, 0x40, 0xdb, 0x96, 0x1f, 0x76, 0x0c, 0xef, 0x44, 0x72, 0x3d, 0x16,
+	0x30, 0x22, 0x82, 0xb3, 0x14, 0x3a, 0xf7, 0x7d, 0x25, 0xf0, 0x15, 0x4a, 0x26, 0x33, 0x19, 0x77,
+	0x95, 0xc3, 0xaf, 0x28, 0x4a, 0x78, 0xcd, 0x64, 0xcc, 0xb4, 0x0d, 0x59, 0x73, 0x2d, 0x59, 0x73,
+	0x1c, 0x16, 0x68, 0x81, 0xc5, 0x1c, 0x1f, 0xac, 0x22, 0x58, 0xf9, 0xaf, 0x56, 0x67, 0x5f, 0xd6,
+	0x3b, 0x1e, 0x77, 0x88, 0xed, 0x31, 0xc1, 0x14, 0x37, 0x53, 0xeb, 0x98, 0x06, 0xd8, 0xb7, 0xb2,
+	0x04, 0xa4, 0x78, 0xf2, 0x08, 0x7a, 0x0f, 0x97, 0xde, 0x0f, 0x45, 0xbd, 0x1b, 0xa2, 0x34, 0x75,
+	0x5f, 0x31, 0x1e, 0x76, 0x0c, 0x3f, 0x20, 0x9b, 0x78, 0x21, 0xc4, 0x50, 0x2d, 0x7d, 0x15, 0xad,
+	0xa3, 0x8d, 0xb9, 0x3a, 0xe9, 0x75, 0xcb, 0x2f, 0x9d, 0x68, 0x87, 0xf6, 0x2d, 0x0a, 0x06, 0xaa,
+	0xcc, 0x87, 0x5f, 0x4d, 0x9d, 0xfe, 0x9c, 0xc3, 0xaf, 0x0c, 0xa1, 0xf8, 0x2e, 0x73, 0x7c, 0x83,
+	0x7c, 0x8b, 0xf0, 0x0a, 0x27, 0xa8, 0x5a, 0xba, 0xaf, 0x7e, 0x62, 0x05, 0x07, 0x6a, 0x2c, 0x69,
+	0x15, 0xad, 0xcf, 0x6e, 0x14, 0xaa, 0x4d, 0xe9, 0xe2, 0x3a, 0x4a, 0xe7, 0x02, 0x4b, 0x70, 0xf0,
+	0xa1, 0x15, 0x1c, 0x34, 0x00, 0xb0, 0x4e, 0x7b, 0xdd, 0xb2, 0x18, 0x51, 0x1c, 0x93, 0x93, 0x2a,
+	0x25, 0x13, 0x90, 0xd2, 0x91, 0xc2, 0xe7, 0x08, 0x2f, 0x9f, 0x83, 0x48, 0x24, 0x9c, 0x8f, 0x91,
+	0xa0, 0x0c, 0xcb, 0xbd, 0x6e, 0xf9, 0xe5, 0xc1, 0x1c, 0x54, 0x59, 0x00, 0x50, 0xf2, 0x0e, 0xce,
+	0x27, 0xf2, 0x72, 0xeb, 0x68, 0xa3, 0x50, 0x5d, 0x93, 0xa2, 0x96, 0x4a, 0x71, 0x4b, 0xa5, 0x84,
+	0x6e, 0xfe, 0x49, 0xb7, 0x3c, 0xf3, 0xf5, 0xdf, 0x65, 0xa4, 0x24, 0x41, 0x74, 0x05, 0x0a, 0xd9,
+	0xb0, 0xfc, 0xc0, 0x6b, 0x3a, 0xfb, 0x0c, 0xfa, 0x41, 0x1f, 0xe1, 0x57, 0x87, 0x0d, 0x50, 0xe2,
+	0x36, 0xc6, 0x7a, 0x78, 0xa8, 0x5a, 0xce, 0x3e, 0xe3, 0x2c, 0x0b, 0xd5, 0xeb, 0x59, 0x45, 0x4d,
+	0x60, 0xea, 0x6b, 0x21, 0x8b, 0x5e, 0xb7, 0x5c, 0x8c, 0x44, 0xf5, 0xa1, 0xa8, 0xb2, 0xa8, 0xc7,
+	0x5e, 0xb4, 0x84, 0x09, 0x4f, 0xbf, 0xa7, 0x79, 0xda, 0x61, 0x3c, 0x24, 0xf4, 0x63, 0xbc, 0x3c,
+	0x70, 0x0a, 0x8c, 0x1a, 0x78, 0xde, 0xe5, 0x27, 0xc0, 0xe6, 0x5a, 0x16, 0x9b, 0x28, 0xbe, 0x3e,
+	0x17, 0x52, 0x51, 0x20, 0x96, 0x96, 0xf1, 0xeb, 0x1c, 0xfc, 0x3d, 0xd6, 0x7e, 0xa0, 0xb5, 0x6c,
+	0x23, 0xae, 0x5b, 0x92, 0xfd, 0x4b, 0x84, 0xc5, 0x71, 0x1e, 0xc0, 0x84, 0x61, 0x62, 0x83, 0x31,
+	0x99, 0x01, 0x1f, 0x06, 0xef, 0x82, 0xce, 0x5c, 0x85, 0x9a, 0xac, 0x45, 0x35, 0x19, 0x85, 0xa0,
+	0xbc, 0x6d, 0x45, 0x7b, 0x38, 0x71, 0x42, 0xba, 0x09, 0x22, 0xad, 0x4f, 0x0d, 0x7d, 0x8f, 0x31,
+	0x3b, 0x21, 0xfd, 0x17, 0xc2, 0x4b, 0xc3, 0xc6, 0xa9, 0x2e, 0x1b, 0xb1, 0x71, 0x71, 0x84, 0x50,
+	0xf6, 0xb0, 0x5d, 0x01, 0x49, 0xab, 0x63, 0x24, 0x45, 0x8a, 0x96, 0x86, 0x15, 0x0d, 0xdc, 0x80,
+	0xd9, 0xec, 0x1b, 0x40, 0xbf, 0x8b, 0x9b, 0x72, 0x4e, 0x05, 0xa0, 0x29, 0x8f, 0x11, 0x26, 0x56,
+	0xca, 0xaa, 0x86, 0xc2, 0xe2, 0xae, 0x6c, 0x65, 0xcd, 0xca, 0x30, 0x6e, 0xfd, 0x8d, 0xc1, 0x66,
+	0x8d, 0x22, 0x53, 0xa5, 0x68, 0x0d, 0x93, 0xa1, 0x57, 0xf1, 0x9b, 0x9c, 0xe6, 0xfd, 0xe3, 0xc0,
+	0xf0, 0x1c, 0xcd, 0x8e, 0x61, 0x0d, 0xfe, 0x0c, 0xa4, 0x26, 0xfc, 0xca, 0xc5, 0x6e, 0xa0, 0xa9,
+	0x86, 0xe7, 0x74, 0x2d, 0xd0, 0x92, 0xd1, 0x8a, 0x45, 0xa4, 0x04, 0xf0, 0x08, 0x98, 0x71, 0xee,
+	0x4c, 0x7f, 0x40, 0xf1, 0xfd, 0x61, 0xcc, 0xde, 0xdd, 0x53, 0x2e, 0xf3, 0xf6, 0x3e, 0xdf, 0x71,
+	0xa0, 0xbf, 0xe4, 0x70, 0x69, 0x90, 0x32, 0x14, 0xe0, 0x00, 0xbf, 0x68, 0x39, 0x51, 0x8d, 0x54,
+	0xcd, 0xf5, 0x38, 0xf1, 0xc5, 0xfa, 0xfd, 0x30, 0xcd, 0x9f, 0xdd, 0xf2, 0x35, 0xd3, 0x0a, 0x0e,
+	0x3a, 0x2d, 0xa9, 0xcd, 0x0e, 0xe5, 0x36, 0xaf, 0x0d, 0xfc, 0xa9, 0xf8, 0xfa, 0x03, 0x39, 0x38,
+	0x71, 0x0d, 0x5f, 0x6a, 0x18, 0xed, 0x5e, 0xb7, 0xbc, 0x1c, 0x77, 0xb1, 0x8f, 0x45, 0x95, 0x42,
+	0xfc, 0x73, 0xd7, 0xf5, 0xc2, 0x4c, 0xc6, 0x71, 0x2a, 0x53, 0xee, 0xd9, 0x32, 0xa5, 0xb1, 0xa8,
+	0x52, 0x30, 0x8e, 0xfb, 0x99, 0x54, 0xbc, 0x18, 0xb0, 0x00, 0xd2, 0xcc, 0xf2, 0x34, 0xf5, 0xa9,
+	0xd3, 0x2c, 0x45, 0x69, 0x12, 0x20, 0xaa, 0xe4, 0xf9, 0xf7, 0xae, 0xeb, 0x55, 0xff, 0xc0, 0xf8,
+	0x05, 0x5e, 0x4d, 0xf2, 0x13, 0xc2, 0xf9, 0x78, 0xc7, 0x91, 0x9d, 0x29, 0x57, 0x22, 0x9f, 0x1a,
+	0xe1, 0xc6, 0xa5, 0x16, 0x29, 0xbd, 0xf3, 0xf8, 0xb7, 0x7f, 0xbf, 0xca, 0xdd, 0x24, 0x3b, 0x72,
+	0xd6, 0xff, 0x0e, 0xfc, 0x8a, 0x57, 0x2c, 0xdd, 0x97, 0x3f, 0x83, 0x29, 0x7c, 0x44, 0xbe, 0x47,
+	0x78, 0x31, 0xd9, 0x25, 0x64, 0x32, 0x0a, 0xc3, 0xbb, 0x4d, 0xb8, 0x39, 0x6d, 0x18, 0x50, 0xaf,
+	0x71, 0xea, 0x15, 0xb2, 0x99, 0x49, 0xbd, 0xbf, 0xd5, 0xc8, 0x37, 0x08, 0xcf, 0x47, 0xfb, 0x86,
+	0x54, 0x27, 0xca, 0x3b, 0xb0, 0xf2, 0x84, 0xda, 0x54, 0x31, 0x40, 0x54, 0xe6, 0x44, 0xaf, 0x93,
+	0xb7, 0x32, 0x89, 0x46, 0xbb, 0x8f, 0xfc, 0x8a, 0x70, 0x71, 0x64, 0xab, 0x91, 0xb7, 0x27, 0xca,
+	0x3d, 0x6e, 0x5f, 0x0a, 0x77, 0x2f, 0x1b, 0x0e, 0x2a, 0x6e, 0x73, 0x15, 0x37, 0x48, 0x2d, 0x53,
+	0xc5, 0xe8, 0xc2, 0xe4, 0x8a, 0x46, 0x56, 0xc2, 0x84, 0x8a, 0xc6, 0x2d, 0x53, 0xe1, 0xee, 0x65,
+	0xc3, 0xa7, 0x56, 0x34, 0xba, 0x55, 0xc8, 0x7f, 0x08, 0xaf, 0x8c, 0x59, 0x0b, 0xe4, 0xde, 0x44,
+	0xc4, 0x2e, 0xde, 0x3d, 0x42, 0xe3, 0xd9, 0x40, 0x40, 0x63, 0x9d, 0x6b, 0xbc, 0x43, 0x6e, 0x65,
+	0x6a, 0x4c, 0x5e, 0xc2, 0xc4, 0xa6, 0x9a, 0x91, 0x9c, 0x1f, 0x11, 0x5e, 0x80, 0x07, 0x9f, 0x4c,
+	0x78, 0x01, 0x06, 0x36, 0x9a, 0xb0, 0x33, 0x5d, 0xd0, 0xd4, 0xed, 0x09, 0xcf, 0xc3, 0xc7, 0xb5,
+	0xff, 0x32, 0xd5, 0x3f, 0x78, 0x72, 0x2a, 0xa2, 0xa7, 0xa7, 0x22, 0xfa, 0xe7, 0x54, 0x44, 0x5f,
+	0x9c, 0x89, 0x33, 0x4f, 0xcf, 0xc4, 0x99, 0xdf, 0xcf, 0xc4, 0x99, 0x8f, 0x6e, 0xa7, 0xde, 0x6e,
+	0x00, 0xae, 0xd8, 0x5a, 0xcb, 0x4f, 0xb2, 0x1c, 0x6d, 0x6f, 0xc9, 0xc7, 0x23, 0xb9, 0xf8, 0xa3,
+	0xde, 0x9a, 0xe7, 0xbb, 0xb4, 0xf6, 0xff, 0x00, 0x9e, 0x37, 0x2e, 0x6c, 0x2b, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -738,6 +844,9 @@ type QueryClient interface {
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
 	IncentivizedPools(ctx context.Context, in *QueryIncentivizedPoolsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsResponse, error)
 	ExternalIncentiveGauges(ctx context.Context, in *QueryExternalIncentiveGaugesRequest, opts ...grpc.CallOption) (*QueryExternalIncentiveGaugesResponse, error)
+	// PoolAPR returns the estimated annualized incentives APR of locking a
+	// pool's shares for a lockable duration
+	PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error)
 }
 
 type queryClient struct {
@@ -802,6 +911,15 @@ func (c *queryClient) ExternalIncentiveGauges(ctx context.Context, in *QueryExte
 	return out, nil
 }
 
+func (c *queryClient) PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error) {
+	out := new(QueryPoolAPRResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/PoolAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GaugeIds takes the pool id and returns the matching gauge ids and durations
@@ -811,6 +929,9 @@ type QueryServer interface {
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
 	IncentivizedPools(context.Context, *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error)
 	ExternalIncentiveGauges(context.Context, *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error)
+	// PoolAPR returns the estimated annualized incentives APR of locking a
+	// pool's shares for a lockable duration
+	PoolAPR(context.Context, *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -835,6 +956,9 @@ func (*UnimplementedQueryServer) IncentivizedPools(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) ExternalIncentiveGauges(ctx context.Context, req *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalIncentiveGauges not implemented")
 }
+func (*UnimplementedQueryServer) PoolAPR(ctx context.Context, req *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAPR not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -948,6 +1072,24 @@ func _Query_ExternalIncentiveGauges_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/PoolAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAPR(ctx, req.(*QueryPoolAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolincentives.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -976,6 +1118,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExternalIncentiveGauges",
 			Handler:    _Query_ExternalIncentiveGauges_Handler,
 		},
+		{
+			MethodName: "PoolAPR",
+			Handler:    _Query_PoolAPR_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/pool-incentives/v1beta1/query.proto",
@@ -1413,6 +1559,95 @@ func (m *QueryExternalIncentiveGaugesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPoolAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalApr.Size()
+		i -= size
+		if _, err := m.TotalApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExternalApr.Size()
+		i -= size
+		if _, err := m.ExternalApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InternalApr.Size()
+		i -= size
+		if _, err := m.InternalApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1594,6 +1829,35 @@ func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPoolAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InternalApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExternalApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2644,6 +2908,260 @@ func (m *QueryExternalIncentiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockableDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InternalApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,8 @@ import (
 
 // BeginBlocker is called on every block.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper) {
+	k.SampleNativeAssetPrices(ctx)
+
 	numBlocksSinceEpochStart, err := ek.NumBlocksSinceEpochStart(ctx, k.GetEpochIdentifier(ctx))
 	if err != nil {
 		panic(err)
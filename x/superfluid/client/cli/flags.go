@@ -3,4 +3,6 @@ package cli
 // Proposal flags.
 const (
 	FlagSuperfluidAssets = "superfluid-assets"
+	FlagRiskFactors      = "risk-factors"
+	FlagPricePoolIds     = "price-pool-ids"
 )
@@ -34,7 +34,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdSuperfluidDelegationAmount(),
 		GetCmdSuperfluidDelegationsByDelegator(),
 		GetCmdSuperfluidUndelegationsByDelegator(),
+		GetCmdSuperfluidRedelegationsByDelegator(),
+		GetCmdSuperfluidUnbondingsByDelegator(),
+		GetCmdSuperfluidUnbondingsByValidatorDenom(),
+		GetCmdSuperfluidLockRewards(),
+		GetCmdStaleAssets(),
 		GetCmdTotalSuperfluidDelegations(),
+		GetCmdSuperfluidDelegationHeadroom(),
 	)
 
 	return cmd
@@ -323,6 +329,198 @@ func GetCmdSuperfluidUndelegationsByDelegator() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSuperfluidUnbondingsByDelegator returns the superfluid positions unbonding for the specified delegator.
+func GetCmdSuperfluidUnbondingsByDelegator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-unbondings-by-delegator [delegator_address]",
+		Short: "Query superfluid positions unbonding for the specified delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SuperfluidUnbondingsByDelegator(cmd.Context(), &types.SuperfluidUnbondingsByDelegatorRequest{
+				DelegatorAddress: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "superfluid-unbondings-by-delegator")
+
+	return cmd
+}
+
+// GetCmdSuperfluidUnbondingsByValidatorDenom returns the superfluid positions of a denom unbonding from the specified validator.
+func GetCmdSuperfluidUnbondingsByValidatorDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-unbondings-by-validator-denom [validator_address] [denom]",
+		Short: "Query superfluid positions of a denom unbonding from the specified validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SuperfluidUnbondingsByValidatorDenom(cmd.Context(), &types.SuperfluidUnbondingsByValidatorDenomRequest{
+				ValidatorAddress: args[0],
+				Denom:            args[1],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "superfluid-unbondings-by-validator-denom")
+
+	return cmd
+}
+
+// GetCmdSuperfluidRedelegationsByDelegator returns the superfluid redelegations in progress of the specified delegator.
+func GetCmdSuperfluidRedelegationsByDelegator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-redelegation-by-delegator [delegator_address]",
+		Short: "Query superfluid redelegations in progress of the specified delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SuperfluidRedelegationsByDelegator(cmd.Context(), &types.SuperfluidRedelegationsByDelegatorRequest{
+				DelegatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSuperfluidLockRewards returns the superfluid staking rewards of the specified lock.
+func GetCmdSuperfluidLockRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-lock-rewards [lock_id]",
+		Short: "Query the superfluid staking rewards accrued by the specified lock, and the ones it got at recent epochs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SuperfluidLockRewards(cmd.Context(), &types.SuperfluidLockRewardsRequest{
+				LockId: uint64(lockId),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdStaleAssets returns the superfluid assets whose multiplier failed to update at the last epochs.
+func GetCmdStaleAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stale-assets",
+		Short: "Query the superfluid assets whose osmo equivalent multiplier failed to update at the last epochs",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StaleAssets(cmd.Context(), &types.StaleAssetsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSuperfluidDelegationHeadroom returns the osmo that can still be superfluid delegated to a validator.
+func GetCmdSuperfluidDelegationHeadroom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-delegation-headroom [validator_address]",
+		Short: "Query the osmo that can still be superfluid delegated to a validator before reaching the superfluid caps",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SuperfluidDelegationHeadroom(cmd.Context(), &types.SuperfluidDelegationHeadroomRequest{
+				ValidatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdTotalSuperfluidDelegations returns total amount of base denom delegated via superfluid staking.
 func GetCmdTotalSuperfluidDelegations() *cobra.Command {
 	cmd := &cobra.Command{
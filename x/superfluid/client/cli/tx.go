@@ -31,7 +31,9 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSetLockAutoCompoundCmd(),
+		NewSuperfluidRedelegateCmd(),
+		NewSuperfluidPartialUndelegateCmd(),
 		NewCmdSubmitSetSuperfluidAssetsProposal(),
 		NewCmdSubmitRemoveSuperfluidAssetsProposal(),
 		NewCmdLockAndSuperfluidDelegate(),
@@ -143,43 +145,119 @@ func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	return cmd
 }
 
-// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate
-// func NewSuperfluidRedelegateCmd() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "redelegate [lock_id] [val_addr] [flags]",
-// 		Short: "superfluid redelegate a lock to a new validator",
-// 		Args:  cobra.ExactArgs(2),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			clientCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
-
-// 			lockId, err := strconv.Atoi(args[0])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			valAddr, err := sdk.ValAddressFromBech32(args[1])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			msg := types.NewMsgSuperfluidRedelegate(
-// 				clientCtx.GetFromAddress(),
-// 				uint64(lockId),
-// 				valAddr,
-// 			)
-
-// 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// NewSetLockAutoCompoundCmd broadcast MsgSetLockAutoCompound.
+func NewSetLockAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-lock-auto-compound [lock_id] [enabled] [flags]",
+		Short: "enable or disable the auto-compounding of the rewards of a lock of LP shares",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLockAutoCompound(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				enabled,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate.
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [lock_id] [val_addr] [flags]",
+		Short: "superfluid redelegate a lock to a new validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidRedelegate(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				valAddr,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSuperfluidPartialUndelegateCmd broadcast MsgSuperfluidPartialUndelegate.
+func NewSuperfluidPartialUndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-undelegate [lock_id] [coin] [flags]",
+		Short: "superfluid undelegate part of a lock, leaving the rest delegated",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidPartialUndelegate(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				coin,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
@@ -226,6 +304,8 @@ func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagSuperfluidAssets, "", "The superfluid asset array")
+	cmd.Flags().String(FlagRiskFactors, "", "The risk factors of the superfluid assets, in the same order (optional)")
+	cmd.Flags().String(FlagPricePoolIds, "", "The price pool ids of the superfluid assets, in the same order, 0 for LP shares (optional)")
 
 	return cmd
 }
@@ -297,11 +377,56 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 
 	assets := strings.Split(assetsStr, ",")
 
+	riskFactorsStr, err := cmd.Flags().GetString(FlagRiskFactors)
+	if err != nil {
+		return nil, err
+	}
+
+	riskFactors := make([]sdk.Dec, len(assets))
+	if riskFactorsStr != "" {
+		riskFactorStrs := strings.Split(riskFactorsStr, ",")
+		if len(riskFactorStrs) != len(assets) {
+			return nil, fmt.Errorf("got %d risk factors for %d superfluid assets", len(riskFactorStrs), len(assets))
+		}
+		for i, riskFactorStr := range riskFactorStrs {
+			riskFactors[i], err = sdk.NewDecFromStr(riskFactorStr)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	pricePoolIdsStr, err := cmd.Flags().GetString(FlagPricePoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// assets with a price pool are native assets, the others are LP shares
+	pricePoolIds := make([]uint64, len(assets))
+	if pricePoolIdsStr != "" {
+		pricePoolIdStrs := strings.Split(pricePoolIdsStr, ",")
+		if len(pricePoolIdStrs) != len(assets) {
+			return nil, fmt.Errorf("got %d price pool ids for %d superfluid assets", len(pricePoolIdStrs), len(assets))
+		}
+		for i, pricePoolIdStr := range pricePoolIdStrs {
+			pricePoolIds[i], err = strconv.ParseUint(pricePoolIdStr, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	superfluidAssets := []types.SuperfluidAsset{}
-	for _, asset := range assets {
+	for i, asset := range assets {
+		assetType := types.SuperfluidAssetTypeLPShare
+		if pricePoolIds[i] != 0 {
+			assetType = types.SuperfluidAssetTypeNative
+		}
 		superfluidAssets = append(superfluidAssets, types.SuperfluidAsset{
-			Denom:     asset,
-			AssetType: types.SuperfluidAssetTypeLPShare,
+			Denom:       asset,
+			AssetType:   assetType,
+			RiskFactor:  riskFactors[i],
+			PricePoolId: pricePoolIds[i],
 		})
 	}
 
@@ -32,9 +32,15 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		case *types.MsgUnPoolWhitelistedPool:
 			res, err := msgServer.UnPoolWhitelistedPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
-		// case *types.MsgSuperfluidRedelegate:
-		// 	res, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(ctx), msg)
-		// return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSuperfluidRedelegate:
+			res, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSuperfluidPartialUndelegate:
+			res, err := msgServer.SuperfluidPartialUndelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetLockAutoCompound:
+			res, err := msgServer.SetLockAutoCompound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
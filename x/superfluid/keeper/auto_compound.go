@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v10/osmoutils"
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetLockAutoCompound enables or disables the auto-compounding of the rewards of a lock.
+// Enabling it makes the lock's escrow account receive the lock's rewards, until they are compounded.
+func (k Keeper) SetLockAutoCompound(ctx sdk.Context, sender string, lockID uint64, enabled bool) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != sender {
+		return lockuptypes.ErrNotLockOwner
+	}
+
+	_, found := k.GetAutoCompoundLock(ctx, lockID)
+	if !enabled {
+		if !found {
+			return nil
+		}
+		return k.stopAutoCompound(ctx, *lock)
+	}
+	if found {
+		return nil
+	}
+	if _, err := getAutoCompoundPoolId(*lock); err != nil {
+		return err
+	}
+	if lock.RewardReceiver() != lock.Owner {
+		return sdkerrors.Wrap(types.ErrAutoCompoundNotAllowed, "lock rewards are not received by its owner")
+	}
+	err = k.lk.SetLockRewardReceiver(ctx, lockID, lock.OwnerAddress(), types.GetAutoCompoundEscrowAddr(lockID))
+	if err != nil {
+		return err
+	}
+	k.SetAutoCompoundLock(ctx, types.AutoCompoundLock{LockId: lockID})
+	return nil
+}
+
+// stopAutoCompound stops the auto-compounding of the rewards of a lock. The lock's rewards are
+// received by its owner again, and the rewards left in its escrow account are sent to the owner.
+func (k Keeper) stopAutoCompound(ctx sdk.Context, lock lockuptypes.PeriodLock) error {
+	k.DeleteAutoCompoundLock(ctx, lock.ID)
+
+	escrowAddr := types.GetAutoCompoundEscrowAddr(lock.ID)
+	if lock.RewardReceiver() == escrowAddr.String() {
+		err := k.lk.SetLockRewardReceiver(ctx, lock.ID, lock.OwnerAddress(), lock.OwnerAddress())
+		if err != nil {
+			return err
+		}
+	}
+	return k.bk.SendCoins(ctx, escrowAddr, lock.OwnerAddress(), k.bk.GetAllBalances(ctx, escrowAddr))
+}
+
+// getAutoCompoundPoolId returns the pool of the LP shares of a lock that can be auto-compounded.
+// The lock must hold a single LP share and not be unlocking.
+func getAutoCompoundPoolId(lock lockuptypes.PeriodLock) (uint64, error) {
+	if lock.IsUnlocking() {
+		return 0, sdkerrors.Wrap(types.ErrAutoCompoundNotAllowed, "lock is unlocking")
+	}
+	if lock.Coins.Len() != 1 || !strings.HasPrefix(lock.Coins[0].Denom, "gamm/pool/") {
+		return 0, sdkerrors.Wrap(types.ErrAutoCompoundNotAllowed, "lock is not of a single LP share")
+	}
+	poolId, err := strconv.ParseUint(strings.TrimPrefix(lock.Coins[0].Denom, "gamm/pool/"), 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrap(types.ErrAutoCompoundNotAllowed, err.Error())
+	}
+	return poolId, nil
+}
+
+// autoCompoundLocks compounds the rewards of up to AutoCompoundLocksPerEpoch auto-compounding locks,
+// starting after the lock compounded last, so that every lock gets its turn.
+// Locks that are no longer eligible, or whose rewards are no longer received by their escrow account,
+// stop auto-compounding.
+func (k Keeper) autoCompoundLocks(ctx sdk.Context) {
+	limit := k.GetParams(ctx).AutoCompoundLocksPerEpoch
+	if limit == 0 {
+		return
+	}
+
+	for _, autoCompoundLock := range k.getNextAutoCompoundLocks(ctx, limit) {
+		k.setAutoCompoundCursor(ctx, autoCompoundLock.LockId)
+
+		lock, err := k.lk.GetLockByID(ctx, autoCompoundLock.LockId)
+		if err != nil {
+			k.DeleteAutoCompoundLock(ctx, autoCompoundLock.LockId)
+			continue
+		}
+		poolId, err := getAutoCompoundPoolId(*lock)
+		if err != nil || lock.RewardReceiver() != types.GetAutoCompoundEscrowAddr(lock.ID).String() {
+			if err := k.stopAutoCompound(ctx, *lock); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("failed to stop auto-compounding lock %d: %s", lock.ID, err.Error()))
+			}
+			continue
+		}
+
+		// the rewards stay in the escrow account if compounding fails, and are compounded later on
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.compoundLockRewards(cacheCtx, *lock, poolId)
+		})
+	}
+}
+
+// compoundLockRewards joins the rewards held in the escrow account of a lock, claimable rewards included,
+// into its pool, and adds the shares to the lock. Reward denoms that are not in the pool are sent to the owner.
+func (k Keeper) compoundLockRewards(ctx sdk.Context, lock lockuptypes.PeriodLock, poolId uint64) error {
+	// claimed rewards are sent to the escrow account, which is the lock's reward receiver
+	if _, err := k.ik.ClaimLockRewards(ctx, lock.ID); err != nil {
+		return err
+	}
+	escrowAddr := types.GetAutoCompoundEscrowAddr(lock.ID)
+	rewards := k.bk.GetAllBalances(ctx, escrowAddr)
+
+	pool, err := k.gk.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+
+	owner := lock.OwnerAddress()
+	// LP shares of the lock's pool are added to the lock as they are
+	shares := rewards.AmountOf(lock.Coins[0].Denom)
+	compounded := sdk.Coins{}
+	for _, reward := range rewards {
+		if reward.Denom == lock.Coins[0].Denom {
+			continue
+		}
+		if poolLiquidity.AmountOf(reward.Denom).IsZero() {
+			if err := k.bk.SendCoins(ctx, escrowAddr, owner, sdk.NewCoins(reward)); err != nil {
+				return err
+			}
+			continue
+		}
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			sharesOut, err := k.joinLockRewardIntoPool(cacheCtx, escrowAddr, poolId, reward)
+			if err != nil {
+				return err
+			}
+			shares = shares.Add(sharesOut)
+			compounded = compounded.Add(reward)
+			return nil
+		})
+	}
+	if !shares.IsPositive() {
+		return nil
+	}
+
+	sharesCoin := sdk.NewCoin(lock.Coins[0].Denom, shares)
+	if err := k.bk.SendCoins(ctx, escrowAddr, owner, sdk.NewCoins(sharesCoin)); err != nil {
+		return err
+	}
+	if _, err := k.lk.AddTokensToLockByID(ctx, lock.ID, owner, sharesCoin); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtLockAutoCompound,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lock.ID)),
+		sdk.NewAttribute(types.AttributeAmount, compounded.String()),
+		sdk.NewAttribute(types.AttributeShares, shares.String()),
+	))
+	return nil
+}
+
+// joinLockRewardIntoPool joins a reward into the pool from the escrow account of a lock.
+// The join gets the shares of the pool's current price, as there is no price of the pool that
+// can't be moved before the epoch to compare it with, so it isn't bounded by a slippage.
+func (k Keeper) joinLockRewardIntoPool(ctx sdk.Context, escrowAddr sdk.AccAddress, poolId uint64, reward sdk.Coin) (sdk.Int, error) {
+	return k.gk.JoinSwapExactAmountIn(ctx, escrowAddr, poolId, sdk.NewCoins(reward), sdk.OneInt())
+}
+
+// getNextAutoCompoundLocks returns up to limit auto-compounding locks, by lock id,
+// starting after the cursor and wrapping around.
+func (k Keeper) getNextAutoCompoundLocks(ctx sdk.Context, limit uint64) []types.AutoCompoundLock {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoCompoundLock)
+	start := sdk.Uint64ToBigEndian(k.getAutoCompoundCursor(ctx) + 1)
+
+	autoCompoundLocks := []types.AutoCompoundLock{}
+	collect := func(start, end []byte) {
+		iterator := prefixStore.Iterator(start, end)
+		defer iterator.Close()
+		for ; iterator.Valid() && uint64(len(autoCompoundLocks)) < limit; iterator.Next() {
+			autoCompoundLock := types.AutoCompoundLock{}
+			if err := proto.Unmarshal(iterator.Value(), &autoCompoundLock); err != nil {
+				panic(err)
+			}
+			autoCompoundLocks = append(autoCompoundLocks, autoCompoundLock)
+		}
+	}
+	collect(start, nil)
+	collect(nil, start)
+	return autoCompoundLocks
+}
+
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyAutoCompoundCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, lockID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundCursor, sdk.Uint64ToBigEndian(lockID))
+}
+
+func (k Keeper) SetAutoCompoundLock(ctx sdk.Context, autoCompoundLock types.AutoCompoundLock) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoCompoundLock)
+	bz, err := proto.Marshal(&autoCompoundLock)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(autoCompoundLock.LockId), bz)
+}
+
+// GetAutoCompoundLock returns the auto-compounding record of the lock, and a bool if found / not found.
+func (k Keeper) GetAutoCompoundLock(ctx sdk.Context, lockID uint64) (types.AutoCompoundLock, bool) {
+	autoCompoundLock := types.AutoCompoundLock{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoCompoundLock)
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockID))
+	if bz == nil {
+		return autoCompoundLock, false
+	}
+	err := proto.Unmarshal(bz, &autoCompoundLock)
+	if err != nil {
+		panic(err)
+	}
+	return autoCompoundLock, true
+}
+
+func (k Keeper) GetAllAutoCompoundLocks(ctx sdk.Context) []types.AutoCompoundLock {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoCompoundLock)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	autoCompoundLocks := []types.AutoCompoundLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		autoCompoundLock := types.AutoCompoundLock{}
+
+		err := proto.Unmarshal(iterator.Value(), &autoCompoundLock)
+		if err != nil {
+			panic(err)
+		}
+
+		autoCompoundLocks = append(autoCompoundLocks, autoCompoundLock)
+	}
+	return autoCompoundLocks
+}
+
+func (k Keeper) DeleteAutoCompoundLock(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoCompoundLock)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockID))
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+)
+
+func (suite *KeeperTestSuite) TestAutoCompoundLocks() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(2)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.AutoCompoundLocksPerEpoch = 1
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+
+	// only the owner can enable auto-compounding
+	_, err := msgServer.SetLockAutoCompound(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetLockAutoCompound(delAddrs[1], locks[0].ID, true))
+	suite.Require().ErrorIs(err, lockuptypes.ErrNotLockOwner)
+
+	// the rewards of auto-compounding locks are received by their escrow accounts
+	for i, lock := range locks {
+		_, err := msgServer.SetLockAutoCompound(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetLockAutoCompound(delAddrs[i], lock.ID, true))
+		suite.Require().NoError(err)
+
+		gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.GetAutoCompoundEscrowAddr(lock.ID).String(), gotLock.RewardReceiver())
+	}
+
+	// the owners' own balances are not compounded
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000000000000000000), sdk.NewInt64Coin("unknown", 100))
+	ownerBalance := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	for i, lock := range locks {
+		suite.FundAcc(types.GetAutoCompoundEscrowAddr(lock.ID), rewards)
+		suite.FundAcc(delAddrs[i], ownerBalance)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(intermediaryAccs[0].ValAddr)
+	suite.Require().NoError(err)
+	getDelegationShares := func() sdk.Dec {
+		delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAccs[0].GetAccAddress(), valAddr)
+		suite.Require().True(found)
+		return delegation.Shares
+	}
+
+	// only one lock is compounded per epoch, in turns
+	for i, lock := range locks {
+		sharesBefore := getDelegationShares()
+		suite.App.SuperfluidKeeper.AutoCompoundLocks(suite.Ctx)
+
+		compoundedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+		suite.Require().NoError(err)
+		suite.Require().True(compoundedLock.Coins.AmountOf(denoms[0]).GT(lock.Coins.AmountOf(denoms[0])))
+		suite.Require().True(getDelegationShares().GT(sharesBefore))
+
+		// the rewards that are not in the pool are sent to the owner
+		balances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, delAddrs[i])
+		suite.Require().Equal(ownerBalance.AmountOf(bondDenom), balances.AmountOf(bondDenom))
+		suite.Require().Equal(sdk.NewInt(100), balances.AmountOf("unknown"))
+		suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, types.GetAutoCompoundEscrowAddr(lock.ID)).Empty())
+
+		if i+1 < len(locks) {
+			escrowBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, types.GetAutoCompoundEscrowAddr(locks[i+1].ID))
+			suite.Require().Equal(rewards, escrowBalances)
+		}
+	}
+
+	// disabling auto-compounding removes the lock, and pays out its escrow account to the owner
+	suite.FundAcc(types.GetAutoCompoundEscrowAddr(locks[0].ID), rewards)
+	_, err = msgServer.SetLockAutoCompound(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetLockAutoCompound(delAddrs[0], locks[0].ID, false))
+	suite.Require().NoError(err)
+	_, found := suite.App.SuperfluidKeeper.GetAutoCompoundLock(suite.Ctx, locks[0].ID)
+	suite.Require().False(found)
+	gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, locks[0].ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delAddrs[0].String(), gotLock.RewardReceiver())
+	balances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, delAddrs[0])
+	suite.Require().Equal(ownerBalance.Add(rewards...).AmountOf(bondDenom), balances.AmountOf(bondDenom))
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, types.GetAutoCompoundEscrowAddr(locks[0].ID)).Empty())
+}
+
+func (suite *KeeperTestSuite) TestJoinLockRewardIntoPool() {
+	suite.SetupTest()
+
+	poolId := suite.PrepareBalancerPool()
+	escrowAddr := types.GetAutoCompoundEscrowAddr(1)
+	reward := sdk.NewInt64Coin("foo", 1000000)
+	suite.FundAcc(escrowAddr, sdk.NewCoins(reward))
+
+	// the escrow account gets the expected shares
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedShares, _, err := pool.CalcJoinPoolShares(suite.Ctx, sdk.NewCoins(reward), pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	shares, err := suite.App.SuperfluidKeeper.JoinLockRewardIntoPool(suite.Ctx, escrowAddr, poolId, reward)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedShares, shares)
+	suite.Require().Equal(expectedShares, suite.App.BankKeeper.GetBalance(suite.Ctx, escrowAddr, "gamm/pool/1").Amount)
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetIntermediaryAccountDelegatedTokens returns the osmo delegated by an intermediary account to its validator.
+func (k Keeper) GetIntermediaryAccountDelegatedTokens(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) (sdk.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	val, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Int{}, stakingtypes.ErrNoValidatorFound
+	}
+
+	delegation, found := k.sk.GetDelegation(ctx, acc.GetAccAddress(), valAddr)
+	if !found {
+		return sdk.ZeroInt(), nil
+	}
+
+	return delegation.Shares.Quo(val.DelegatorShares).MulInt(val.Tokens).RoundInt(), nil
+}
+
+// computeSuperfluidDelegatedTokens walks all the intermediary accounts and returns the osmo
+// superfluid delegated to each validator, and to all the validators.
+// Accounts of validators that no longer exist delegate nothing.
+func (k Keeper) computeSuperfluidDelegatedTokens(ctx sdk.Context) (map[string]sdk.Int, sdk.Int, error) {
+	validatorAmounts := map[string]sdk.Int{}
+	total := sdk.ZeroInt()
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		amount, err := k.GetIntermediaryAccountDelegatedTokens(ctx, acc)
+		if err == stakingtypes.ErrNoValidatorFound {
+			continue
+		} else if err != nil {
+			return nil, sdk.Int{}, err
+		}
+		total = total.Add(amount)
+		if validatorAmount, ok := validatorAmounts[acc.ValAddr]; ok {
+			amount = validatorAmount.Add(amount)
+		}
+		validatorAmounts[acc.ValAddr] = amount
+	}
+	return validatorAmounts, total, nil
+}
+
+func superfluidDelegatedTokensKey(valAddr string) []byte {
+	if valAddr == "" {
+		return types.KeyTotalSuperfluidTokens
+	}
+	return append(types.KeyPrefixValidatorSuperfluidTokens, []byte(valAddr)...)
+}
+
+// GetSuperfluidDelegatedTokens returns the osmo superfluid delegated to a validator,
+// or to all the validators if valAddr is empty.
+// The amounts are running totals kept by minting and burning superfluid osmo, and resynced with
+// the delegations of the validator's intermediary accounts when it is slashed.
+func (k Keeper) GetSuperfluidDelegatedTokens(ctx sdk.Context, valAddr string) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(superfluidDelegatedTokensKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	amount := sdk.Int{}
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setSuperfluidDelegatedTokens(ctx sdk.Context, valAddr string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := superfluidDelegatedTokensKey(valAddr)
+	if !amount.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// addSuperfluidDelegatedTokens adds amount, which is negative for burnt osmo,
+// to the osmo superfluid delegated to the validator and to all the validators.
+func (k Keeper) addSuperfluidDelegatedTokens(ctx sdk.Context, valAddr string, amount sdk.Int) {
+	k.setSuperfluidDelegatedTokens(ctx, valAddr, k.GetSuperfluidDelegatedTokens(ctx, valAddr).Add(amount))
+	k.setSuperfluidDelegatedTokens(ctx, "", k.GetSuperfluidDelegatedTokens(ctx, "").Add(amount))
+}
+
+// ResetSuperfluidDelegatedTokens sets the osmo superfluid delegated to each validator, and to all the validators,
+// to the current delegations of the intermediary accounts.
+func (k Keeper) ResetSuperfluidDelegatedTokens(ctx sdk.Context) error {
+	validatorAmounts, total, err := k.computeSuperfluidDelegatedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixValidatorSuperfluidTokens)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for valAddr, amount := range validatorAmounts {
+		k.setSuperfluidDelegatedTokens(ctx, valAddr, amount)
+	}
+	k.setSuperfluidDelegatedTokens(ctx, "", total)
+	return nil
+}
+
+// syncValidatorSuperfluidDelegatedTokens sets the osmo superfluid delegated to a validator to the current
+// delegations of its intermediary accounts, and adjusts the osmo superfluid delegated to all the validators
+// by the difference. Slashes are the only changes to these delegations that don't mint or burn osmo.
+func (k Keeper) syncValidatorSuperfluidDelegatedTokens(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	amount := sdk.ZeroInt()
+	for _, acc := range k.GetIntermediaryAccountsForVal(ctx, valAddr) {
+		accAmount, err := k.GetIntermediaryAccountDelegatedTokens(ctx, acc)
+		if err != nil {
+			return err
+		}
+		amount = amount.Add(accAmount)
+	}
+	k.addSuperfluidDelegatedTokens(ctx, valAddr.String(), amount.Sub(k.GetSuperfluidDelegatedTokens(ctx, valAddr.String())))
+	return nil
+}
+
+// superfluidCapHeadroom returns the osmo that can still be superfluid delegated before the superfluid
+// share of the tokens reaches maxShare, counting the delegated osmo in the tokens, and false if maxShare is no cap.
+func superfluidCapHeadroom(maxShare sdk.Dec, superfluidAmount, tokens sdk.Int) (sdk.Int, bool) {
+	if maxShare.IsZero() {
+		return sdk.Int{}, false
+	}
+	// (superfluidAmount + headroom) / (tokens + headroom) <= maxShare
+	headroom := maxShare.MulInt(tokens).Sub(superfluidAmount.ToDec()).Quo(sdk.OneDec().Sub(maxShare)).TruncateInt()
+	if headroom.IsNegative() {
+		return sdk.ZeroInt(), true
+	}
+	return headroom, true
+}
+
+// GetSuperfluidDelegationHeadroom returns the osmo that can still be superfluid delegated to the validator
+// before reaching the superfluid cap of the validator, and the global superfluid cap.
+// A headroom is nil when its cap is disabled.
+func (k Keeper) GetSuperfluidDelegationHeadroom(ctx sdk.Context, validator stakingtypes.Validator) *types.SuperfluidDelegationHeadroomResponse {
+	params := k.GetParams(ctx)
+
+	validatorAmount := k.GetSuperfluidDelegatedTokens(ctx, validator.OperatorAddress)
+	totalAmount := k.GetSuperfluidDelegatedTokens(ctx, "")
+	totalBondedTokens := k.sk.TotalBondedTokens(ctx)
+
+	res := &types.SuperfluidDelegationHeadroomResponse{
+		ValidatorSuperfluidAmount: validatorAmount,
+		ValidatorTokens:           validator.Tokens,
+		TotalSuperfluidAmount:     totalAmount,
+		TotalBondedTokens:         totalBondedTokens,
+	}
+	if headroom, capped := superfluidCapHeadroom(params.MaxValidatorSuperfluidShare, validatorAmount, validator.Tokens); capped {
+		res.ValidatorHeadroom = &headroom
+	}
+	if headroom, capped := superfluidCapHeadroom(params.MaxTotalSuperfluidShare, totalAmount, totalBondedTokens); capped {
+		res.TotalHeadroom = &headroom
+	}
+	return res
+}
+
+// validateSuperfluidDelegationCaps returns an error if superfluid delegating amount to the validator
+// would exceed the superfluid cap of the validator or the global superfluid cap.
+func (k Keeper) validateSuperfluidDelegationCaps(ctx sdk.Context, valAddr string, amount sdk.Int) error {
+	params := k.GetParams(ctx)
+	if params.MaxValidatorSuperfluidShare.IsZero() && params.MaxTotalSuperfluidShare.IsZero() {
+		return nil
+	}
+
+	validator, err := k.validateValAddrForDelegate(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	headroom := k.GetSuperfluidDelegationHeadroom(ctx, validator)
+	if headroom.ValidatorHeadroom != nil && amount.GT(*headroom.ValidatorHeadroom) {
+		return sdkerrors.Wrapf(types.ErrValidatorSuperfluidCapExceeded, "amount %s, headroom %s", amount, headroom.ValidatorHeadroom)
+	}
+	if headroom.TotalHeadroom != nil && amount.GT(*headroom.TotalHeadroom) {
+		return sdkerrors.Wrapf(types.ErrTotalSuperfluidCapExceeded, "amount %s, headroom %s", amount, headroom.TotalHeadroom)
+	}
+	return nil
+}
+
+// capSuperfluidDelegationAmount returns the part of amount that can be superfluid delegated to the validator
+// without exceeding the superfluid cap of the validator or the global superfluid cap.
+func (k Keeper) capSuperfluidDelegationAmount(ctx sdk.Context, valAddr string, amount sdk.Int) (sdk.Int, error) {
+	params := k.GetParams(ctx)
+	if params.MaxValidatorSuperfluidShare.IsZero() && params.MaxTotalSuperfluidShare.IsZero() {
+		return amount, nil
+	}
+
+	validator, err := k.validateValAddrForDelegate(ctx, valAddr)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	headroom := k.GetSuperfluidDelegationHeadroom(ctx, validator)
+	if headroom.ValidatorHeadroom != nil {
+		amount = sdk.MinInt(amount, *headroom.ValidatorHeadroom)
+	}
+	if headroom.TotalHeadroom != nil {
+		amount = sdk.MinInt(amount, *headroom.TotalHeadroom)
+	}
+	return amount, nil
+}
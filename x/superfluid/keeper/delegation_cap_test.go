@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+)
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegationCaps() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+	queryHeadroom := func() *types.SuperfluidDelegationHeadroomResponse {
+		res, err := suite.querier.SuperfluidDelegationHeadroom(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidDelegationHeadroomRequest{
+			ValidatorAddress: valAddrs[0].String(),
+		})
+		suite.Require().NoError(err)
+		return res
+	}
+	setCaps := func(maxValidatorShare, maxTotalShare sdk.Dec) {
+		params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+		params.MaxValidatorSuperfluidShare = maxValidatorShare
+		params.MaxTotalSuperfluidShare = maxTotalShare
+		suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+	}
+	// locks and superfluid delegates, keeping the state only on success as a tx would
+	superfluidDelegate := func(lpAmount int64) error {
+		ctx := suite.Ctx
+		cacheCtx, write := ctx.CacheContext()
+		suite.Ctx = cacheCtx
+		defer func() { suite.Ctx = ctx }()
+
+		delAddr := CreateRandomAccounts(1)[0]
+		unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+		lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], lpAmount)), unbondingDuration)
+		err := suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, delAddr.String(), lockID, valAddrs[0].String())
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// no cap by default
+	res := queryHeadroom()
+	suite.Require().Equal(sdk.NewInt(10000000), res.ValidatorSuperfluidAmount)
+	suite.Require().Equal(sdk.NewInt(10000000), res.TotalSuperfluidAmount)
+	suite.Require().Nil(res.ValidatorHeadroom)
+	suite.Require().Nil(res.TotalHeadroom)
+
+	// the validator has 10000100 regular and 10000000 superfluid delegated tokens
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	suite.FundAcc(delAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000)))
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	_, err := suite.App.StakingKeeper.Delegate(suite.Ctx, delAddrs[0], sdk.NewInt(10000000), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	// (10000000 + headroom) / (20000100 + headroom) <= 0.6
+	setCaps(sdk.NewDecWithPrec(6, 1), sdk.ZeroDec())
+	res = queryHeadroom()
+	suite.Require().Equal(sdk.NewInt(20000100), res.ValidatorTokens)
+	suite.Require().Equal(sdk.NewInt(5000150), *res.ValidatorHeadroom)
+	suite.Require().Nil(res.TotalHeadroom)
+
+	err = superfluidDelegate(1000000)
+	suite.Require().ErrorIs(err, types.ErrValidatorSuperfluidCapExceeded)
+	err = superfluidDelegate(400000)
+	suite.Require().NoError(err)
+	res = queryHeadroom()
+	suite.Require().Equal(sdk.NewInt(14000000), res.ValidatorSuperfluidAmount)
+	suite.Require().Equal(sdk.NewInt(1000150), *res.ValidatorHeadroom)
+
+	// tokens added to a superfluid delegated lock are only delegated up to the cap
+	lock := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, delAddrs[0])[0]
+	addedCoin := sdk.NewInt64Coin(denoms[0], 1000000)
+	suite.FundAcc(delAddrs[0], sdk.NewCoins(addedCoin))
+	_, err = suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, lock.ID, delAddrs[0], addedCoin)
+	suite.Require().NoError(err)
+	res = queryHeadroom()
+	suite.Require().Equal(sdk.NewInt(15000150), res.ValidatorSuperfluidAmount)
+	suite.Require().True(res.ValidatorHeadroom.IsZero())
+
+	// the refresh doesn't mint over the cap either
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	res = queryHeadroom()
+	suite.Require().Equal(sdk.NewInt(15000150), res.ValidatorSuperfluidAmount)
+
+	// and mints the rest once the cap is lifted
+	setCaps(sdk.ZeroDec(), sdk.ZeroDec())
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	res = queryHeadroom()
+	suite.Require().Equal(sdk.NewInt(24000000), res.ValidatorSuperfluidAmount)
+	suite.Require().Equal(sdk.NewInt(24000000), res.TotalSuperfluidAmount)
+
+	// the global cap is reached at the current superfluid share of the bonded tokens
+	maxTotalShare := res.TotalSuperfluidAmount.ToDec().Quo(res.TotalBondedTokens.ToDec())
+	setCaps(sdk.ZeroDec(), maxTotalShare)
+	res = queryHeadroom()
+	suite.Require().Nil(res.ValidatorHeadroom)
+	suite.Require().True(res.TotalHeadroom.LT(sdk.NewInt(10)))
+
+	err = superfluidDelegate(1000)
+	suite.Require().ErrorIs(err, types.ErrTotalSuperfluidCapExceeded)
+}
+
+// TestSuperfluidUndelegateCappedDelegation tests that locks of an intermediary account which the superfluid
+// caps left delegating less than the value of its locks only undelegate their share of the delegation.
+func (suite *KeeperTestSuite) TestSuperfluidUndelegateCappedDelegation() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(2)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	accs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{
+		{0, 0, 0, 1000000},
+		{1, 0, 0, 1000000},
+	}, denoms)
+	acc := accs[0]
+
+	delegatedTokens := func() sdk.Int {
+		amount, err := suite.App.SuperfluidKeeper.GetIntermediaryAccountDelegatedTokens(suite.Ctx, acc)
+		suite.Require().NoError(err)
+		return amount
+	}
+	suite.Require().Equal(sdk.NewInt(20000000), delegatedTokens())
+
+	// cap the validator at its current superfluid share, so that tokens added to the first lock aren't delegated
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.MaxValidatorSuperfluidShare = delegatedTokens().ToDec().Quo(validator.Tokens.ToDec())
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+
+	addedCoin := sdk.NewInt64Coin(denoms[0], 3000000)
+	suite.FundAcc(delAddrs[0], sdk.NewCoins(addedCoin))
+	_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, locks[0].ID, delAddrs[0], addedCoin)
+	suite.Require().NoError(err)
+	delegated := delegatedTokens()
+	suite.Require().True(delegated.Sub(sdk.NewInt(20000000)).LT(sdk.NewInt(10)))
+
+	// the first lock is worth 40000000 of the 50000000 the account should delegate, and undelegates 4/5 of the delegation
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[0].String(), locks[0].ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delegated.Sub(delegated.MulRaw(4).QuoRaw(5)), delegatedTokens())
+
+	// the second lock is worth 10000000, and redelegates with the rest of the delegation
+	params.MaxValidatorSuperfluidShare = sdk.ZeroDec()
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+	err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, delAddrs[1].String(), locks[1].ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+	suite.Require().True(delegatedTokens().IsZero())
+	suite.Require().True(suite.App.SuperfluidKeeper.GetSuperfluidDelegatedTokens(suite.Ctx, valAddrs[0].String()).IsZero())
+	suite.Require().Equal(sdk.NewInt(10000000), suite.App.SuperfluidKeeper.GetSuperfluidDelegatedTokens(suite.Ctx, valAddrs[1].String()))
+}
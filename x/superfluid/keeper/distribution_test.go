@@ -3,6 +3,7 @@ package keeper_test
 import (
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -112,3 +113,68 @@ func (suite *KeeperTestSuite) TestMoveSuperfluidDelegationRewardToGauges() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSuperfluidLockRewards() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(2)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.LockRewardHistoryEpochs = 2
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+
+	// start the distribution of the superfluid gauges
+	suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, suite.App.SuperfluidKeeper.GetEpochIdentifier(suite.Ctx), 0)
+
+	epochIdentifier := suite.App.SuperfluidKeeper.GetEpochIdentifier(suite.Ctx)
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		epochInfo := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochIdentifier)
+		epochInfo.CurrentEpoch = epoch
+		suite.App.EpochsKeeper.SetEpochInfo(suite.Ctx, epochInfo)
+
+		suite.AllocateRewardsToValidator(valAddrs[0], sdk.NewInt(20000))
+		suite.App.SuperfluidKeeper.MoveSuperfluidDelegationRewardToGauges(suite.Ctx)
+		suite.App.SuperfluidKeeper.DistributeSuperfluidGauges(suite.Ctx, epoch)
+	}
+
+	// every lock reward is evented with its lock id
+	rewardedLockIds := map[string]int{}
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtSuperfluidLockRewards {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeLockId {
+				rewardedLockIds[string(attr.Value)]++
+			}
+		}
+	}
+	for _, lock := range locks {
+		suite.Require().Equal(3, rewardedLockIds[sdk.NewUint(lock.ID).String()])
+	}
+
+	for _, lock := range locks {
+		res, err := suite.querier.SuperfluidLockRewards(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidLockRewardsRequest{LockId: lock.ID})
+		suite.Require().NoError(err)
+
+		// rewards are split evenly between the two locks
+		suite.Require().Len(res.AccruedRewards, 1)
+		suite.Require().Equal(valAddrs[0].String(), res.AccruedRewards[0].ValidatorAddress)
+		suite.Require().True(res.TotalAccruedRewards.AmountOf(sdk.DefaultBondDenom).IsPositive())
+		suite.Require().Equal(res.AccruedRewards[0].Rewards, res.TotalAccruedRewards)
+
+		// only the last two epochs are kept in history
+		suite.Require().Len(res.History, 2)
+		totalHistoryRewards := sdk.NewCoins()
+		for i, record := range res.History {
+			suite.Require().Equal(int64(i+2), record.EpochNumber)
+			suite.Require().Equal(valAddrs[0].String(), record.ValidatorAddress)
+			suite.Require().True(record.Rewards.AmountOf(sdk.DefaultBondDenom).IsPositive())
+			totalHistoryRewards = totalHistoryRewards.Add(record.Rewards...)
+		}
+		suite.Require().True(res.TotalAccruedRewards.IsAllGT(totalHistoryRewards))
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,23 +28,30 @@ func (k Keeper) AfterEpochStartBeginBlock(ctx sdk.Context) {
 	k.MoveSuperfluidDelegationRewardToGauges(ctx)
 
 	ctx.Logger().Info("Distribute Superfluid gauges")
-	k.distributeSuperfluidGauges(ctx)
+	k.distributeSuperfluidGauges(ctx, curEpoch)
 
 	// Update all LP tokens multipliers for the upcoming epoch.
 	// This affects staking reward distribution until the next epochs rewards.
 	// Exclusive of current epoch's rewards, inclusive of next epoch's rewards.
 	ctx.Logger().Info("Update all osmo equivalency multipliers")
+	// Each asset is updated in its own cache context, so that a failing asset
+	// only marks itself stale, and does not block the other assets.
 	for _, asset := range k.GetAllSuperfluidAssets(ctx) {
-		err := k.UpdateOsmoEquivalentMultipliers(ctx, asset, curEpoch)
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.UpdateOsmoEquivalentMultipliers(cacheCtx, asset, curEpoch)
+		})
 		if err != nil {
-			// TODO: Revisit what we do here. (halt all distr, only skip this asset)
-			// Since at MVP of feature, we only have one pool of superfluid staking,
-			// we can punt this question.
-			// each of the errors feels like significant misconfig
-			return
+			k.markAssetStale(ctx, asset.Denom, curEpoch, err)
+		} else {
+			k.clearAssetStale(ctx, asset.Denom, curEpoch)
 		}
 	}
 
+	// Compound the rewards of auto-compounding locks into their pools,
+	// increasing the superfluid delegation of superfluid locks.
+	ctx.Logger().Info("Auto-compound lock rewards")
+	k.autoCompoundLocks(ctx)
+
 	// Refresh intermediary accounts' delegation amounts,
 	// making staking rewards follow the updated multiplier numbers.
 	ctx.Logger().Info("Refresh all superfluid delegation amounts")
@@ -84,7 +92,7 @@ func (k Keeper) MoveSuperfluidDelegationRewardToGauges(ctx sdk.Context) {
 	}
 }
 
-func (k Keeper) distributeSuperfluidGauges(ctx sdk.Context) {
+func (k Keeper) distributeSuperfluidGauges(ctx sdk.Context, curEpoch int64) {
 	gauges := k.ik.GetActiveGauges(ctx)
 
 	// only distribute to active gauges that are for perpetual synthetic denoms
@@ -95,10 +103,11 @@ func (k Keeper) distributeSuperfluidGauges(ctx sdk.Context) {
 			distrGauges = append(distrGauges, gauge)
 		}
 	}
-	_, err := k.ik.Distribute(ctx, distrGauges)
+	_, lockRewards, err := k.ik.DistributeWithLockRewards(ctx, distrGauges)
 	if err != nil {
 		panic(err)
 	}
+	k.recordLockRewards(ctx, curEpoch, distrGauges, lockRewards)
 }
 
 func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.SuperfluidAsset, newEpochNumber int64) error {
@@ -108,8 +117,6 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 		pool, err := k.gk.GetPoolAndPoke(ctx, poolId)
 		if err != nil {
 			// Pool has been unexpectedly deleted
-			k.Logger(ctx).Error(err.Error())
-			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
 			return err
 		}
 
@@ -118,17 +125,18 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 		osmoPoolAsset := pool.GetTotalPoolLiquidity(ctx).AmountOf(bondDenom)
 		if osmoPoolAsset.IsZero() {
 			// Pool has unexpectedly removed Osmo from its assets.
-			k.Logger(ctx).Error(err.Error())
-			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
-			return err
+			return fmt.Errorf("pool %d has no %s liquidity", poolId, bondDenom)
 		}
 
 		multiplier := k.calculateOsmoBackingPerShare(pool, osmoPoolAsset)
 		k.SetOsmoEquivalentMultiplier(ctx, newEpochNumber, asset.Denom, multiplier)
 	} else if asset.AssetType == types.SuperfluidAssetTypeNative {
-		// TODO: Consider deleting superfluid asset type native
-		k.Logger(ctx).Error("unsupported superfluid asset type")
-		return errors.New("SuperfluidAssetTypeNative is unsupported")
+		// Native_token_Osmo_equivalent = time-averaged OSMO price of the token in its price pool
+		multiplier, err := k.getNativeAssetTwap(ctx, asset)
+		if err != nil {
+			return err
+		}
+		k.SetOsmoEquivalentMultiplier(ctx, newEpochNumber, asset.Denom, multiplier)
 	}
 	return nil
 }
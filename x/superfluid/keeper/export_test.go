@@ -1,6 +1,22 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 var (
 	StakingSyntheticDenom   = stakingSyntheticDenom
 	UnstakingSyntheticDenom = unstakingSyntheticDenom
 )
+
+func (k Keeper) DistributeSuperfluidGauges(ctx sdk.Context, curEpoch int64) {
+	k.distributeSuperfluidGauges(ctx, curEpoch)
+}
+
+func (k Keeper) AutoCompoundLocks(ctx sdk.Context) {
+	k.autoCompoundLocks(ctx)
+}
+
+func (k Keeper) JoinLockRewardIntoPool(ctx sdk.Context, escrowAddr sdk.AccAddress, poolId uint64, reward sdk.Coin) (sdk.Int, error) {
+	return k.joinLockRewardIntoPool(ctx, escrowAddr, poolId, reward)
+}
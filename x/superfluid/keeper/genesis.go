@@ -37,6 +37,39 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize superfluid unbonding start heights
+	for _, startHeight := range genState.UnbondingStartHeights {
+		k.SetUnbondingStartHeight(ctx, startHeight.LockId, startHeight.SynthDenom, startHeight.Height)
+	}
+
+	// initialize stale superfluid assets
+	for _, staleAsset := range genState.StaleAssets {
+		k.SetStaleAsset(ctx, staleAsset)
+	}
+
+	// initialize native superfluid asset price records
+	for _, record := range genState.NativeAssetPriceRecords {
+		k.SetNativeAssetPriceRecord(ctx, record)
+	}
+
+	// initialize auto-compounding locks
+	for _, autoCompoundLock := range genState.AutoCompoundLocks {
+		k.SetAutoCompoundLock(ctx, autoCompoundLock)
+	}
+
+	// initialize superfluid staking rewards of locks
+	for _, accrued := range genState.LockAccruedRewards {
+		k.setLockAccruedRewards(ctx, accrued.LockId, accrued.Rewards)
+	}
+	for _, history := range genState.LockRewardHistory {
+		k.setLockRewardRecord(ctx, history.LockId, history.Record)
+	}
+
+	// the osmo superfluid delegated is derived from the delegations of the intermediary accounts
+	if err := k.ResetSuperfluidDelegatedTokens(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +80,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		UnbondingStartHeights:         k.GetAllUnbondingStartHeights(ctx),
+		StaleAssets:                   k.GetAllStaleAssets(ctx),
+		NativeAssetPriceRecords:       k.GetAllNativeAssetPriceRecords(ctx),
+		AutoCompoundLocks:             k.GetAllAutoCompoundLocks(ctx),
+		LockAccruedRewards:            k.GetAllLocksAccruedRewards(ctx),
+		LockRewardHistory:             k.GetAllLocksRewardHistory(ctx),
 	}
 }
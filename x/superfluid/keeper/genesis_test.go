@@ -19,12 +19,15 @@ var now = time.Now().UTC()
 
 var testGenesis = types.GenesisState{
 	Params: types.Params{
-		MinimumRiskFactor: sdk.NewDecWithPrec(5, 1), // 50%
+		MinimumRiskFactor:           sdk.NewDecWithPrec(5, 1), // 50%
+		MaxValidatorSuperfluidShare: sdk.NewDecWithPrec(2, 1),
+		MaxTotalSuperfluidShare:     sdk.ZeroDec(),
 	},
 	SuperfluidAssets: []types.SuperfluidAsset{
 		{
-			Denom:     "gamm/pool/1",
-			AssetType: types.SuperfluidAssetTypeLPShare,
+			Denom:      "gamm/pool/1",
+			AssetType:  types.SuperfluidAssetTypeLPShare,
+			RiskFactor: sdk.ZeroDec(),
 		},
 	},
 	OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{
@@ -47,6 +50,54 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	UnbondingStartHeights: []types.SuperfluidUnbondingStartHeight{
+		{
+			LockId:     2,
+			Height:     10,
+			SynthDenom: "gamm/pool/1/superunbonding/osmovaloper1cyw4vw20el8e7ez8080md0r8psg25n0cq98a9n",
+		},
+	},
+	StaleAssets: []types.StaleSuperfluidAsset{
+		{
+			Denom:        "gamm/pool/1",
+			SinceEpoch:   1,
+			FailedEpochs: 2,
+			LastError:    "pool not found",
+		},
+	},
+	NativeAssetPriceRecords: []types.NativeAssetPriceRecord{
+		{
+			Denom:          "uatom",
+			LastSpotPrice:  sdk.NewDec(2),
+			LastSampleTime: now,
+			PriceTimeSum:   sdk.NewDec(7200000),
+			StartTime:      now.Add(-time.Hour),
+		},
+	},
+	AutoCompoundLocks: []types.AutoCompoundLock{
+		{
+			LockId: 1,
+		},
+	},
+	LockAccruedRewards: []types.LockAccruedRewards{
+		{
+			LockId: 1,
+			Rewards: types.SuperfluidLockRewards{
+				ValidatorAddress: "osmovaloper1cyw4vw20el8e7ez8080md0r8psg25n0cq98a9n",
+				Rewards:          sdk.NewCoins(sdk.NewInt64Coin("uosmo", 3000)),
+			},
+		},
+	},
+	LockRewardHistory: []types.LockRewardHistoryRecord{
+		{
+			LockId: 1,
+			Record: types.SuperfluidLockRewardRecord{
+				EpochNumber:      2,
+				ValidatorAddress: "osmovaloper1cyw4vw20el8e7ez8080md0r8psg25n0cq98a9n",
+				Rewards:          sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+			},
+		},
+	},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -91,6 +142,24 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	startHeights := app.SuperfluidKeeper.GetAllUnbondingStartHeights(ctx)
+	require.Equal(t, startHeights, genesis.UnbondingStartHeights)
+
+	staleAssets := app.SuperfluidKeeper.GetAllStaleAssets(ctx)
+	require.Equal(t, staleAssets, genesis.StaleAssets)
+
+	priceRecords := app.SuperfluidKeeper.GetAllNativeAssetPriceRecords(ctx)
+	require.Equal(t, priceRecords, genesis.NativeAssetPriceRecords)
+
+	autoCompoundLocks := app.SuperfluidKeeper.GetAllAutoCompoundLocks(ctx)
+	require.Equal(t, autoCompoundLocks, genesis.AutoCompoundLocks)
+
+	lockAccruedRewards := app.SuperfluidKeeper.GetAllLocksAccruedRewards(ctx)
+	require.Equal(t, lockAccruedRewards, genesis.LockAccruedRewards)
+
+	lockRewardHistory := app.SuperfluidKeeper.GetAllLocksRewardHistory(ctx)
+	require.Equal(t, lockRewardHistory, genesis.LockRewardHistory)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -101,8 +170,9 @@ func TestExportGenesis(t *testing.T) {
 	app.SuperfluidKeeper.InitGenesis(ctx, genesis)
 
 	asset := types.SuperfluidAsset{
-		Denom:     "gamm/pool/2",
-		AssetType: types.SuperfluidAssetTypeLPShare,
+		Denom:      "gamm/pool/2",
+		AssetType:  types.SuperfluidAssetTypeLPShare,
+		RiskFactor: sdk.NewDecWithPrec(6, 1),
 	}
 	app.SuperfluidKeeper.SetSuperfluidAsset(ctx, asset)
 	savedAsset := app.SuperfluidKeeper.GetSuperfluidAsset(ctx, "gamm/pool/2")
@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
@@ -11,11 +12,18 @@ import (
 
 func HandleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper, p *types.SetSuperfluidAssetsProposal) error {
 	for _, asset := range p.Assets {
+		if asset.AssetType == types.SuperfluidAssetTypeNative {
+			if err := k.ValidateNativeAssetPricePool(ctx, asset); err != nil {
+				return err
+			}
+		}
 		k.AddNewSuperfluidAsset(ctx, asset)
 		event := sdk.NewEvent(
 			types.TypeEvtSetSuperfluidAsset,
 			sdk.NewAttribute(types.AttributeDenom, asset.Denom),
 			sdk.NewAttribute(types.AttributeSuperfluidAssetType, asset.AssetType.String()),
+			sdk.NewAttribute(types.AttributeRiskFactor, k.GetRiskFactor(ctx, asset).String()),
+			sdk.NewAttribute(types.AttributePricePoolId, strconv.FormatUint(asset.PricePoolId, 10)),
 		)
 		ctx.EventManager().EmitEvent(event)
 	}
@@ -25,8 +33,7 @@ func HandleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek type
 func HandleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveSuperfluidAssetsProposal) error {
 	for _, denom := range p.SuperfluidAssetDenoms {
 		asset := k.GetSuperfluidAsset(ctx, denom)
-		dummyAsset := types.SuperfluidAsset{}
-		if asset == dummyAsset {
+		if asset.Denom == "" {
 			return fmt.Errorf("superfluid asset %s doesn't exist", denom)
 		}
 		k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
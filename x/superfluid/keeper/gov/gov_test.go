@@ -42,16 +42,31 @@ func (suite *KeeperTestSuite) createGammPool(denoms []string) uint64 {
 
 func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposal() {
 	nativeAsset := types.SuperfluidAsset{
-		Denom:     "stake",
-		AssetType: types.SuperfluidAssetTypeNative,
+		Denom:      "stake",
+		AssetType:  types.SuperfluidAssetTypeNative,
+		RiskFactor: sdk.ZeroDec(),
 	}
 	asset1 := types.SuperfluidAsset{
-		Denom:     "gamm/pool/1",
-		AssetType: types.SuperfluidAssetTypeLPShare,
+		Denom:      "gamm/pool/1",
+		AssetType:  types.SuperfluidAssetTypeLPShare,
+		RiskFactor: sdk.NewDecWithPrec(6, 1),
 	}
 	asset2 := types.SuperfluidAsset{
-		Denom:     "nonexistanttoken",
-		AssetType: types.SuperfluidAssetTypeNative,
+		Denom:      "nonexistanttoken",
+		AssetType:  types.SuperfluidAssetTypeNative,
+		RiskFactor: sdk.ZeroDec(),
+	}
+	pricedNativeAsset := types.SuperfluidAsset{
+		Denom:       "uatom",
+		AssetType:   types.SuperfluidAssetTypeNative,
+		RiskFactor:  sdk.ZeroDec(),
+		PricePoolId: 1,
+	}
+	unpricedNativeAsset := types.SuperfluidAsset{
+		Denom:       "uatom",
+		AssetType:   types.SuperfluidAssetTypeNative,
+		RiskFactor:  sdk.ZeroDec(),
+		PricePoolId: 2,
 	}
 
 	type Action struct {
@@ -86,6 +101,25 @@ func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposal() {
 				},
 			},
 		},
+		{
+			"native asset priced by a pool",
+			[]Action{
+				{
+					true, []types.SuperfluidAsset{pricedNativeAsset}, []types.SuperfluidAsset{pricedNativeAsset}, false,
+				},
+				{
+					false, []types.SuperfluidAsset{pricedNativeAsset}, []types.SuperfluidAsset{}, false,
+				},
+			},
+		},
+		{
+			"native asset price pool does not exist",
+			[]Action{
+				{
+					true, []types.SuperfluidAsset{unpricedNativeAsset}, []types.SuperfluidAsset{}, true,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
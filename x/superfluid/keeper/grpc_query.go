@@ -199,41 +199,14 @@ func (q Querier) SuperfluidDelegationsByDelegator(goCtx context.Context, req *ty
 		TotalEquivalentStakedAmount: sdk.NewCoin(appparams.BaseCoinUnit, sdk.ZeroInt()),
 	}
 
-	syntheticLocks := q.Keeper.lk.GetAllSyntheticLockupsByAddr(ctx, delAddr)
-
-	for _, syntheticLock := range syntheticLocks {
-		// don't include unbonding delegations
-		if strings.Contains(syntheticLock.SynthDenom, "superunbonding") {
-			continue
-		}
-
-		periodLock, err := q.Keeper.lk.GetLockByID(ctx, syntheticLock.UnderlyingLockId)
-		if err != nil {
-			return nil, err
-		}
-
-		baseDenom := periodLock.Coins.GetDenomByIndex(0)
-		lockedCoins := sdk.NewCoin(baseDenom, periodLock.GetCoins().AmountOf(baseDenom))
-		valAddr, err := ValidatorAddressFromSyntheticDenom(syntheticLock.SynthDenom)
-
-		// Find how many osmo tokens this delegation is worth at superfluids current risk adjustment
-		// and twap of the denom.
-		equivalentAmount := q.Keeper.GetSuperfluidOSMOTokens(ctx, baseDenom, lockedCoins.Amount)
-		coin := sdk.NewCoin(appparams.BaseCoinUnit, equivalentAmount)
-
-		if err != nil {
-			return nil, err
-		}
-		res.SuperfluidDelegationRecords = append(res.SuperfluidDelegationRecords,
-			types.SuperfluidDelegationRecord{
-				DelegatorAddress:       req.DelegatorAddress,
-				ValidatorAddress:       valAddr,
-				DelegationAmount:       lockedCoins,
-				EquivalentStakedAmount: &coin,
-			},
-		)
-		res.TotalDelegatedCoins = res.TotalDelegatedCoins.Add(lockedCoins)
-		res.TotalEquivalentStakedAmount = res.TotalEquivalentStakedAmount.Add(coin)
+	records, err := q.Keeper.GetSuperfluidDelegationRecords(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		res.SuperfluidDelegationRecords = append(res.SuperfluidDelegationRecords, record)
+		res.TotalDelegatedCoins = res.TotalDelegatedCoins.Add(record.DelegationAmount)
+		res.TotalEquivalentStakedAmount = res.TotalEquivalentStakedAmount.Add(*record.EquivalentStakedAmount)
 	}
 
 	return &res, nil
@@ -267,6 +240,10 @@ func (q Querier) SuperfluidUndelegationsByDelegator(goCtx context.Context, req *
 		if strings.Contains(syntheticLock.SynthDenom, "superbonding") {
 			continue
 		}
+		// a lock still connected to an intermediary account is being redelegated.
+		if _, found := q.Keeper.GetIntermediaryAccountFromLockId(ctx, syntheticLock.UnderlyingLockId); found {
+			continue
+		}
 
 		periodLock, err := q.Keeper.lk.GetLockByID(ctx, syntheticLock.UnderlyingLockId)
 		if err != nil {
@@ -296,6 +273,154 @@ func (q Querier) SuperfluidUndelegationsByDelegator(goCtx context.Context, req *
 	return &res, nil
 }
 
+// SuperfluidRedelegationsByDelegator returns all the superfluid redelegations
+// in progress of a delegator.
+func (q Querier) SuperfluidRedelegationsByDelegator(goCtx context.Context, req *types.SuperfluidRedelegationsByDelegatorRequest) (*types.SuperfluidRedelegationsByDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.DelegatorAddress) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.SuperfluidRedelegationsByDelegatorResponse{
+		SuperfluidRedelegationRecords: q.Keeper.GetSuperfluidRedelegations(ctx, delAddr),
+		TotalRedelegatedCoins:         sdk.NewCoins(),
+	}
+	for _, record := range res.SuperfluidRedelegationRecords {
+		res.TotalRedelegatedCoins = res.TotalRedelegatedCoins.Add(record.Amount)
+	}
+
+	return &res, nil
+}
+
+// SuperfluidLockRewards returns the superfluid staking rewards accrued by a
+// lock, and the ones it got at each of the recent epochs, by validator.
+func (q Querier) SuperfluidLockRewards(goCtx context.Context, req *types.SuperfluidLockRewardsRequest) (*types.SuperfluidLockRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := types.SuperfluidLockRewardsResponse{
+		AccruedRewards:      q.Keeper.GetAllLockAccruedRewards(ctx, req.LockId),
+		TotalAccruedRewards: sdk.NewCoins(),
+		History:             q.Keeper.GetLockRewardHistory(ctx, req.LockId),
+	}
+	for _, rewards := range res.AccruedRewards {
+		res.TotalAccruedRewards = res.TotalAccruedRewards.Add(rewards.Rewards...)
+	}
+
+	return &res, nil
+}
+
+// StaleAssets returns the superfluid assets whose osmo equivalent multiplier
+// failed to update at the last epochs.
+func (q Querier) StaleAssets(goCtx context.Context, req *types.StaleAssetsRequest) (*types.StaleAssetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.StaleAssetsResponse{
+		StaleAssets: q.Keeper.GetAllStaleAssets(ctx),
+	}, nil
+}
+
+// SuperfluidUnbondingsByDelegator returns the superfluid positions of a delegator that are unbonding,
+// with the total unbonding coins of all its positions.
+func (q Querier) SuperfluidUnbondingsByDelegator(goCtx context.Context, req *types.SuperfluidUnbondingsByDelegatorRequest) (*types.SuperfluidUnbondingsByDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.DelegatorAddress) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, total, pageRes, err := q.Keeper.GetSuperfluidUnbondingsByDelegator(ctx, delAddr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SuperfluidUnbondingsByDelegatorResponse{
+		SuperfluidUnbondingRecords: records,
+		TotalUnbondingCoins:        total,
+		Pagination:                 pageRes,
+	}, nil
+}
+
+// SuperfluidUnbondingsByValidatorDenom returns the superfluid positions of a denom that are unbonding
+// from a validator, with the total unbonding coins of all the positions.
+func (q Querier) SuperfluidUnbondingsByValidatorDenom(goCtx context.Context, req *types.SuperfluidUnbondingsByValidatorDenomRequest) (*types.SuperfluidUnbondingsByValidatorDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+	if len(req.ValidatorAddress) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if q.Keeper.GetSuperfluidAsset(ctx, req.Denom).Denom == "" {
+		return nil, types.ErrNonSuperfluidAsset
+	}
+
+	_, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, total, pageRes, err := q.Keeper.GetSuperfluidUnbondingsByValidatorDenom(ctx, req.ValidatorAddress, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SuperfluidUnbondingsByValidatorDenomResponse{
+		SuperfluidUnbondingRecords: records,
+		TotalUnbondingCoins:        total,
+		Pagination:                 pageRes,
+	}, nil
+}
+
+// SuperfluidDelegationHeadroom returns the osmo that can still be superfluid delegated to a validator
+// before reaching the superfluid delegation caps.
+func (q Querier) SuperfluidDelegationHeadroom(goCtx context.Context, req *types.SuperfluidDelegationHeadroomRequest) (*types.SuperfluidDelegationHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ValidatorAddress) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := q.Keeper.validateValAddrForDelegate(ctx, req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Keeper.GetSuperfluidDelegationHeadroom(ctx, validator), nil
+}
+
 // SuperfluidDelegationsByValidatorDenom returns all the superfluid positions
 // of a specific denom delegated to one validator.
 func (q Querier) SuperfluidDelegationsByValidatorDenom(goCtx context.Context, req *types.SuperfluidDelegationsByValidatorDenomRequest) (*types.SuperfluidDelegationsByValidatorDenomResponse, error) {
@@ -384,7 +509,7 @@ func (q Querier) EstimateSuperfluidDelegatedAmountByValidatorDenom(goCtx context
 	}
 
 	syntheticOsmoAmt := delegation.Shares.Quo(val.DelegatorShares).MulInt(val.Tokens)
-	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
+	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, q.Keeper.GetSuperfluidAsset(ctx, req.Denom), syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
 
 	return &types.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse{
 		TotalDelegatedCoins: sdk.NewCoins(sdk.NewCoin(req.Denom, baseAmount)),
@@ -395,27 +520,9 @@ func (q Querier) EstimateSuperfluidDelegatedAmountByValidatorDenom(goCtx context
 func (q Querier) TotalSuperfluidDelegations(goCtx context.Context, _ *types.TotalSuperfluidDelegationsRequest) (*types.TotalSuperfluidDelegationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	totalSuperfluidDelegated := sdk.NewInt(0)
-
-	intermediaryAccounts := q.Keeper.GetAllIntermediaryAccounts(ctx)
-	for _, intermediaryAccount := range intermediaryAccounts {
-		valAddr, err := sdk.ValAddressFromBech32(intermediaryAccount.ValAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		val, found := q.Keeper.sk.GetValidator(ctx, valAddr)
-		if !found {
-			return nil, stakingtypes.ErrNoValidatorFound
-		}
-
-		delegation, found := q.Keeper.sk.GetDelegation(ctx, intermediaryAccount.GetAccAddress(), valAddr)
-		if !found {
-			continue
-		}
-
-		syntheticOsmoAmt := delegation.Shares.Quo(val.DelegatorShares).MulInt(val.Tokens).RoundInt()
-		totalSuperfluidDelegated = totalSuperfluidDelegated.Add(syntheticOsmoAmt)
+	_, totalSuperfluidDelegated, err := q.Keeper.computeSuperfluidDelegatedTokens(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.TotalSuperfluidDelegationsResponse{
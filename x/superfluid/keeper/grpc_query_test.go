@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
@@ -200,3 +201,96 @@ func (suite *KeeperTestSuite) TestGRPCQuerySuperfluidDelegationsDontIncludeUnbon
 	suite.Require().NoError(err)
 	suite.Require().Equal(totalSuperfluidDelegationsRes.TotalDelegations, sdk.NewInt(30000000))
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySuperfluidUnbondings() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(2)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	superfluidDelegations := []superfluidDelegation{
+		{0, 0, 0, 1000000},
+		{0, 1, 1, 1000000},
+		{1, 0, 0, 1000000},
+		{1, 1, 1, 1000000},
+	}
+	_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, superfluidDelegations, denoms)
+
+	// undelegate the first three locks, and redelegate the last one, which is not an unbonding
+	for _, lock := range locks[:3] {
+		err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+		suite.Require().NoError(err)
+	}
+	err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[3].Owner, locks[3].ID, valAddrs[0].String())
+	suite.Require().NoError(err)
+
+	endTime := suite.Ctx.BlockTime().Add(suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime)
+
+	res, err := suite.queryClient.SuperfluidUnbondingsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidUnbondingsByDelegatorRequest{
+		DelegatorAddress: delAddrs[0].String(),
+		Pagination:       &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SuperfluidUnbondingRecord{
+		{
+			LockId:           locks[0].ID,
+			DelegatorAddress: delAddrs[0].String(),
+			ValidatorAddress: valAddrs[0].String(),
+			Amount:           sdk.NewInt64Coin(denoms[0], 1000000),
+			EndTime:          endTime,
+		},
+		{
+			LockId:           locks[1].ID,
+			DelegatorAddress: delAddrs[0].String(),
+			ValidatorAddress: valAddrs[1].String(),
+			Amount:           sdk.NewInt64Coin(denoms[1], 1000000),
+			EndTime:          endTime,
+		},
+	}, res.SuperfluidUnbondingRecords)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000), sdk.NewInt64Coin(denoms[1], 1000000)), res.TotalUnbondingCoins)
+
+	// paginate the unbondings of the delegator
+	res, err = suite.queryClient.SuperfluidUnbondingsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidUnbondingsByDelegatorRequest{
+		DelegatorAddress: delAddrs[0].String(),
+		Pagination:       &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.SuperfluidUnbondingRecords, 1)
+	suite.Require().Equal(locks[0].ID, res.SuperfluidUnbondingRecords[0].LockId)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000), sdk.NewInt64Coin(denoms[1], 1000000)), res.TotalUnbondingCoins)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.SuperfluidUnbondingsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidUnbondingsByDelegatorRequest{
+		DelegatorAddress: delAddrs[0].String(),
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.SuperfluidUnbondingRecords, 1)
+	suite.Require().Equal(locks[1].ID, res.SuperfluidUnbondingRecords[0].LockId)
+	suite.Require().Nil(res.Pagination.NextKey)
+	// the total is only summed up when counting the total
+	suite.Require().True(res.TotalUnbondingCoins.Empty())
+
+	// unbondings of a validator denom pair
+	valDenomRes, err := suite.queryClient.SuperfluidUnbondingsByValidatorDenom(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidUnbondingsByValidatorDenomRequest{
+		ValidatorAddress: valAddrs[0].String(),
+		Denom:            denoms[0],
+		Pagination:       &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(valDenomRes.SuperfluidUnbondingRecords, 2)
+	suite.Require().Equal(locks[0].ID, valDenomRes.SuperfluidUnbondingRecords[0].LockId)
+	suite.Require().Equal(locks[2].ID, valDenomRes.SuperfluidUnbondingRecords[1].LockId)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 2000000)), valDenomRes.TotalUnbondingCoins)
+
+	valDenomRes, err = suite.queryClient.SuperfluidUnbondingsByValidatorDenom(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidUnbondingsByValidatorDenomRequest{
+		ValidatorAddress: valAddrs[1].String(),
+		Denom:            denoms[1],
+		Pagination:       &query.PageRequest{Offset: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(valDenomRes.SuperfluidUnbondingRecords, 0)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denoms[1], 1000000)), valDenomRes.TotalUnbondingCoins)
+}
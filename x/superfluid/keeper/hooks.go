@@ -55,10 +55,24 @@ func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, loc
 func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 }
 
+// an unlocking lock stops auto-compounding, and its rewards are received by its owner again.
 func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	if _, found := h.k.GetAutoCompoundLock(ctx, lockID); !found {
+		return
+	}
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	if err := h.k.stopAutoCompound(ctx, *lock); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
 }
 
 func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.DeleteAllLockAccruedRewards(ctx, lockID)
+	h.k.DeleteAutoCompoundLock(ctx, lockID)
 }
 
 func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
@@ -67,6 +81,36 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+// the split off part of a superfluid lock keeps the lock's superfluid position,
+// as it carries over the lock's synthetic lockups. The split off part of an auto-compounding lock
+// doesn't auto-compound, so its rewards are received by its owner rather than the lock's escrow account.
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	if _, found := h.k.GetAutoCompoundLock(ctx, lockID); found {
+		splitLock, err := h.k.lk.GetLockByID(ctx, splitLockID)
+		if err == nil && splitLock.RewardReceiver() == types.GetAutoCompoundEscrowAddr(lockID).String() {
+			err = h.k.lk.SetLockRewardReceiver(ctx, splitLockID, splitLock.OwnerAddress(), splitLock.OwnerAddress())
+		}
+		if err != nil {
+			h.k.Logger(ctx).Error(err.Error())
+		}
+	}
+	acc, found := h.k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if found {
+		h.k.SetLockIdIntermediaryAccountConnection(ctx, splitLockID, acc)
+	}
+	for _, startHeight := range h.k.GetUnbondingStartHeightsByLockId(ctx, lockID) {
+		h.k.SetUnbondingStartHeight(ctx, splitLockID, startHeight.SynthDenom, startHeight.Height)
+	}
+}
+
+func (h Hooks) OnRewardReceiverChange(ctx sdk.Context, lockID uint64, rewardReceiver sdk.AccAddress) {
+}
+
+// the unbonding start height of a superfluid unbonding is kept as long as its synthetic lockup.
+func (h Hooks) OnSyntheticLockupDeleted(ctx sdk.Context, lockID uint64, synthDenom string) {
+	h.k.DeleteUnbondingStartHeight(ctx, lockID, synthDenom)
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
@@ -102,5 +146,8 @@ func (h Hooks) AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, in
 	if slashFactor.IsZero() {
 		return
 	}
+	if err := h.k.syncValidatorSuperfluidDelegatedTokens(ctx, valAddr); err != nil {
+		h.k.Logger(ctx).Error(fmt.Sprintf("failed to sync superfluid delegated tokens of %s: %s", valAddr, err))
+	}
 	h.k.RefreshIntermediaryDelegationAmounts(ctx)
 }
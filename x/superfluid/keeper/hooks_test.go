@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
 )
 
 func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
@@ -98,6 +103,80 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSuperfluidAfterEpochStartStaleAsset() {
+	suite.SetupTest()
+
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.StaleAssetZeroingEpochs = 2
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// register a superfluid asset for the next pool, which does not exist yet
+	staleDenom := gammtypes.GetPoolShareDenom(2)
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, types.SuperfluidAsset{
+		Denom:     staleDenom,
+		AssetType: types.SuperfluidAssetTypeLPShare,
+	})
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 0, staleDenom, sdk.NewDec(10))
+
+	epochIdentifier := suite.App.SuperfluidKeeper.GetEpochIdentifier(suite.Ctx)
+	runEpoch := func(epoch int64) {
+		epochInfo := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochIdentifier)
+		epochInfo.CurrentEpoch = epoch
+		suite.App.EpochsKeeper.SetEpochInfo(suite.Ctx, epochInfo)
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+		suite.App.SuperfluidKeeper.AfterEpochStartBeginBlock(suite.Ctx)
+	}
+	hasEvent := func(event proto.Message) bool {
+		for _, e := range suite.Ctx.EventManager().Events() {
+			if e.Type == proto.MessageName(event) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// the failing asset is marked stale, and keeps its multiplier
+	runEpoch(1)
+	res, err := suite.querier.StaleAssets(sdk.WrapSDKContext(suite.Ctx), &types.StaleAssetsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.StaleAssets, 1)
+	suite.Require().Equal(staleDenom, res.StaleAssets[0].Denom)
+	suite.Require().Equal(int64(1), res.StaleAssets[0].SinceEpoch)
+	suite.Require().Equal(uint64(1), res.StaleAssets[0].FailedEpochs)
+	suite.Require().NotEmpty(res.StaleAssets[0].LastError)
+	suite.Require().False(res.StaleAssets[0].MultiplierZeroed)
+	suite.Require().True(hasEvent(&types.EventAssetMultiplierUpdateFailed{}))
+	suite.Require().Equal(sdk.NewDec(10), suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, staleDenom))
+
+	// the other assets are still updated
+	for _, record := range suite.App.SuperfluidKeeper.GetAllOsmoEquivalentMultipliers(suite.Ctx) {
+		if record.Denom == denoms[0] {
+			suite.Require().Equal(int64(1), record.EpochNumber)
+		}
+	}
+
+	// the multiplier is zeroed once the asset failed StaleAssetZeroingEpochs epochs in a row
+	runEpoch(2)
+	staleAsset, found := suite.App.SuperfluidKeeper.GetStaleAsset(suite.Ctx, staleDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), staleAsset.SinceEpoch)
+	suite.Require().Equal(uint64(2), staleAsset.FailedEpochs)
+	suite.Require().True(staleAsset.MultiplierZeroed)
+	suite.Require().True(hasEvent(&types.EventAssetMultiplierZeroed{}))
+	suite.Require().True(suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, staleDenom).IsZero())
+
+	// the asset recovers once its pool exists
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	suite.Require().Equal(uint64(2), suite.createGammPool([]string{bondDenom, "foo"}))
+	runEpoch(3)
+	_, found = suite.App.SuperfluidKeeper.GetStaleAsset(suite.Ctx, staleDenom)
+	suite.Require().False(found)
+	suite.Require().True(hasEvent(&types.EventAssetRecovered{}))
+	suite.Require().True(suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, staleDenom).IsPositive())
+}
+
 // func (suite *KeeperTestSuite) TestOnStartUnlock() {
 // 	testCases := []struct {
 // 		name             string
@@ -269,6 +348,8 @@ func (suite *KeeperTestSuite) TestBeforeSlashingUnbondingDelegationHook() {
 			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, tc.superDelegations, denoms)
 			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
 
+			// start unbondings after the infraction height, for them to be slashed
+			suite.Ctx = suite.Ctx.WithBlockHeight(90)
 			for _, lockId := range tc.superUnbondingLockIds {
 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
 				suite.Require().NoError(err)
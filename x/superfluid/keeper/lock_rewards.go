@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	incentivestypes "github.com/osmosis-labs/osmosis/v10/x/incentives/types"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordLockRewards records the superfluid staking rewards distributed to every lock at an epoch,
+// adding them to the rewards accrued by the lock and to the reward history of the epoch.
+// gauges are the superfluid gauges the rewards were distributed from.
+func (k Keeper) recordLockRewards(ctx sdk.Context, epochNumber int64, gauges []incentivestypes.Gauge, lockRewards []incentivestypes.LockRewards) {
+	gaugeValAddrs := make(map[uint64]string, len(gauges))
+	for _, gauge := range gauges {
+		valAddr, err := ValidatorAddressFromSyntheticDenom(gauge.DistributeTo.Denom)
+		if err != nil {
+			continue
+		}
+		gaugeValAddrs[gauge.Id] = valAddr
+	}
+
+	historyEpochs := k.GetParams(ctx).LockRewardHistoryEpochs
+	for _, lockReward := range lockRewards {
+		valAddr, ok := gaugeValAddrs[lockReward.GaugeId]
+		if !ok || lockReward.Coins.IsZero() {
+			continue
+		}
+
+		accrued := k.GetLockAccruedRewards(ctx, lockReward.LockId, valAddr)
+		accrued.Rewards = accrued.Rewards.Add(lockReward.Coins...)
+		k.setLockAccruedRewards(ctx, lockReward.LockId, accrued)
+
+		if historyEpochs > 0 {
+			k.setLockRewardRecord(ctx, lockReward.LockId, types.SuperfluidLockRewardRecord{
+				EpochNumber:      epochNumber,
+				ValidatorAddress: valAddr,
+				Rewards:          lockReward.Coins,
+			})
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSuperfluidLockRewards,
+			sdk.NewAttribute(types.AttributeLockId, sdk.NewUint(lockReward.LockId).String()),
+			sdk.NewAttribute(types.AttributeValidator, valAddr),
+			sdk.NewAttribute(types.AttributeEpochNumber, sdk.NewInt(epochNumber).String()),
+			sdk.NewAttribute(types.AttributeAmount, lockReward.Coins.String()),
+		))
+	}
+
+	k.pruneLockRewardHistory(ctx, epochNumber-int64(historyEpochs))
+}
+
+// GetLockAccruedRewards returns the superfluid staking rewards a lock accrued through the intermediary account of a validator.
+func (k Keeper) GetLockAccruedRewards(ctx sdk.Context, lockId uint64, valAddr string) types.SuperfluidLockRewards {
+	rewards := types.SuperfluidLockRewards{ValidatorAddress: valAddr, Rewards: sdk.NewCoins()}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockAccruedRewards)
+	bz := prefixStore.Get(lockAccruedRewardsKey(lockId, valAddr))
+	if bz == nil {
+		return rewards
+	}
+	err := proto.Unmarshal(bz, &rewards)
+	if err != nil {
+		panic(err)
+	}
+	return rewards
+}
+
+// GetAllLockAccruedRewards returns the superfluid staking rewards a lock accrued, by validator.
+func (k Keeper) GetAllLockAccruedRewards(ctx sdk.Context, lockId uint64) []types.SuperfluidLockRewards {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, append(types.KeyPrefixLockAccruedRewards, sdk.Uint64ToBigEndian(lockId)...))
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allRewards := []types.SuperfluidLockRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		rewards := types.SuperfluidLockRewards{}
+		err := proto.Unmarshal(iterator.Value(), &rewards)
+		if err != nil {
+			panic(err)
+		}
+		allRewards = append(allRewards, rewards)
+	}
+	return allRewards
+}
+
+// GetAllLocksAccruedRewards returns the superfluid staking rewards accrued by every lock, by validator.
+func (k Keeper) GetAllLocksAccruedRewards(ctx sdk.Context) []types.LockAccruedRewards {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockAccruedRewards)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allRewards := []types.LockAccruedRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		rewards := types.SuperfluidLockRewards{}
+		err := proto.Unmarshal(iterator.Value(), &rewards)
+		if err != nil {
+			panic(err)
+		}
+		allRewards = append(allRewards, types.LockAccruedRewards{
+			LockId:  sdk.BigEndianToUint64(iterator.Key()[:8]),
+			Rewards: rewards,
+		})
+	}
+	return allRewards
+}
+
+func (k Keeper) setLockAccruedRewards(ctx sdk.Context, lockId uint64, rewards types.SuperfluidLockRewards) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockAccruedRewards)
+	bz, err := proto.Marshal(&rewards)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(lockAccruedRewardsKey(lockId, rewards.ValidatorAddress), bz)
+}
+
+// DeleteAllLockAccruedRewards deletes the superfluid staking rewards a lock accrued.
+// It is called once the lock is deleted, so that the rewards stay queryable while the lock unlocks.
+func (k Keeper) DeleteAllLockAccruedRewards(ctx sdk.Context, lockId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, append(types.KeyPrefixLockAccruedRewards, sdk.Uint64ToBigEndian(lockId)...))
+
+	iterator := prefixStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// GetLockRewardHistory returns the superfluid staking rewards of a lock at each of the epochs kept in history,
+// by validator.
+func (k Keeper) GetLockRewardHistory(ctx sdk.Context, lockId uint64) []types.SuperfluidLockRewardRecord {
+	store := ctx.KVStore(k.storeKey)
+	curEpoch := k.ek.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx)).CurrentEpoch
+	firstEpoch := curEpoch - int64(k.GetParams(ctx).LockRewardHistoryEpochs) + 1
+	if firstEpoch < 0 {
+		firstEpoch = 0
+	}
+
+	records := []types.SuperfluidLockRewardRecord{}
+	for epoch := firstEpoch; epoch <= curEpoch; epoch++ {
+		prefixStore := prefix.NewStore(store, append(types.KeyPrefixLockRewardHistory, lockRewardRecordKey(epoch, lockId, "")...))
+		iterator := prefixStore.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			record := types.SuperfluidLockRewardRecord{}
+			err := proto.Unmarshal(iterator.Value(), &record)
+			if err != nil {
+				panic(err)
+			}
+			records = append(records, record)
+		}
+		iterator.Close()
+	}
+	return records
+}
+
+// GetAllLocksRewardHistory returns the superfluid staking rewards of every lock at each of the epochs kept in history,
+// by validator.
+func (k Keeper) GetAllLocksRewardHistory(ctx sdk.Context) []types.LockRewardHistoryRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRewardHistory)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	history := []types.LockRewardHistoryRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SuperfluidLockRewardRecord{}
+		err := proto.Unmarshal(iterator.Value(), &record)
+		if err != nil {
+			panic(err)
+		}
+		history = append(history, types.LockRewardHistoryRecord{
+			LockId: sdk.BigEndianToUint64(iterator.Key()[8:16]),
+			Record: record,
+		})
+	}
+	return history
+}
+
+func (k Keeper) setLockRewardRecord(ctx sdk.Context, lockId uint64, record types.SuperfluidLockRewardRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRewardHistory)
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(lockRewardRecordKey(record.EpochNumber, lockId, record.ValidatorAddress), bz)
+}
+
+// pruneLockRewardHistory deletes the lock reward records of the epochs up to lastPrunedEpoch.
+func (k Keeper) pruneLockRewardHistory(ctx sdk.Context, lastPrunedEpoch int64) {
+	if lastPrunedEpoch < 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRewardHistory)
+
+	iterator := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(lastPrunedEpoch+1)))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+func lockAccruedRewardsKey(lockId uint64, valAddr string) []byte {
+	return append(sdk.Uint64ToBigEndian(lockId), []byte(valAddr)...)
+}
+
+func lockRewardRecordKey(epochNumber int64, lockId uint64, valAddr string) []byte {
+	key := append(sdk.Uint64ToBigEndian(uint64(epochNumber)), sdk.Uint64ToBigEndian(lockId)...)
+	return append(key, []byte(valAddr)...)
+}
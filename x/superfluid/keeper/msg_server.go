@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,12 +57,38 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUndelegateResponse{}, err
 }
 
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err != nil {
+		return nil, err
+	}
 
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSuperfluidRedelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
+		sdk.NewAttribute(types.AttributeNewValidator, msg.NewValAddr),
+	))
+	return &types.MsgSuperfluidRedelegateResponse{}, nil
+}
+
+func (server msgServer) SuperfluidPartialUndelegate(goCtx context.Context, msg *types.MsgSuperfluidPartialUndelegate) (*types.MsgSuperfluidPartialUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	splitLockId, err := server.keeper.SuperfluidPartialUndelegate(ctx, msg.Sender, msg.LockId, msg.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSuperfluidPartialUndelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
+		sdk.NewAttribute(types.AttributeSplitLockId, fmt.Sprintf("%d", splitLockId)),
+		sdk.NewAttribute(types.AttributeAmount, msg.Coin.String()),
+	))
+	return &types.MsgSuperfluidPartialUndelegateResponse{SplitLockId: splitLockId}, nil
+}
 
 func (server msgServer) SuperfluidUnbondLock(goCtx context.Context, msg *types.MsgSuperfluidUnbondLock) (
 	*types.MsgSuperfluidUnbondLockResponse, error,
@@ -78,6 +105,21 @@ func (server msgServer) SuperfluidUnbondLock(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUnbondLockResponse{}, err
 }
 
+func (server msgServer) SetLockAutoCompound(goCtx context.Context, msg *types.MsgSetLockAutoCompound) (*types.MsgSetLockAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SetLockAutoCompound(ctx, msg.Sender, msg.LockId, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSetLockAutoCompound,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
+		sdk.NewAttribute(types.AttributeEnabled, strconv.FormatBool(msg.Enabled)),
+	))
+	return &types.MsgSetLockAutoCompoundResponse{}, nil
+}
+
 func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgLockAndSuperfluidDelegate) (*types.MsgLockAndSuperfluidDelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getNativeAssetSpotPrice returns the OSMO spot price of a native superfluid asset in its price pool.
+func (k Keeper) getNativeAssetSpotPrice(ctx sdk.Context, asset types.SuperfluidAsset) (sdk.Dec, error) {
+	if asset.PricePoolId == 0 {
+		return sdk.Dec{}, fmt.Errorf("native superfluid asset %s has no price pool", asset.Denom)
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	spotPrice, err := k.gk.CalculateSpotPrice(ctx, asset.PricePoolId, bondDenom, asset.Denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !spotPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("pool %d has no %s price for %s", asset.PricePoolId, bondDenom, asset.Denom)
+	}
+	return spotPrice, nil
+}
+
+// ValidateNativeAssetPricePool returns an error if the price pool of a native superfluid asset
+// can not price it in OSMO.
+func (k Keeper) ValidateNativeAssetPricePool(ctx sdk.Context, asset types.SuperfluidAsset) error {
+	_, err := k.getNativeAssetSpotPrice(ctx, asset)
+	return err
+}
+
+// SampleNativeAssetPrices adds the spot price held since the last sample to the price record
+// of every native superfluid asset, and samples the current spot price.
+// It is called at every BeginBlock, so that a sample is the price held during the previous block.
+func (k Keeper) SampleNativeAssetPrices(ctx sdk.Context) {
+	for _, asset := range k.GetAllSuperfluidAssets(ctx) {
+		if asset.AssetType != types.SuperfluidAssetTypeNative {
+			continue
+		}
+		spotPrice, err := k.getNativeAssetSpotPrice(ctx, asset)
+		if err != nil {
+			// keep the last sampled price, the multiplier update reports the error
+			k.Logger(ctx).Error(err.Error())
+			continue
+		}
+
+		record, found := k.GetNativeAssetPriceRecord(ctx, asset.Denom)
+		if !found {
+			record = newNativeAssetPriceRecord(ctx, asset.Denom, spotPrice)
+		} else {
+			record = accumulateNativeAssetPrice(ctx, record)
+			record.LastSpotPrice = spotPrice
+		}
+		k.SetNativeAssetPriceRecord(ctx, record)
+	}
+}
+
+// getNativeAssetTwap returns the time-averaged OSMO price of a native superfluid asset since the
+// last update of its multiplier, and restarts the accumulation from now.
+// Without any accumulated time, e.g. for a newly added asset, the current spot price is used.
+func (k Keeper) getNativeAssetTwap(ctx sdk.Context, asset types.SuperfluidAsset) (sdk.Dec, error) {
+	spotPrice, err := k.getNativeAssetSpotPrice(ctx, asset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	twap := spotPrice
+	record, found := k.GetNativeAssetPriceRecord(ctx, asset.Denom)
+	if found {
+		record = accumulateNativeAssetPrice(ctx, record)
+		elapsedMs := record.LastSampleTime.Sub(record.StartTime).Milliseconds()
+		if elapsedMs > 0 {
+			twap = record.PriceTimeSum.QuoInt64(elapsedMs)
+		}
+	}
+	if !twap.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("time-averaged price of %s is not positive", asset.Denom)
+	}
+
+	k.SetNativeAssetPriceRecord(ctx, newNativeAssetPriceRecord(ctx, asset.Denom, spotPrice))
+	return twap, nil
+}
+
+func newNativeAssetPriceRecord(ctx sdk.Context, denom string, spotPrice sdk.Dec) types.NativeAssetPriceRecord {
+	return types.NativeAssetPriceRecord{
+		Denom:          denom,
+		LastSpotPrice:  spotPrice,
+		LastSampleTime: ctx.BlockTime(),
+		PriceTimeSum:   sdk.ZeroDec(),
+		StartTime:      ctx.BlockTime(),
+	}
+}
+
+// accumulateNativeAssetPrice adds the last sampled spot price, weighted by the milliseconds it
+// was held until the block time, to the record.
+func accumulateNativeAssetPrice(ctx sdk.Context, record types.NativeAssetPriceRecord) types.NativeAssetPriceRecord {
+	heldMs := ctx.BlockTime().Sub(record.LastSampleTime).Milliseconds()
+	if heldMs > 0 {
+		record.PriceTimeSum = record.PriceTimeSum.Add(record.LastSpotPrice.MulInt64(heldMs))
+		record.LastSampleTime = ctx.BlockTime()
+	}
+	return record
+}
+
+func (k Keeper) SetNativeAssetPriceRecord(ctx sdk.Context, record types.NativeAssetPriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixNativeAssetPriceRecord)
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set([]byte(record.Denom), bz)
+}
+
+// GetNativeAssetPriceRecord returns the price record of the native superfluid asset, and a bool if found / not found.
+func (k Keeper) GetNativeAssetPriceRecord(ctx sdk.Context, denom string) (types.NativeAssetPriceRecord, bool) {
+	record := types.NativeAssetPriceRecord{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixNativeAssetPriceRecord)
+	bz := prefixStore.Get([]byte(denom))
+	if bz == nil {
+		return record, false
+	}
+	err := proto.Unmarshal(bz, &record)
+	if err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+func (k Keeper) GetAllNativeAssetPriceRecords(ctx sdk.Context) []types.NativeAssetPriceRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixNativeAssetPriceRecord)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.NativeAssetPriceRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.NativeAssetPriceRecord{}
+
+		err := proto.Unmarshal(iterator.Value(), &record)
+		if err != nil {
+			panic(err)
+		}
+
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) DeleteNativeAssetPriceRecord(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixNativeAssetPriceRecord)
+	prefixStore.Delete([]byte(denom))
+}
@@ -1,18 +1,18 @@
 package keeper
 
 import (
-	"time"
-
 	"github.com/osmosis-labs/osmosis/v10/osmoutils"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // SlashLockupsForValidatorSlash should be called before the validator at valAddr is slashed.
 // This function is responsible for inspecting every intermediate account to valAddr.
-// For each intermediate account IA, it slashes every constituent delegation behind IA.
-// Furthermore, if the infraction height is sufficiently old, slashes unbondings
+// For each intermediate account IA, it slashes every constituent delegation behind IA,
+// and every constituent unbonding that started at or after the infraction height.
 // Note: Based on sdk.staking.Slash function review, slashed tokens are burnt not sent to community pool
 // we ignore that, and send the underliyng tokens to the community pool anyway.
 func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec) {
@@ -34,31 +34,34 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 	// and then all of its unbonding delegations.
 	// We do these slashes as burns.
 	for _, acc := range accs {
-		locks := k.lk.GetLocksLongerThanDurationDenom(ctx, acc.Denom, time.Second)
-		for _, lock := range locks {
-			// slashing only applies to synthetic lockup amount
-			synthLock, err := k.lk.GetSyntheticLockup(ctx, lock.ID, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
-			// synth lock doesn't exist for bonding
-			if err != nil {
-				synthLock, err = k.lk.GetSyntheticLockup(ctx, lock.ID, unstakingSyntheticDenom(acc.Denom, acc.ValAddr))
-				// synth lock doesn't exist for unbonding
-				// => no superfluid staking on this lock ID, so continue
-				if err != nil {
-					continue
-				}
+		bondedLocks, unbondingLocks := k.getIntermediaryAccountLocks(ctx, acc)
+		for _, lock := range bondedLocks {
+			k.slashLock(ctx, lock, slashFactor)
+		}
+		for _, lock := range unbondingLocks {
+			// like staking unbonding delegations, unbondings that started before the infraction
+			// were not backing the validator when it misbehaved, so they are not slashed.
+			// Unbondings without a start height started before it was recorded, and are slashed.
+			startHeight, found := k.GetUnbondingStartHeight(ctx, lock.ID, unstakingSyntheticDenom(acc.Denom, acc.ValAddr))
+			if found && startHeight < infractionHeight {
+				continue
 			}
-
-			// slash the lock whether its bonding or unbonding.
-			// this overslashes unbondings that started unbonding before the slash infraction,
-			// but this seems to be an acceptable trade-off based upon choices taken in the SDK.
-			k.slashSynthLock(ctx, synthLock, slashFactor)
+			k.slashLock(ctx, lock, slashFactor)
 		}
 	}
 }
 
-func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.SyntheticLock, slashFactor sdk.Dec) {
+// getIntermediaryAccountLocks returns the locks superfluid bonded and unbonding through the intermediary account.
+// They are found through the lockup refs of the account's synthetic denoms,
+// rather than by going over every lock of the account's denom.
+func (k Keeper) getIntermediaryAccountLocks(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) (bondedLocks, unbondingLocks []lockuptypes.PeriodLock) {
+	bondedLocks = k.lk.GetLocksLongerThanDurationDenom(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr), 0)
+	unbondingLocks = k.lk.GetLocksLongerThanDurationDenom(ctx, unstakingSyntheticDenom(acc.Denom, acc.ValAddr), 0)
+	return bondedLocks, unbondingLocks
+}
+
+func (k Keeper) slashLock(ctx sdk.Context, lock lockuptypes.PeriodLock, slashFactor sdk.Dec) {
 	// Only single token lock is allowed here
-	lock, _ := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
 	slashAmt := lock.Coins[0].Amount.ToDec().Mul(slashFactor).TruncateInt()
 	slashCoins := sdk.NewCoins(sdk.NewCoin(lock.Coins[0].Denom, slashAmt))
 	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
@@ -67,3 +70,61 @@ func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.Synthetic
 		return err
 	})
 }
+
+// SetUnbondingStartHeight records the height at which the superfluid unbonding of the lock
+// through the given synthetic denom started.
+func (k Keeper) SetUnbondingStartHeight(ctx sdk.Context, lockId uint64, synthDenom string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnbondingStartHeight)
+	prefixStore.Set(unbondingStartHeightKey(lockId, synthDenom), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetUnbondingStartHeight returns the height at which the superfluid unbonding of the lock
+// through the given synthetic denom started, and a bool if found / not found.
+func (k Keeper) GetUnbondingStartHeight(ctx sdk.Context, lockId uint64, synthDenom string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnbondingStartHeight)
+	bz := prefixStore.Get(unbondingStartHeightKey(lockId, synthDenom))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// GetUnbondingStartHeightsByLockId returns the start heights of the superfluid unbondings of the lock.
+func (k Keeper) GetUnbondingStartHeightsByLockId(ctx sdk.Context, lockId uint64) []types.SuperfluidUnbondingStartHeight {
+	return k.getUnbondingStartHeightsFromPrefix(ctx, sdk.Uint64ToBigEndian(lockId))
+}
+
+func (k Keeper) GetAllUnbondingStartHeights(ctx sdk.Context) []types.SuperfluidUnbondingStartHeight {
+	return k.getUnbondingStartHeightsFromPrefix(ctx, nil)
+}
+
+func (k Keeper) getUnbondingStartHeightsFromPrefix(ctx sdk.Context, keyPrefix []byte) []types.SuperfluidUnbondingStartHeight {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnbondingStartHeight)
+
+	iterator := sdk.KVStorePrefixIterator(prefixStore, keyPrefix)
+	defer iterator.Close()
+
+	startHeights := []types.SuperfluidUnbondingStartHeight{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		startHeights = append(startHeights, types.SuperfluidUnbondingStartHeight{
+			LockId:     sdk.BigEndianToUint64(key[:8]),
+			Height:     int64(sdk.BigEndianToUint64(iterator.Value())),
+			SynthDenom: string(key[8:]),
+		})
+	}
+	return startHeights
+}
+
+func (k Keeper) DeleteUnbondingStartHeight(ctx sdk.Context, lockId uint64, synthDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnbondingStartHeight)
+	prefixStore.Delete(unbondingStartHeightKey(lockId, synthDenom))
+}
+
+func unbondingStartHeightKey(lockId uint64, synthDenom string) []byte {
+	return append(sdk.Uint64ToBigEndian(lockId), []byte(synthDenom)...)
+}
@@ -188,3 +188,88 @@ func (suite *KeeperTestSuite) TestSlashLockupsForUnbondingDelegationSlash() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSlashLockupsForInfractionHeight() {
+	testCases := []struct {
+		name             string
+		infractionOffset int64
+		expSlashed       bool
+	}{
+		{
+			"unbonding started before infraction height is not slashed",
+			1,
+			false,
+		},
+		{
+			"unbonding started at infraction height is slashed",
+			0,
+			true,
+		},
+		{
+			"unbonding started after infraction height is slashed",
+			-1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			delAddrs := CreateRandomAccounts(2)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// setup a bonded and an unbonding superfluid delegation
+			_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+			bondedLock, unbondingLock := locks[0], locks[1]
+
+			suite.Ctx = suite.Ctx.WithBlockHeight(100)
+			err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, unbondingLock.Owner, unbondingLock.ID)
+			suite.Require().NoError(err)
+
+			synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, unbondingLock.ID)
+			suite.Require().Len(synthLocks, 1)
+			synthDenom := synthLocks[0].SynthDenom
+			startHeight, found := suite.App.SuperfluidKeeper.GetUnbondingStartHeight(suite.Ctx, unbondingLock.ID, synthDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(int64(100), startHeight)
+
+			// slash validator
+			suite.Ctx = suite.Ctx.WithBlockHeight(102)
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			consAddr, err := validator.GetConsAddr()
+			suite.Require().NoError(err)
+			power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+			slashFactor := sdk.NewDecWithPrec(5, 2)
+			// Note: this calls BeforeValidatorSlashed hook
+			suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, 100+tc.infractionOffset, power, slashFactor)
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// bonded locks are always slashed
+			gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, bondedLock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+
+			gotLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, unbondingLock.ID)
+			suite.Require().NoError(err)
+			if tc.expSlashed {
+				suite.Require().Equal(sdk.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+			} else {
+				suite.Require().Equal(sdk.NewInt(1000000).String(), gotLock.Coins[0].Amount.String())
+			}
+
+			// the start height is removed along with the synthetic lockup once the unbonding completes
+			suite.Ctx = suite.Ctx.WithBlockTime(synthLocks[0].EndTime)
+			suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+			_, found = suite.App.SuperfluidKeeper.GetUnbondingStartHeight(suite.Ctx, unbondingLock.ID, synthDenom)
+			suite.Require().False(found)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	appparams "github.com/osmosis-labs/osmosis/v10/app/params"
 	"github.com/osmosis-labs/osmosis/v10/osmoutils"
 	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
@@ -29,6 +30,9 @@ func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.Superflui
 	return refreshedAmount
 }
 
+// RefreshIntermediaryDelegationAmounts mints or burns the osmo delegated by every intermediary account,
+// so that it matches the current osmo value of the synthetic locks of the account.
+// Minting is capped by the superfluid caps, the rest is minted by a later refresh once there is headroom.
 func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 	// iterate over every (denom, validator) pair
 	accs := k.GetAllIntermediaryAccounts(ctx)
@@ -61,8 +65,14 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 		refreshedAmount := k.GetExpectedDelegationAmount(ctx, acc)
 
 		if refreshedAmount.GT(currentAmount) {
-			adjustment := refreshedAmount.Sub(currentAmount)
-			err = k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
+			adjustment, err := k.capSuperfluidDelegationAmount(ctx, acc.ValAddr, refreshedAmount.Sub(currentAmount))
+			if err == nil && !adjustment.Equal(refreshedAmount.Sub(currentAmount)) {
+				k.Logger(ctx).Info(fmt.Sprintf("superfluid refresh of %s capped from %s to %s",
+					mAddr.String(), refreshedAmount.Sub(currentAmount), adjustment))
+			}
+			if err == nil && adjustment.IsPositive() {
+				err = k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
+			}
 			if err != nil {
 				ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
 			}
@@ -93,11 +103,18 @@ func (k Keeper) IncreaseSuperfluidDelegation(ctx sdk.Context, lockID uint64, amo
 
 	// mint OSMO token based on TWAP of locked denom to denom module account
 	osmoAmt := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, amount.AmountOf(acc.Denom))
+
+	// tokens added to the lock can't be rejected here, so the part over the superfluid caps
+	// is left undelegated, to be minted by a later refresh once there is headroom.
+	osmoAmt, err := k.capSuperfluidDelegationAmount(ctx, acc.ValAddr, osmoAmt)
+	if err != nil {
+		return err
+	}
 	if osmoAmt.IsZero() {
 		return nil
 	}
 
-	err := k.mintOsmoTokensAndDelegate(ctx, osmoAmt, acc)
+	err = k.mintOsmoTokensAndDelegate(ctx, osmoAmt, acc)
 	if err != nil {
 		return err
 	}
@@ -121,8 +138,7 @@ func (k Keeper) validateLockForSFDelegate(ctx sdk.Context, lock *lockuptypes.Per
 	if err != nil {
 		return err
 	}
-	defaultSuperfluidAsset := types.SuperfluidAsset{}
-	if k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom) == defaultSuperfluidAsset {
+	if k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom).Denom == "" {
 		return types.ErrNonSuperfluidAsset
 	}
 
@@ -195,6 +211,10 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 	if amount.IsZero() {
 		return types.ErrOsmoEquivalentZeroNotAllowed
 	}
+	err = k.validateSuperfluidDelegationCaps(ctx, valAddr, amount)
+	if err != nil {
+		return err
+	}
 
 	return k.mintOsmoTokensAndDelegate(ctx, amount, acc)
 }
@@ -215,6 +235,10 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	if !found {
 		return types.ErrNotSuperfluidUsedLockup
 	}
+	amount, err := k.getLockUndelegationAmount(ctx, intermediaryAcc, lockedCoin)
+	if err != nil {
+		return err
+	}
 	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
 
 	// Delete the old synthetic lockup, and create a new synthetic lockup representing the unstaking
@@ -225,7 +249,6 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	}
 
 	// undelegate this lock's delegation amount, and burn the minted osmo.
-	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount)
 	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
 	if err != nil {
 		return err
@@ -235,6 +258,153 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
 }
 
+// SuperfluidPartialUndelegate splits coin off a superfluid delegated lock into a new lock, which stays
+// connected to the lock's intermediary account, and superfluid undelegates the new lock.
+// The remainder of the lock stays delegated. Returns the id of the new lock.
+func (k Keeper) SuperfluidPartialUndelegate(ctx sdk.Context, sender string, lockID uint64, coin sdk.Coin) (uint64, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return 0, err
+	}
+	if coin.Denom != lock.Coins[0].Denom || !coin.IsPositive() || !coin.Amount.LT(lock.Coins[0].Amount) {
+		return 0, types.ErrInvalidPartialAmount
+	}
+	if _, found := k.GetIntermediaryAccountFromLockId(ctx, lockID); !found {
+		return 0, types.ErrNotSuperfluidUsedLockup
+	}
+
+	splitLock, err := k.lk.SplitLock(ctx, lockID, sdk.NewCoins(coin))
+	if err != nil {
+		return 0, err
+	}
+	return splitLock.ID, k.SuperfluidUndelegate(ctx, sender, splitLock.ID)
+}
+
+// SuperfluidRedelegate moves the superfluid delegation of a lock to a new validator.
+// The lock's staking synthetic lockup at the old validator is replaced by an unstaking one,
+// which keeps the lock slashable by the old validator for the staking unbonding time,
+// and a staking synthetic lockup is created at the new validator.
+// Like staking redelegations, a lock being redelegated can't be redelegated again until
+// the redelegation completes, and redelegations between a validator pair are limited to
+// the staking MaxEntries param.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return err
+	}
+	lockedCoin := lock.Coins[0]
+
+	oldAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	_, err = k.validateValAddrForDelegate(ctx, newValAddr)
+	if err != nil {
+		return err
+	}
+	if oldAcc.ValAddr == newValAddr {
+		return types.ErrSameValidatorRedelegation
+	}
+
+	// a lock with an unstaking synthetic lockup on top of its delegation is being redelegated.
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		if synthLock.IsUnlocking() {
+			return types.ErrTransitiveRedelegation
+		}
+	}
+
+	entries := uint32(0)
+	for _, redelegation := range k.GetSuperfluidRedelegations(ctx, lock.OwnerAddress()) {
+		if redelegation.SrcValidatorAddress == oldAcc.ValAddr && redelegation.DstValidatorAddress == newValAddr {
+			entries++
+		}
+	}
+	if entries >= k.sk.GetParams(ctx).MaxEntries {
+		return types.ErrMaxRedelegationEntries
+	}
+
+	// undelegate from the old validator, leaving an unstaking synthetic lockup behind.
+	undelegationAmount, err := k.getLockUndelegationAmount(ctx, oldAcc, lockedCoin)
+	if err != nil {
+		return err
+	}
+	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+	err = k.lk.DeleteSyntheticLockup(ctx, lockID, stakingSyntheticDenom(lockedCoin.Denom, oldAcc.ValAddr))
+	if err != nil {
+		return err
+	}
+	err = k.forceUndelegateAndBurnOsmoTokens(ctx, undelegationAmount, oldAcc)
+	if err != nil {
+		return err
+	}
+	err = k.createSyntheticLockup(ctx, lockID, oldAcc, unlockingStatus)
+	if err != nil {
+		return err
+	}
+
+	// delegate to the new validator.
+	newAcc, err := k.GetOrCreateIntermediaryAccount(ctx, lockedCoin.Denom, newValAddr)
+	if err != nil {
+		return err
+	}
+	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, newAcc)
+	err = k.createSyntheticLockup(ctx, lockID, newAcc, bondedStatus)
+	if err != nil {
+		return err
+	}
+
+	amount := k.GetSuperfluidOSMOTokens(ctx, newAcc.Denom, lockedCoin.Amount)
+	if amount.IsZero() {
+		return types.ErrOsmoEquivalentZeroNotAllowed
+	}
+	err = k.validateSuperfluidDelegationCaps(ctx, newValAddr, amount)
+	if err != nil {
+		return err
+	}
+	return k.mintOsmoTokensAndDelegate(ctx, amount, newAcc)
+}
+
+// GetSuperfluidRedelegations returns the superfluid redelegations in progress of a delegator.
+// A lock is being redelegated when it has an unstaking synthetic lockup while still being
+// connected to the intermediary account of another validator.
+func (k Keeper) GetSuperfluidRedelegations(ctx sdk.Context, delAddr sdk.AccAddress) []types.SuperfluidRedelegationRecord {
+	records := []types.SuperfluidRedelegationRecord{}
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByAddr(ctx, delAddr) {
+		if !synthLock.IsUnlocking() {
+			continue
+		}
+		acc, found := k.GetIntermediaryAccountFromLockId(ctx, synthLock.UnderlyingLockId)
+		if !found {
+			continue
+		}
+		srcValAddr, err := ValidatorAddressFromSyntheticDenom(synthLock.SynthDenom)
+		if err != nil || srcValAddr == acc.ValAddr {
+			continue
+		}
+		lock, err := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
+		if err != nil {
+			continue
+		}
+		records = append(records, types.SuperfluidRedelegationRecord{
+			LockId:              lock.ID,
+			DelegatorAddress:    delAddr.String(),
+			SrcValidatorAddress: srcValAddr,
+			DstValidatorAddress: acc.ValAddr,
+			Amount:              sdk.NewCoin(acc.Denom, lock.Coins.AmountOf(acc.Denom)),
+			CompletionTime:      synthLock.EndTime,
+		})
+	}
+	return records
+}
+
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
 	lock, err := k.lk.GetLockByID(ctx, underlyingLockId)
 	if err != nil {
@@ -244,12 +414,16 @@ func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, s
 	if err != nil {
 		return err
 	}
+	// a lock that was redelegated before being undelegated has an unstaking synthetic lockup
+	// on both validators.
 	synthLocks := k.lk.GetAllSyntheticLockupsByLockup(ctx, underlyingLockId)
-	if len(synthLocks) != 1 {
+	if len(synthLocks) == 0 {
 		return types.ErrNotSuperfluidUsedLockup
 	}
-	if !synthLocks[0].IsUnlocking() {
-		return types.ErrBondingLockupNotSupported
+	for _, synthLock := range synthLocks {
+		if !synthLock.IsUnlocking() {
+			return types.ErrBondingLockupNotSupported
+		}
 	}
 	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
 }
@@ -296,11 +470,34 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount sdk.Int, i
 		_, err = k.sk.Delegate(cacheCtx,
 			intermediaryAccount.GetAccAddress(),
 			osmoAmount, stakingtypes.Unbonded, validator, true)
-		return err
+		if err != nil {
+			return err
+		}
+
+		k.addSuperfluidDelegatedTokens(cacheCtx, intermediaryAccount.ValAddr, osmoAmount)
+		return nil
 	})
 	return err
 }
 
+// getLockUndelegationAmount returns the osmo to undelegate from the intermediary account when a lock
+// connected to it stops being superfluid delegated. It is the osmo value of the lock, but at most the lock's
+// share of the account's delegation, which is lower than the value of its locks when the superfluid caps
+// cut the minted osmo. It must be called while the lock's staking synthetic lockup still exists.
+func (k Keeper) getLockUndelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, lockedCoin sdk.Coin) (sdk.Int, error) {
+	amount := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, lockedCoin.Amount)
+
+	delegated, err := k.GetIntermediaryAccountDelegatedTokens(ctx, acc)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	totalLocked := k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
+	if totalLocked.IsPositive() {
+		delegated = delegated.Mul(lockedCoin.Amount).Quo(totalLocked)
+	}
+	return sdk.MinInt(amount, delegated), nil
+}
+
 // force undelegate osmoAmount worth of delegation shares from delegations between intermediary account and valAddr
 // We take the returned tokens, and then immediately burn them.
 func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
@@ -339,6 +536,7 @@ func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 		bondDenom := k.sk.BondDenom(cacheCtx)
 		k.bk.AddSupplyOffset(cacheCtx, bondDenom, undelegatedCoins.AmountOf(bondDenom))
 
+		k.addSuperfluidDelegatedTokens(cacheCtx, intermediaryAcc.ValAddr, undelegatedCoins.AmountOf(bondDenom).Neg())
 		return err
 	})
 
@@ -361,66 +559,94 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 	return k.sk.TotalBondedTokens(ctx)
 }
 
-// IterateDelegations implements govtypes.StakingKeeper
-// Iterates through staking keeper's delegations, and then all of the superfluid delegations.
-func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
-	// call the callback with the non-superfluid delegations
-	var index int64
-	k.sk.IterateDelegations(ctx, delegator, func(i int64, delegation stakingtypes.DelegationI) (stop bool) {
-		index = i
-		return fn(i, delegation)
-	})
-
-	synthlocks := k.lk.GetAllSyntheticLockupsByAddr(ctx, delegator)
-	for i, lock := range synthlocks {
-		// get locked coin from the lock ID
-		interim, ok := k.GetIntermediaryAccountFromLockId(ctx, lock.UnderlyingLockId)
-		if !ok {
+// GetSuperfluidDelegationRecords returns the superfluid delegations of a delegator, one for each
+// of its locks superfluid delegated to a validator, along with their osmo equivalent staked amount.
+func (k Keeper) GetSuperfluidDelegationRecords(ctx sdk.Context, delAddr sdk.AccAddress) ([]types.SuperfluidDelegationRecord, error) {
+	records := []types.SuperfluidDelegationRecord{}
+	for _, syntheticLock := range k.lk.GetAllSyntheticLockupsByAddr(ctx, delAddr) {
+		// don't include unbonding delegations
+		if syntheticLock.IsUnlocking() {
 			continue
 		}
 
-		lock, err := k.lk.GetLockByID(ctx, lock.UnderlyingLockId)
+		periodLock, err := k.lk.GetLockByID(ctx, syntheticLock.UnderlyingLockId)
 		if err != nil {
-			ctx.Logger().Error("lockup retrieval failed with underlying lock", "Lock", lock, "Error", err)
-			continue
+			return nil, err
 		}
-
-		coin, err := lock.SingleCoin()
+		valAddr, err := ValidatorAddressFromSyntheticDenom(syntheticLock.SynthDenom)
 		if err != nil {
-			ctx.Logger().Error("lock fails to meet expected invariant, it contains multiple coins", "Lock", lock, "Error", err)
-			continue
+			return nil, err
 		}
 
-		// get osmo-equivalent token amount
-		amount := k.GetSuperfluidOSMOTokens(ctx, interim.Denom, coin.Amount)
+		baseDenom := periodLock.Coins.GetDenomByIndex(0)
+		lockedCoins := sdk.NewCoin(baseDenom, periodLock.GetCoins().AmountOf(baseDenom))
 
-		// get validator shares equivalent to the token amount
-		valAddr, err := sdk.ValAddressFromBech32(interim.ValAddr)
+		// Find how many osmo tokens this delegation is worth at superfluids current risk adjustment
+		// and twap of the denom.
+		equivalentAmount := k.GetSuperfluidOSMOTokens(ctx, baseDenom, lockedCoins.Amount)
+		coin := sdk.NewCoin(appparams.BaseCoinUnit, equivalentAmount)
+
+		records = append(records, types.SuperfluidDelegationRecord{
+			DelegatorAddress:       delAddr.String(),
+			ValidatorAddress:       valAddr,
+			DelegationAmount:       lockedCoins,
+			EquivalentStakedAmount: &coin,
+		})
+	}
+	return records, nil
+}
+
+// IterateDelegations implements govtypes.StakingKeeper
+// Iterates through staking keeper's delegations, and then all of the superfluid delegation records,
+// so that superfluid stakers can override the vote of the validators they delegate to, as governance
+// deducts their delegations from the validators' inherited voting power.
+// Each superfluid delegation gets the share of its intermediary account's delegation proportional to
+// its delegation amount, so that the intermediary account's shares are split among its delegators.
+func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+	// call the callback with the non-superfluid delegations
+	var index int64
+	stopped := false
+	k.sk.IterateDelegations(ctx, delegator, func(i int64, delegation stakingtypes.DelegationI) (stop bool) {
+		index = i + 1
+		stopped = fn(i, delegation)
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	records, err := k.GetSuperfluidDelegationRecords(ctx, delegator)
+	if err != nil {
+		ctx.Logger().Error("failed to get superfluid delegation records", "Delegator", delegator, "Error", err)
+		return
+	}
+	for _, record := range records {
+		valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
 		if err != nil {
-			ctx.Logger().Error("failed to decode validator address", "Intermediary", interim.ValAddr, "LockID", lock.ID, "Error", err)
+			ctx.Logger().Error("failed to decode validator address", "Validator", record.ValidatorAddress, "Error", err)
 			continue
 		}
 
-		validator, found := k.sk.GetValidator(ctx, valAddr)
+		denom := record.DelegationAmount.Denom
+		intermediaryAccAddr := types.GetSuperfluidIntermediaryAccountAddr(denom, record.ValidatorAddress)
+		intermediaryDelegation, found := k.sk.GetDelegation(ctx, intermediaryAccAddr, valAddr)
 		if !found {
-			ctx.Logger().Error("validator does not exist for lock", "Validator", valAddr, "LockID", lock.ID)
 			continue
 		}
-
-		shares, err := validator.SharesFromTokens(amount)
-		if err != nil {
-			// tokens are not valid. continue.
+		totalLocked := k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(denom, record.ValidatorAddress))
+		if !totalLocked.IsPositive() {
 			continue
 		}
+		shares := intermediaryDelegation.Shares.MulInt(record.DelegationAmount.Amount).QuoInt(totalLocked)
 
-		// construct delegation and call callback
 		delegation := stakingtypes.Delegation{
 			DelegatorAddress: delegator.String(),
-			ValidatorAddress: interim.ValAddr,
+			ValidatorAddress: record.ValidatorAddress,
 			Shares:           shares,
 		}
-
-		// if valid delegation has been found, increment delegation index
-		fn(index+int64(i), delegation)
+		if fn(index, delegation) {
+			return
+		}
+		index++
 	}
 }
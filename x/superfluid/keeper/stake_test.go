@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -437,6 +438,99 @@ func (suite *KeeperTestSuite) TestSuperfluidUndelegate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSuperfluidPartialUndelegate() {
+	testCases := []struct {
+		name          string
+		undelegateAll bool
+		coin          func(denom string) sdk.Coin
+		expErr        error
+	}{
+		{
+			"partial undelegation",
+			false,
+			func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 400000) },
+			nil,
+		},
+		{
+			"partial undelegation of the whole lock",
+			false,
+			func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 1000000) },
+			types.ErrInvalidPartialAmount,
+		},
+		{
+			"partial undelegation of another denom",
+			false,
+			func(denom string) sdk.Coin { return sdk.NewInt64Coin("foo", 400000) },
+			types.ErrInvalidPartialAmount,
+		},
+		{
+			"partial undelegation of an undelegated lock",
+			true,
+			func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 400000) },
+			types.ErrNotSuperfluidUsedLockup,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			delAddrs := CreateRandomAccounts(1)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+			acc, lock := intermediaryAccs[0], locks[0]
+			if tc.undelegateAll {
+				err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+				suite.Require().NoError(err)
+			}
+
+			splitLockId, err := suite.App.SuperfluidKeeper.SuperfluidPartialUndelegate(suite.Ctx, lock.Owner, lock.ID, tc.coin(denoms[0]))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the remainder of the lock stays delegated
+			remainingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(600000), remainingLock.Coins.AmountOf(denoms[0]))
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, keeper.StakingSyntheticDenom(denoms[0], acc.ValAddr))
+			suite.Require().NoError(err)
+			suite.Require().Equal(acc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+
+			// the split lock is superfluid undelegating
+			splitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitLockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(400000), splitLock.Coins.AmountOf(denoms[0]))
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, splitLockId, keeper.StakingSyntheticDenom(denoms[0], acc.ValAddr))
+			suite.Require().Error(err)
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, splitLockId, keeper.UnstakingSyntheticDenom(denoms[0], acc.ValAddr))
+			suite.Require().NoError(err)
+			suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, splitLockId).Empty())
+
+			// the intermediary account only delegates for the remainder
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddrs[0])
+			suite.Require().True(found)
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			expAmount := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(600000))
+			suite.Require().Equal(expAmount, validator.TokensFromShares(delegation.Shares).RoundInt())
+			suite.Require().Equal(expAmount, suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, acc))
+
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// the split lock can be unbonded
+			err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, splitLockId, lock.Owner)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 // TestSuperfluidUnbondLock tests the following.
 // 		1. test SuperfluidUnbondLock does not work before undelegation
 // 		2. test SuperfluidUnbondLock makes underlying lock start unlocking
@@ -527,155 +621,167 @@ func (suite *KeeperTestSuite) TestSuperfluidUnbondLock() {
 	}
 }
 
-// func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		suite.Run(tc.name, func() {
-// 			suite.SetupTest()
-
-// 			poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			suite.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := suite.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					suite.Require().Error(err)
-// 					continue
-// 				}
-// 				suite.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
-// 				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, expAcc.GetAccAddress())
-// 				suite.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				suite.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gotAcc.GaugeId)
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				suite.Require().Equal(gauge.IsPerpetual, true)
-// 				suite.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				suite.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				suite.Require().Equal(gauge.StartTime, suite.Ctx.BlockTime())
-// 				suite.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				suite.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				suite.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
-// 				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				suite.Require().True(found)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := suite.Ctx.CacheContext()
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				suite.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
+func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name                    string
+		validatorStats          []stakingtypes.BondStatus
+		delegatorNumber         int
+		superDelegations        []superfluidDelegation
+		maxEntries              uint32
+		superRedelegations      []superfluidRedelegation
+		expSuperRedelegationErr []error
+	}{
+		{
+			"with single superfluid delegation with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			7,
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]error{nil},
+		},
+		{
+			"with multiple superfluid delegations with multiple redelegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			2,
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			7,
+			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]error{nil, nil},
+		},
+		{
+			"try redelegating back from new validator to original validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			7,
+			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]error{nil, types.ErrTransitiveRedelegation},
+		},
+		{
+			"redelegations over the staking max entries",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {0, 0, 1, 1000000}},
+			1,
+			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]error{nil, types.ErrMaxRedelegationEntries},
+		},
+		{
+			"not available lock id redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			7,
+			[]superfluidRedelegation{{2, 0, 1}}, // lock2 => val0 -> val1
+			[]error{lockuptypes.ErrLockupNotFound},
+		},
+		{
+			"redelegation for same validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			7,
+			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
+			[]error{types.ErrSameValidatorRedelegation},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			stakingParams := suite.App.StakingKeeper.GetParams(suite.Ctx)
+			stakingParams.MaxEntries = tc.maxEntries
+			suite.App.StakingKeeper.SetParams(suite.Ctx, stakingParams)
+
+			delAddrs := CreateRandomAccounts(tc.delegatorNumber)
+			valAddrs := suite.SetupValidators(tc.validatorStats)
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, tc.superDelegations, denoms)
+			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			// execute redelegation and check changes on store
+			redelegatedLockIds := []uint64{}
+			for index, srd := range tc.superRedelegations {
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+				oldValAddr := valAddrs[srd.oldValIndex].String()
+				newValAddr := valAddrs[srd.newValIndex].String()
+
+				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, newValAddr)
+				if tc.expSuperRedelegationErr[index] != nil {
+					suite.Require().ErrorIs(err, tc.expSuperRedelegationErr[index])
+					continue
+				}
+				suite.Require().NoError(err)
+				redelegatedLockIds = append(redelegatedLockIds, srd.lockId)
+				denom := lock.Coins[0].Denom
+
+				// check previous validator bonding synthetic lockup deletion
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, oldValAddr))
+				suite.Require().Error(err)
+
+				// check unbonding synthetic lockup creation on the previous validator
+				unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(denom, oldValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(unbondingDuration))
+
+				// check bonding synthetic lockup creation on the new validator
+				synthLock, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, newValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.EndTime, time.Time{})
+
+				// check lockID connection with the new intermediary account and its delegation
+				expAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId))
+				suite.Require().Equal(newValAddr, expAcc.ValAddr)
+				suite.Require().Equal(denom, expAcc.Denom)
+				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
+				suite.Require().True(found)
+
+				// check redelegation query
+				res, err := suite.queryClient.SuperfluidRedelegationsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidRedelegationsByDelegatorRequest{
+					DelegatorAddress: lock.Owner,
+				})
+				suite.Require().NoError(err)
+				suite.Require().Contains(res.SuperfluidRedelegationRecords, types.SuperfluidRedelegationRecord{
+					LockId:              srd.lockId,
+					DelegatorAddress:    lock.Owner,
+					SrcValidatorAddress: oldValAddr,
+					DstValidatorAddress: newValAddr,
+					Amount:              lock.Coins[0],
+					CompletionTime:      suite.Ctx.BlockTime().Add(unbondingDuration),
+				})
+
+				// redelegations are not undelegations
+				undelegationsRes, err := suite.queryClient.SuperfluidUndelegationsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidUndelegationsByDelegatorRequest{
+					DelegatorAddress: lock.Owner,
+				})
+				suite.Require().NoError(err)
+				suite.Require().Empty(undelegationsRes.SuperfluidDelegationRecords)
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// redelegated locks can still be undelegated and unbonded
+			for _, lockId := range redelegatedLockIds {
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+				suite.Require().NoError(err)
+				err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lockId)
+				suite.Require().NoError(err)
+				err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lockId, lock.Owner)
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
 
 func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	testCases := []struct {
@@ -1040,3 +1146,76 @@ func (suite *KeeperTestSuite) TestSuperfluidDelegationGovernanceVoting() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSuperfluidVoteOverride() {
+	testCases := []struct {
+		name          string
+		delegatorVote govtypes.WeightedVoteOptions
+	}{
+		{
+			"superfluid delegator doesn't vote",
+			nil,
+		},
+		{
+			"superfluid delegator votes",
+			govtypes.NewNonSplitVoteOption(govtypes.OptionNo),
+		},
+		{
+			"superfluid delegator weighted votes",
+			govtypes.WeightedVoteOptions{
+				{Option: govtypes.OptionNo, Weight: sdk.NewDecWithPrec(6, 1)},
+				{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(4, 1)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			delAddrs := CreateRandomAccounts(1)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {0, 0, 1, 1000000}}, denoms)
+
+			proposal, err := suite.App.GovKeeper.SubmitProposal(suite.Ctx, govtypes.NewTextProposal("title", "description"))
+			suite.Require().NoError(err)
+			suite.App.GovKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+
+			// the validator votes yes, on behalf of the superfluid delegator unless it votes
+			err = suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, sdk.AccAddress(valAddrs[0]), govtypes.NewNonSplitVoteOption(govtypes.OptionYes))
+			suite.Require().NoError(err)
+			if tc.delegatorVote != nil {
+				err = suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, delAddrs[0], tc.delegatorVote)
+				suite.Require().NoError(err)
+			}
+
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			superfluidPower := sdk.ZeroDec()
+			for _, acc := range intermediaryAccs {
+				delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddrs[0])
+				suite.Require().True(found)
+				superfluidPower = superfluidPower.Add(validator.TokensFromShares(delegation.Shares))
+			}
+			suite.Require().True(superfluidPower.IsPositive())
+
+			expResults := map[govtypes.VoteOption]sdk.Dec{
+				govtypes.OptionYes:        validator.Tokens.ToDec(),
+				govtypes.OptionAbstain:    sdk.ZeroDec(),
+				govtypes.OptionNo:         sdk.ZeroDec(),
+				govtypes.OptionNoWithVeto: sdk.ZeroDec(),
+			}
+			for _, option := range tc.delegatorVote {
+				expResults[govtypes.OptionYes] = validator.Tokens.ToDec().Sub(superfluidPower)
+				expResults[option.Option] = superfluidPower.Mul(option.Weight)
+			}
+
+			proposal, found = suite.App.GovKeeper.GetProposal(suite.Ctx, proposal.ProposalId)
+			suite.Require().True(found)
+			_, _, tallyResults := suite.App.GovKeeper.Tally(suite.Ctx, proposal)
+			suite.Require().Equal(govtypes.NewTallyResultFromMap(expResults), tallyResults)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// markAssetStale records a failed osmo equivalent multiplier update of the asset at the epoch.
+// Once the asset failed StaleAssetZeroingEpochs epochs in a row, its multiplier is zeroed,
+// so that it stops backing superfluid delegations until it updates again.
+func (k Keeper) markAssetStale(ctx sdk.Context, denom string, epochNum int64, updateErr error) {
+	k.Logger(ctx).Error("failed to update superfluid asset multiplier", "denom", denom, "epoch", epochNum, "error", updateErr.Error())
+
+	staleAsset, found := k.GetStaleAsset(ctx, denom)
+	if !found {
+		staleAsset = types.StaleSuperfluidAsset{
+			Denom:      denom,
+			SinceEpoch: epochNum,
+		}
+	}
+	staleAsset.FailedEpochs++
+	staleAsset.LastError = updateErr.Error()
+
+	k.emitTypedEvent(ctx, &types.EventAssetMultiplierUpdateFailed{
+		Denom:        denom,
+		EpochNumber:  epochNum,
+		FailedEpochs: staleAsset.FailedEpochs,
+		Error:        staleAsset.LastError,
+	})
+
+	zeroingEpochs := k.GetParams(ctx).StaleAssetZeroingEpochs
+	if zeroingEpochs != 0 && staleAsset.FailedEpochs >= zeroingEpochs {
+		k.SetOsmoEquivalentMultiplier(ctx, epochNum, denom, sdk.ZeroDec())
+		if !staleAsset.MultiplierZeroed {
+			staleAsset.MultiplierZeroed = true
+			k.emitTypedEvent(ctx, &types.EventAssetMultiplierZeroed{
+				Denom:        denom,
+				EpochNumber:  epochNum,
+				FailedEpochs: staleAsset.FailedEpochs,
+			})
+		}
+	}
+
+	k.SetStaleAsset(ctx, staleAsset)
+}
+
+// clearAssetStale deletes the stale record of the asset after a successful multiplier update.
+func (k Keeper) clearAssetStale(ctx sdk.Context, denom string, epochNum int64) {
+	staleAsset, found := k.GetStaleAsset(ctx, denom)
+	if !found {
+		return
+	}
+	k.DeleteStaleAsset(ctx, denom)
+	k.emitTypedEvent(ctx, &types.EventAssetRecovered{
+		Denom:        denom,
+		EpochNumber:  epochNum,
+		FailedEpochs: staleAsset.FailedEpochs,
+	})
+}
+
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+	}
+}
+
+func (k Keeper) SetStaleAsset(ctx sdk.Context, staleAsset types.StaleSuperfluidAsset) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixStaleAsset)
+	bz, err := proto.Marshal(&staleAsset)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set([]byte(staleAsset.Denom), bz)
+}
+
+// GetStaleAsset returns the stale record of the asset, and a bool if found / not found.
+func (k Keeper) GetStaleAsset(ctx sdk.Context, denom string) (types.StaleSuperfluidAsset, bool) {
+	staleAsset := types.StaleSuperfluidAsset{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixStaleAsset)
+	bz := prefixStore.Get([]byte(denom))
+	if bz == nil {
+		return staleAsset, false
+	}
+	err := proto.Unmarshal(bz, &staleAsset)
+	if err != nil {
+		panic(err)
+	}
+	return staleAsset, true
+}
+
+func (k Keeper) GetAllStaleAssets(ctx sdk.Context) []types.StaleSuperfluidAsset {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixStaleAsset)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	staleAssets := []types.StaleSuperfluidAsset{}
+	for ; iterator.Valid(); iterator.Next() {
+		staleAsset := types.StaleSuperfluidAsset{}
+
+		err := proto.Unmarshal(iterator.Value(), &staleAsset)
+		if err != nil {
+			panic(err)
+		}
+
+		staleAssets = append(staleAssets, staleAsset)
+	}
+	return staleAssets
+}
+
+func (k Keeper) DeleteStaleAsset(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixStaleAsset)
+	prefixStore.Delete([]byte(denom))
+}
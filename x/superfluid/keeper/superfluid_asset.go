@@ -10,7 +10,8 @@ import (
 // BeginUnwindSuperfluidAsset starts the deletion process for a superfluid asset.
 // This current method is a stub, but is called when:
 // * Governance removes a superfluid asset
-// * A severe error in gamm occurs
+//
+// Failures to update the asset's multiplier do not unwind it, they mark it stale instead.
 //
 // It should eventually begin unwinding all of the synthetic lockups for that asset
 // and queue them for deletion.
@@ -19,23 +20,34 @@ func (k Keeper) BeginUnwindSuperfluidAsset(ctx sdk.Context, epochNum int64, asse
 	// Right now set the TWAP to 0, and delete the asset.
 	k.SetOsmoEquivalentMultiplier(ctx, epochNum, asset.Denom, sdk.ZeroDec())
 	k.DeleteSuperfluidAsset(ctx, asset.Denom)
+	k.DeleteStaleAsset(ctx, asset.Denom)
+	k.DeleteNativeAssetPriceRecord(ctx, asset.Denom)
 }
 
-// Returns amount * (1 - k.RiskFactor(asset))
-// Fow now, the risk factor is a global constant.
-// It will move towards per pool functions.
-func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
+// GetRiskFactor returns the risk factor of a superfluid asset.
+// It is the asset's own risk factor, floored by the MinimumRiskFactor param.
+// Assets without a risk factor use the MinimumRiskFactor param.
+func (k Keeper) GetRiskFactor(ctx sdk.Context, asset types.SuperfluidAsset) sdk.Dec {
 	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Sub(amount.ToDec().Mul(minRiskFactor).RoundInt())
+	if asset.RiskFactor.IsNil() || asset.RiskFactor.LT(minRiskFactor) {
+		return minRiskFactor
+	}
+	return asset.RiskFactor
+}
+
+// Returns amount * (1 - k.GetRiskFactor(asset))
+func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Sub(amount.ToDec().Mul(riskFactor).RoundInt())
 }
 
-// y = x - (x * minRisk)
-// y = x (1 - minRisk)
-// y / (1 - minRisk) = x
+// y = x - (x * risk)
+// y = x (1 - risk)
+// y / (1 - risk) = x
 
-func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, amount sdk.Dec) sdk.Dec {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Quo(sdk.OneDec().Sub(minRiskFactor))
+func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Dec) sdk.Dec {
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Quo(sdk.OneDec().Sub(riskFactor))
 }
 
 func (k Keeper) AddNewSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) {
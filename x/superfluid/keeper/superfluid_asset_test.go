@@ -42,12 +42,48 @@ func (suite *KeeperTestSuite) TestSuperfluidAssetSetGetDeleteFlow() {
 }
 
 func (suite *KeeperTestSuite) TestGetRiskAdjustedOsmoValue() {
-	suite.SetupTest()
+	testCases := []struct {
+		name              string
+		riskFactor        sdk.Dec
+		expRiskFactor     sdk.Dec
+		expAdjustedAmount sdk.Int
+	}{
+		{
+			"asset without risk factor uses minimum risk factor",
+			sdk.Dec{},
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewInt(50),
+		},
+		{
+			"asset risk factor below minimum risk factor",
+			sdk.NewDecWithPrec(2, 1),
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewInt(50),
+		},
+		{
+			"asset risk factor above minimum risk factor",
+			sdk.NewDecWithPrec(8, 1),
+			sdk.NewDecWithPrec(8, 1),
+			sdk.NewInt(20),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			asset := types.SuperfluidAsset{
+				Denom:      "gamm/pool/1",
+				AssetType:  types.SuperfluidAssetTypeLPShare,
+				RiskFactor: tc.riskFactor,
+			}
+			suite.Require().Equal(tc.expRiskFactor, suite.App.SuperfluidKeeper.GetRiskFactor(suite.Ctx, asset))
+
+			adjustedValue := suite.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(suite.Ctx, asset, sdk.NewInt(100))
+			suite.Require().Equal(tc.expAdjustedAmount, adjustedValue)
 
-	adjustedValue := suite.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(
-		suite.Ctx,
-		types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare},
-		sdk.NewInt(100),
-	)
-	suite.Require().Equal(sdk.NewInt(50), adjustedValue)
+			unadjustedValue := suite.App.SuperfluidKeeper.UnriskAdjustOsmoValue(suite.Ctx, asset, adjustedValue.ToDec())
+			suite.Require().Equal(sdk.NewDec(100), unadjustedValue)
+		})
+	}
 }
@@ -46,6 +46,7 @@ func (k Keeper) createSyntheticLockup(ctx sdk.Context,
 	if lockingStat == unlockingStatus {
 		isUnlocking := true
 		synthdenom := unstakingSyntheticDenom(intermediateAcc.Denom, intermediateAcc.ValAddr)
+		k.SetUnbondingStartHeight(ctx, underlyingLockId, synthdenom, ctx.BlockHeight())
 		return k.lk.CreateSyntheticLockup(ctx, underlyingLockId, synthdenom, unbondingDuration, isUnlocking)
 	} else {
 		notUnlocking := false
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v10/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
 
@@ -57,3 +59,54 @@ func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierSetGetDeleteFlow() {
 	multiplier = suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
 	suite.Require().Equal(multiplier, sdk.NewDec(0))
 }
+
+func (suite *KeeperTestSuite) TestNativeAssetOsmoEquivalentMultiplier() {
+	suite.SetupTest()
+
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	poolId := suite.createGammPool([]string{bondDenom, "uatom"})
+	asset := types.SuperfluidAsset{
+		Denom:       "uatom",
+		AssetType:   types.SuperfluidAssetTypeNative,
+		PricePoolId: poolId,
+	}
+
+	// a new native asset starts with the spot price of its pool
+	startTime := suite.Ctx.BlockTime()
+	suite.App.SuperfluidKeeper.AddNewSuperfluidAsset(suite.Ctx, asset)
+	suite.Require().Equal(sdk.OneDec(), suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// the price of 1 is held for an hour
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	suite.App.SuperfluidKeeper.SampleNativeAssetPrices(suite.Ctx)
+
+	// the price drops, and is held for two hours
+	swapper := CreateRandomAccounts(1)[0]
+	suite.FundAcc(swapper, sdk.NewCoins(sdk.NewInt64Coin(asset.Denom, 100000000000000000)))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, sdk.NewInt64Coin(asset.Denom, 100000000000000000), bondDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+	droppedPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, bondDenom, asset.Denom)
+	suite.Require().NoError(err)
+	suite.Require().True(droppedPrice.LT(sdk.OneDec()))
+	suite.App.SuperfluidKeeper.SampleNativeAssetPrices(suite.Ctx)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(3 * time.Hour))
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+	suite.Require().NoError(err)
+
+	hourMs := time.Hour.Milliseconds()
+	expectedTwap := sdk.OneDec().MulInt64(hourMs).Add(droppedPrice.MulInt64(2 * hourMs)).QuoInt64(3 * hourMs)
+	suite.Require().Equal(expectedTwap, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// the accumulation restarts from the update
+	record, found := suite.App.SuperfluidKeeper.GetNativeAssetPriceRecord(suite.Ctx, asset.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.Ctx.BlockTime(), record.StartTime)
+	suite.Require().Equal(droppedPrice, record.LastSpotPrice)
+	suite.Require().True(record.PriceTimeSum.IsZero())
+
+	// the price record is deleted with the asset
+	suite.App.SuperfluidKeeper.BeginUnwindSuperfluidAsset(suite.Ctx, 1, asset)
+	_, found = suite.App.SuperfluidKeeper.GetNativeAssetPriceRecord(suite.Ctx, asset.Denom)
+	suite.Require().False(found)
+}
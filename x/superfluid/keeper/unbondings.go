@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v10/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v10/x/superfluid/types"
+)
+
+// GetSuperfluidUnbondingsByDelegator returns the page of the superfluid unbondings of a delegator requested by pageReq,
+// with the total unbonding coins of all the pages if pageReq counts the total.
+func (k Keeper) GetSuperfluidUnbondingsByDelegator(ctx sdk.Context, delAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.SuperfluidUnbondingRecord, sdk.Coins, *query.PageResponse, error) {
+	return k.paginateSuperfluidUnbondings(ctx, func(pageReq *query.PageRequest, onResult func(lockuptypes.SyntheticLock, bool) (bool, error)) (*query.PageResponse, error) {
+		return k.lk.PaginateUnlockingSyntheticLockupsByAddr(ctx, delAddr, pageReq, onResult)
+	}, pageReq)
+}
+
+// GetSuperfluidUnbondingsByValidatorDenom returns the page of the superfluid unbondings of a denom from a validator
+// requested by pageReq, with the total unbonding coins of all the pages if pageReq counts the total.
+func (k Keeper) GetSuperfluidUnbondingsByValidatorDenom(ctx sdk.Context, valAddr, denom string, pageReq *query.PageRequest) ([]types.SuperfluidUnbondingRecord, sdk.Coins, *query.PageResponse, error) {
+	synthDenom := unstakingSyntheticDenom(denom, valAddr)
+	return k.paginateSuperfluidUnbondings(ctx, func(pageReq *query.PageRequest, onResult func(lockuptypes.SyntheticLock, bool) (bool, error)) (*query.PageResponse, error) {
+		return k.lk.PaginateUnlockingSyntheticLockupsByDenom(ctx, synthDenom, pageReq, onResult)
+	}, pageReq)
+}
+
+// paginateSuperfluidUnbondings returns the page of the superfluid unbondings among the unlocking synthetic lockups
+// paginated by paginate. Like the total count, the total unbonding coins of all the pages are only summed up when
+// pageReq counts the total, which pagination by key doesn't, as it takes a visit of every page.
+func (k Keeper) paginateSuperfluidUnbondings(ctx sdk.Context,
+	paginate func(pageReq *query.PageRequest, onResult func(lockuptypes.SyntheticLock, bool) (bool, error)) (*query.PageResponse, error),
+	pageReq *query.PageRequest,
+) ([]types.SuperfluidUnbondingRecord, sdk.Coins, *query.PageResponse, error) {
+	countTotal := pageReq != nil && pageReq.CountTotal && len(pageReq.Key) == 0
+	records := []types.SuperfluidUnbondingRecord{}
+	total := sdk.NewCoins()
+	pageRes, err := paginate(pageReq, func(synthLock lockuptypes.SyntheticLock, accumulate bool) (bool, error) {
+		record, found, err := k.getSuperfluidUnbondingRecord(ctx, synthLock)
+		if err != nil || !found {
+			return false, err
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		// counting the total visits the records of all the pages.
+		if countTotal {
+			total = total.Add(record.Amount)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return records, total, pageRes, nil
+}
+
+// getSuperfluidUnbondingRecord returns the superfluid unbonding of an unstaking synthetic lockup,
+// or false if the synthetic lockup is not unstaking or its lock is being redelegated rather than unbonded.
+func (k Keeper) getSuperfluidUnbondingRecord(ctx sdk.Context, synthLock lockuptypes.SyntheticLock) (types.SuperfluidUnbondingRecord, bool, error) {
+	if !strings.Contains(synthLock.SynthDenom, "superunbonding") {
+		return types.SuperfluidUnbondingRecord{}, false, nil
+	}
+
+	// a lock still connected to an intermediary account is being redelegated.
+	if _, found := k.GetIntermediaryAccountFromLockId(ctx, synthLock.UnderlyingLockId); found {
+		return types.SuperfluidUnbondingRecord{}, false, nil
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
+	if err != nil {
+		return types.SuperfluidUnbondingRecord{}, false, err
+	}
+	valAddr, err := ValidatorAddressFromSyntheticDenom(synthLock.SynthDenom)
+	if err != nil {
+		return types.SuperfluidUnbondingRecord{}, false, err
+	}
+
+	baseDenom := lock.Coins.GetDenomByIndex(0)
+	return types.SuperfluidUnbondingRecord{
+		LockId:           lock.ID,
+		DelegatorAddress: lock.Owner,
+		ValidatorAddress: valAddr,
+		Amount:           sdk.NewCoin(baseDenom, lock.Coins.AmountOf(baseDenom)),
+		EndTime:          synthLock.EndTime,
+	}, true, nil
+}
@@ -14,7 +14,12 @@ import (
 func RandomizedGenState(simState *module.SimulationState) {
 	superfluidGenesis := &types.GenesisState{
 		Params: types.Params{
-			MinimumRiskFactor: sdk.NewDecWithPrec(5, 2), // 5%
+			MinimumRiskFactor:           sdk.NewDecWithPrec(5, 2), // 5%
+			LockRewardHistoryEpochs:     14,
+			StaleAssetZeroingEpochs:     3,
+			AutoCompoundLocksPerEpoch:   100,
+			MaxValidatorSuperfluidShare: sdk.ZeroDec(),
+			MaxTotalSuperfluidShare:     sdk.ZeroDec(),
 		},
 		SuperfluidAssets:          []types.SuperfluidAsset{},
 		OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{},
@@ -18,6 +18,14 @@ var (
 
 	ErrNonSuperfluidAsset = sdkerrors.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
 
+	ErrTransitiveRedelegation = sdkerrors.Register(ModuleName, 11, "redelegation of a lock that is still being redelegated is not allowed")
+	ErrMaxRedelegationEntries = sdkerrors.Register(ModuleName, 12, "too many superfluid redelegation entries for the validator pair")
+	ErrInvalidPartialAmount   = sdkerrors.Register(ModuleName, 13, "partial undelegation must be of the lock's denom and less than its locked amount")
+	ErrAutoCompoundNotAllowed = sdkerrors.Register(ModuleName, 14, "lock not eligible for auto-compounding")
+
+	ErrValidatorSuperfluidCapExceeded = sdkerrors.Register(ModuleName, 15, "superfluid delegation exceeds the validator's superfluid cap")
+	ErrTotalSuperfluidCapExceeded     = sdkerrors.Register(ModuleName, 16, "superfluid delegation exceeds the total superfluid cap")
+
 	ErrPoolNotWhitelisted   = sdkerrors.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = sdkerrors.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = sdkerrors.Register(ModuleName, 43, "lock has more than one asset")
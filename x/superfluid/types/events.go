@@ -7,14 +7,26 @@ const (
 	TypeEvtSuperfluidDelegate           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
+	TypeEvtSuperfluidRedelegate         = "superfluid_redelegate"
+	TypeEvtSuperfluidPartialUndelegate  = "superfluid_partial_undelegate"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
+	TypeEvtSuperfluidLockRewards        = "superfluid_lock_rewards"
+	TypeEvtSetLockAutoCompound          = "set_lock_auto_compound"
+	TypeEvtLockAutoCompound             = "lock_auto_compound"
 
 	TypeEvtUnpoolId     = "unpool_pool_id"
 	AttributeNewLockIds = "new_lock_ids"
 
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
+	AttributeRiskFactor          = "risk_factor"
+	AttributePricePoolId         = "price_pool_id"
+	AttributeEnabled             = "enabled"
+	AttributeShares              = "shares"
 	AttributeLockId              = "lock_id"
 	AttributeValidator           = "validator"
+	AttributeNewValidator        = "new_validator"
+	AttributeSplitLockId         = "split_lock_id"
 	AttributeAmount              = "amount"
+	AttributeEpochNumber         = "epoch_number"
 )
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochstypes "github.com/osmosis-labs/osmosis/v10/x/epochs/types"
@@ -23,6 +24,8 @@ type LockupKeeper interface {
 	GetAccountPeriodLocks(ctx sdk.Context, addr sdk.AccAddress) []lockuptypes.PeriodLock
 	GetPeriodLocks(ctx sdk.Context) ([]lockuptypes.PeriodLock, error)
 	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coin sdk.Coin) (*lockuptypes.PeriodLock, error)
+	SetLockRewardReceiver(ctx sdk.Context, lockID uint64, owner, rewardReceiver sdk.AccAddress) error
 	// Despite the name, BeginForceUnlock is really BeginUnlock
 	// TODO: Fix this in future code update
 	BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) error
@@ -34,9 +37,12 @@ type LockupKeeper interface {
 
 	GetSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) (*lockuptypes.SyntheticLock, error)
 	GetAllSyntheticLockupsByAddr(ctx sdk.Context, owner sdk.AccAddress) []lockuptypes.SyntheticLock
+	PaginateUnlockingSyntheticLockupsByDenom(ctx sdk.Context, synthDenom string, pageReq *query.PageRequest, onResult func(synthLock lockuptypes.SyntheticLock, accumulate bool) (bool, error)) (*query.PageResponse, error)
+	PaginateUnlockingSyntheticLockupsByAddr(ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest, onResult func(synthLock lockuptypes.SyntheticLock, accumulate bool) (bool, error)) (*query.PageResponse, error)
 	CreateSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string, unlockDuration time.Duration, isUnlocking bool) error
 	DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) error
 	GetAllSyntheticLockupsByLockup(ctx sdk.Context, lockID uint64) []lockuptypes.SyntheticLock
+	SplitLock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (lockuptypes.PeriodLock, error)
 }
 
 type LockupMsgServer interface {
@@ -49,10 +55,13 @@ type GammKeeper interface {
 	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.PoolI, err error)
 	ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, tokenOutMins sdk.Coins) (exitCoins sdk.Coins, err error)
+	JoinSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutMinAmount sdk.Int) (sdk.Int, error)
 }
 
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
 	GetSupplyOffset(ctx sdk.Context, denom string) sdk.Int
@@ -93,7 +102,8 @@ type IncentivesKeeper interface {
 	AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error
 
 	GetActiveGauges(ctx sdk.Context) []incentivestypes.Gauge
-	Distribute(ctx sdk.Context, gauges []incentivestypes.Gauge) (sdk.Coins, error)
+	DistributeWithLockRewards(ctx sdk.Context, gauges []incentivestypes.Gauge) (sdk.Coins, []incentivestypes.LockRewards, error)
+	ClaimLockRewards(ctx sdk.Context, lockID uint64) (sdk.Coins, error)
 
 	GetParams(ctx sdk.Context) incentivestypes.Params
 }
@@ -30,6 +30,12 @@ type GenesisState struct {
 	OsmoEquivalentMultipliers     []OsmoEquivalentMultiplierRecord      `protobuf:"bytes,3,rep,name=osmo_equivalent_multipliers,json=osmoEquivalentMultipliers,proto3" json:"osmo_equivalent_multipliers"`
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	UnbondingStartHeights         []SuperfluidUnbondingStartHeight      `protobuf:"bytes,6,rep,name=unbonding_start_heights,json=unbondingStartHeights,proto3" json:"unbonding_start_heights"`
+	StaleAssets                   []StaleSuperfluidAsset                `protobuf:"bytes,7,rep,name=stale_assets,json=staleAssets,proto3" json:"stale_assets"`
+	NativeAssetPriceRecords       []NativeAssetPriceRecord              `protobuf:"bytes,8,rep,name=native_asset_price_records,json=nativeAssetPriceRecords,proto3" json:"native_asset_price_records"`
+	AutoCompoundLocks             []AutoCompoundLock                    `protobuf:"bytes,9,rep,name=auto_compound_locks,json=autoCompoundLocks,proto3" json:"auto_compound_locks"`
+	LockAccruedRewards            []LockAccruedRewards                  `protobuf:"bytes,10,rep,name=lock_accrued_rewards,json=lockAccruedRewards,proto3" json:"lock_accrued_rewards"`
+	LockRewardHistory             []LockRewardHistoryRecord             `protobuf:"bytes,11,rep,name=lock_reward_history,json=lockRewardHistory,proto3" json:"lock_reward_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +106,48 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetUnbondingStartHeights() []SuperfluidUnbondingStartHeight {
+	if m != nil {
+		return m.UnbondingStartHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStaleAssets() []StaleSuperfluidAsset {
+	if m != nil {
+		return m.StaleAssets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNativeAssetPriceRecords() []NativeAssetPriceRecord {
+	if m != nil {
+		return m.NativeAssetPriceRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundLocks() []AutoCompoundLock {
+	if m != nil {
+		return m.AutoCompoundLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockAccruedRewards() []LockAccruedRewards {
+	if m != nil {
+		return m.LockAccruedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewardHistory() []LockRewardHistoryRecord {
+	if m != nil {
+		return m.LockRewardHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -107,31 +155,44 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x41, 0x86, 0xe2, 0xa0, 0x0d, 0x26, 0x15, 0x63, 0x21, 0xb2, 0xb0, 0xd8, 0x0a,
-	0x26, 0xea, 0x0a, 0xc6, 0x18, 0x12, 0x8d, 0x04, 0x12, 0x07, 0x97, 0xe6, 0x28, 0x67, 0xbd, 0xd8,
-	0xf6, 0xea, 0xbd, 0x2b, 0x81, 0x0f, 0xe0, 0xce, 0xc7, 0x62, 0x64, 0x74, 0x32, 0x06, 0xbe, 0x88,
-	0x69, 0x7b, 0x16, 0x94, 0xea, 0xf6, 0xda, 0xf7, 0xfb, 0xbf, 0xdf, 0xbb, 0xe4, 0x29, 0x55, 0x0a,
-	0x1e, 0x05, 0x02, 0x26, 0x84, 0x01, 0x66, 0x4f, 0x6e, 0x48, 0x86, 0xa6, 0x83, 0x7d, 0x0c, 0x04,
-	0x8c, 0x80, 0x51, 0x4e, 0x55, 0x55, 0x10, 0xc6, 0x8a, 0x28, 0x97, 0x1c, 0xea, 0xd0, 0xb8, 0x6d,
-	0x46, 0x55, 0x42, 0x96, 0x6b, 0x19, 0xb3, 0x56, 0xa5, 0x80, 0x2a, 0x19, 0x50, 0x80, 0x18, 0xf2,
-	0x84, 0xef, 0x78, 0x9a, 0x57, 0x76, 0x6e, 0x92, 0x0d, 0xfa, 0x1c, 0x71, 0xac, 0x5e, 0x2a, 0x85,
-	0x04, 0xd0, 0xe4, 0xaa, 0x5c, 0x2f, 0x36, 0xcb, 0xc6, 0xe6, 0x46, 0x46, 0x37, 0x26, 0xda, 0xf9,
-	0xd9, 0x47, 0x45, 0xea, 0x09, 0x5e, 0x7d, 0x50, 0xf6, 0x56, 0x88, 0x85, 0x00, 0x30, 0x07, 0x6d,
-	0xab, 0x9a, 0xab, 0x17, 0x9b, 0xb5, 0xac, 0x21, 0xfd, 0xb4, 0x6c, 0x45, 0xac, 0x98, 0xb6, 0x0b,
-	0x3f, 0x7f, 0x83, 0x3a, 0x56, 0x0e, 0xa3, 0xb4, 0x85, 0x5f, 0x43, 0x32, 0x42, 0x2e, 0xf6, 0xb9,
-	0xe5, 0x85, 0x2e, 0x27, 0x81, 0x4b, 0x30, 0x03, 0x2d, 0x17, 0x1b, 0x9a, 0x59, 0x86, 0x7b, 0xf0,
-	0xe8, 0x75, 0x9a, 0xba, 0x4b, 0x43, 0x3d, 0x6c, 0x53, 0x36, 0x14, 0xc2, 0x03, 0xfa, 0x07, 0x05,
-	0xaa, 0xab, 0xec, 0x13, 0x9f, 0x63, 0xe6, 0xe1, 0x21, 0x41, 0x6c, 0x62, 0x21, 0xdb, 0xa6, 0xa1,
-	0xcf, 0x41, 0xcb, 0xc7, 0xce, 0xc6, 0xff, 0xaf, 0xea, 0xac, 0x45, 0x5b, 0x49, 0x52, 0x28, 0x4b,
-	0x64, 0xb3, 0x05, 0xea, 0x9b, 0xac, 0x54, 0xa2, 0xc6, 0x2f, 0x9b, 0x65, 0x53, 0xdf, 0xc7, 0x36,
-	0x27, 0xd4, 0x07, 0x6d, 0x3b, 0x16, 0x5f, 0x64, 0x89, 0x6f, 0xa9, 0xfd, 0xd2, 0xc9, 0x92, 0x5e,
-	0xa5, 0x79, 0xa1, 0x3f, 0x5a, 0xb3, 0x6c, 0x30, 0xd0, 0xee, 0xce, 0x16, 0xba, 0x3c, 0x5f, 0xe8,
-	0xf2, 0xe7, 0x42, 0x97, 0xa7, 0x4b, 0x5d, 0x9a, 0x2f, 0x75, 0xe9, 0x7d, 0xa9, 0x4b, 0x8f, 0xe7,
-	0x0e, 0xe1, 0xcf, 0xe1, 0xc0, 0xb0, 0xa9, 0x67, 0x8a, 0x0d, 0x4e, 0x5c, 0x34, 0x80, 0xef, 0x0f,
-	0x73, 0xd4, 0x38, 0x35, 0xc7, 0xeb, 0xb7, 0xc6, 0x27, 0x01, 0x86, 0x41, 0x21, 0xbe, 0xb5, 0xb3,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0x7b, 0x37, 0x83, 0xff, 0x02, 0x00, 0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0x3f, 0xf8, 0x21, 0xce, 0x72, 0x90, 0x01, 0x42, 0x5d, 0x63, 0x21, 0x62, 0x0c,
+	0xd1, 0xb8, 0x15, 0x4c, 0xd4, 0xeb, 0x42, 0x8c, 0x90, 0xf8, 0x07, 0x21, 0x7a, 0xe0, 0xe0, 0x64,
+	0xb6, 0x1d, 0xbb, 0x13, 0xda, 0x99, 0x3a, 0xcf, 0x0c, 0xc2, 0x0b, 0xf0, 0xee, 0xcb, 0xe2, 0xc8,
+	0xd1, 0x93, 0x31, 0xec, 0x1b, 0x31, 0x9d, 0x0e, 0xbb, 0xec, 0xee, 0xac, 0xb7, 0xee, 0x3e, 0x9f,
+	0xef, 0xf7, 0xd3, 0x69, 0x9f, 0x14, 0xad, 0x4b, 0x28, 0x24, 0x70, 0x88, 0xc1, 0x94, 0x4c, 0x7d,
+	0xcd, 0x0d, 0x4f, 0xe3, 0x8c, 0x09, 0x06, 0x1c, 0xda, 0xa5, 0x92, 0x5a, 0x62, 0xec, 0x88, 0xf6,
+	0x90, 0x68, 0x2d, 0x67, 0x32, 0x93, 0x76, 0x1c, 0x57, 0x57, 0x35, 0xd9, 0xda, 0xf0, 0x74, 0x0d,
+	0x2f, 0x1d, 0xb4, 0xe6, 0x81, 0x4a, 0xaa, 0x68, 0xe1, 0x7c, 0x0f, 0xfa, 0xf3, 0x68, 0xe1, 0x4d,
+	0x7d, 0x07, 0x47, 0x9a, 0x6a, 0x86, 0x5f, 0xa1, 0xb9, 0x1a, 0x08, 0x83, 0xf5, 0x60, 0xb3, 0xb9,
+	0xdd, 0x6a, 0x4f, 0xde, 0x51, 0xfb, 0xc0, 0x12, 0x3b, 0xb3, 0x17, 0xbf, 0xd7, 0x1a, 0x87, 0x8e,
+	0xc7, 0x9f, 0xd1, 0xe2, 0x10, 0x21, 0x14, 0x80, 0x69, 0x08, 0xff, 0x5b, 0x9f, 0xd9, 0x6c, 0x6e,
+	0x6f, 0xf8, 0x4a, 0x8e, 0x06, 0x97, 0x9d, 0x8a, 0x75, 0x6d, 0x77, 0x60, 0xf4, 0x6f, 0xc0, 0x67,
+	0xe8, 0x5e, 0x95, 0x26, 0xec, 0x9b, 0xe1, 0xa7, 0x34, 0x67, 0x42, 0x93, 0xc2, 0xe4, 0x9a, 0x97,
+	0x39, 0x67, 0x0a, 0xc2, 0x19, 0x6b, 0xd8, 0xf6, 0x19, 0x3e, 0x40, 0x21, 0x5f, 0x0f, 0x52, 0xef,
+	0x06, 0xa1, 0x43, 0x96, 0x48, 0x95, 0x3a, 0xe1, 0x5d, 0x39, 0x85, 0x02, 0x9c, 0xa3, 0x15, 0x2e,
+	0x34, 0x53, 0x05, 0x4b, 0x39, 0x55, 0xe7, 0x84, 0x26, 0x89, 0x34, 0x42, 0x43, 0x38, 0x6b, 0x9d,
+	0x5b, 0xff, 0x3e, 0xd5, 0xfe, 0x8d, 0x68, 0xa7, 0x4e, 0x3a, 0xe5, 0x32, 0x9f, 0x1c, 0x01, 0xfe,
+	0x11, 0xa0, 0xb5, 0x6a, 0x30, 0x66, 0x23, 0x89, 0x14, 0x82, 0x25, 0x9a, 0x4b, 0x01, 0xe1, 0xff,
+	0x56, 0xfc, 0xd2, 0x27, 0x7e, 0x2b, 0x93, 0x93, 0x7d, 0x9f, 0x74, 0x77, 0x90, 0x77, 0xfa, 0xfb,
+	0x37, 0x2c, 0x13, 0x0c, 0xe0, 0x12, 0xad, 0x1a, 0xd1, 0x95, 0x22, 0xe5, 0x22, 0x23, 0xa0, 0xa9,
+	0xd2, 0xa4, 0xc7, 0x78, 0xd6, 0xd3, 0x10, 0xce, 0x4d, 0x7f, 0xd6, 0xc3, 0x73, 0x7f, 0xba, 0x0e,
+	0x1f, 0x55, 0xd9, 0x3d, 0x1b, 0x75, 0xe6, 0x15, 0xe3, 0x99, 0x01, 0xfe, 0x88, 0x16, 0x40, 0xd3,
+	0x9c, 0x5d, 0x2f, 0xcd, 0x2d, 0xab, 0xd9, 0xf4, 0x6a, 0x2a, 0xce, 0xbf, 0x39, 0x4d, 0xdb, 0xe1,
+	0x96, 0xa6, 0x40, 0x2d, 0x41, 0x35, 0x3f, 0x75, 0x9d, 0xa4, 0x54, 0x3c, 0x61, 0x44, 0xd9, 0x17,
+	0x0f, 0xe1, 0xbc, 0x15, 0x3c, 0xf6, 0x09, 0xde, 0xdb, 0x94, 0x6d, 0x39, 0xa8, 0x32, 0x23, 0xbb,
+	0xb2, 0x2a, 0xbc, 0x53, 0xc0, 0xc7, 0x68, 0x89, 0x1a, 0x2d, 0x49, 0x22, 0x8b, 0x52, 0x1a, 0x91,
+	0x92, 0x5c, 0x26, 0x27, 0x10, 0xde, 0xb6, 0x9e, 0x87, 0x3e, 0x4f, 0xc7, 0x68, 0xb9, 0xeb, 0xe8,
+	0xea, 0xd5, 0x39, 0xc3, 0x22, 0x1d, 0xfb, 0x1f, 0xf0, 0x17, 0xb4, 0x5c, 0xb5, 0x55, 0xfb, 0xa0,
+	0x0c, 0x4b, 0x89, 0x62, 0xdf, 0x69, 0x75, 0x08, 0x64, 0xcb, 0x1f, 0x4d, 0xdb, 0x85, 0x4e, 0x8d,
+	0x1f, 0xd6, 0xb4, 0xab, 0xc7, 0xf9, 0xc4, 0x04, 0x53, 0xb4, 0x64, 0xfb, 0xeb, 0x5e, 0xd2, 0xe3,
+	0xa0, 0xa5, 0x3a, 0x0f, 0x9b, 0xb6, 0xfe, 0xc9, 0xb4, 0xfa, 0x3a, 0xbd, 0x57, 0xc3, 0x23, 0x0f,
+	0x69, 0x31, 0x1f, 0x1f, 0xef, 0x1c, 0x5c, 0x5c, 0x45, 0xc1, 0xe5, 0x55, 0x14, 0xfc, 0xb9, 0x8a,
+	0x82, 0x9f, 0xfd, 0xa8, 0x71, 0xd9, 0x8f, 0x1a, 0xbf, 0xfa, 0x51, 0xe3, 0xf8, 0x45, 0xc6, 0x75,
+	0xcf, 0x74, 0xdb, 0x89, 0x2c, 0x62, 0x67, 0x7a, 0x9a, 0xd3, 0x2e, 0x5c, 0xff, 0x88, 0x4f, 0xb7,
+	0x9e, 0xc5, 0x67, 0x37, 0x3f, 0x5f, 0xfa, 0xbc, 0x64, 0xd0, 0x9d, 0xb3, 0x9f, 0xaf, 0xe7, 0x7f,
+	0x07, 0x00, 0x72, 0x16, 0x03, 0x9a, 0x52, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +215,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockRewardHistory) > 0 {
+		for iNdEx := len(m.LockRewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.LockAccruedRewards) > 0 {
+		for iNdEx := len(m.LockAccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockAccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AutoCompoundLocks) > 0 {
+		for iNdEx := len(m.AutoCompoundLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.NativeAssetPriceRecords) > 0 {
+		for iNdEx := len(m.NativeAssetPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeAssetPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.StaleAssets) > 0 {
+		for iNdEx := len(m.StaleAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StaleAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UnbondingStartHeights) > 0 {
+		for iNdEx := len(m.UnbondingStartHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingStartHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -266,6 +411,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnbondingStartHeights) > 0 {
+		for _, e := range m.UnbondingStartHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StaleAssets) > 0 {
+		for _, e := range m.StaleAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NativeAssetPriceRecords) > 0 {
+		for _, e := range m.NativeAssetPriceRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundLocks) > 0 {
+		for _, e := range m.AutoCompoundLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockAccruedRewards) > 0 {
+		for _, e := range m.LockAccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewardHistory) > 0 {
+		for _, e := range m.LockRewardHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -473,6 +654,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingStartHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingStartHeights = append(m.UnbondingStartHeights, SuperfluidUnbondingStartHeight{})
+			if err := m.UnbondingStartHeights[len(m.UnbondingStartHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StaleAssets = append(m.StaleAssets, StaleSuperfluidAsset{})
+			if err := m.StaleAssets[len(m.StaleAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetPriceRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeAssetPriceRecords = append(m.NativeAssetPriceRecords, NativeAssetPriceRecord{})
+			if err := m.NativeAssetPriceRecords[len(m.NativeAssetPriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundLocks = append(m.AutoCompoundLocks, AutoCompoundLock{})
+			if err := m.AutoCompoundLocks[len(m.AutoCompoundLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockAccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockAccruedRewards = append(m.LockAccruedRewards, LockAccruedRewards{})
+			if err := m.LockAccruedRewards[len(m.LockAccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardHistory = append(m.LockRewardHistory, LockRewardHistoryRecord{})
+			if err := m.LockRewardHistory[len(m.LockRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 
 	gammtypes "github.com/osmosis-labs/osmosis/v10/x/gamm/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -56,9 +57,25 @@ func (p *SetSuperfluidAssetsProposal) ValidateBasic() error {
 			if err = gammtypes.ValidatePoolShareDenom(asset.Denom); err != nil {
 				return err
 			}
+			if asset.PricePoolId != 0 {
+				return fmt.Errorf("LP share %s should not have a price pool", asset.Denom)
+			}
+		case SuperfluidAssetTypeNative:
+			if err = sdk.ValidateDenom(asset.Denom); err != nil {
+				return err
+			}
+			if strings.HasPrefix(asset.Denom, "gamm/pool/") {
+				return fmt.Errorf("LP share %s can not be a native superfluid asset", asset.Denom)
+			}
+			if asset.PricePoolId == 0 {
+				return fmt.Errorf("native superfluid asset %s should have a price pool", asset.Denom)
+			}
 		default:
 			return fmt.Errorf("unsupported superfluid asset type")
 		}
+		if !asset.RiskFactor.IsNil() && (asset.RiskFactor.IsNegative() || asset.RiskFactor.GTE(sdk.OneDec())) {
+			return fmt.Errorf("risk factor of %s should be in [0, 1): %s", asset.Denom, asset.RiskFactor)
+		}
 	}
 
 	return nil
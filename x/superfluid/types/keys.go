@@ -27,4 +27,31 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixUnbondingStartHeight defines prefix to connect lockId and synthetic denom to the height the superfluid unbonding started.
+	KeyPrefixUnbondingStartHeight = []byte{0x07}
+
+	// KeyPrefixLockAccruedRewards defines prefix to connect lockId and validator to the superfluid staking rewards the lock accrued.
+	KeyPrefixLockAccruedRewards = []byte{0x08}
+
+	// KeyPrefixLockRewardHistory defines prefix to connect epoch, lockId and validator to the superfluid staking rewards of the lock at the epoch.
+	KeyPrefixLockRewardHistory = []byte{0x09}
+
+	// KeyPrefixStaleAsset defines prefix to connect denom and the stale record of the superfluid asset.
+	KeyPrefixStaleAsset = []byte{0x0A}
+
+	// KeyPrefixNativeAssetPriceRecord defines prefix to connect denom and the price record of the native superfluid asset.
+	KeyPrefixNativeAssetPriceRecord = []byte{0x0B}
+
+	// KeyPrefixAutoCompoundLock defines prefix to connect lockId and the auto-compounding record of the lock.
+	KeyPrefixAutoCompoundLock = []byte{0x0C}
+
+	// KeyAutoCompoundCursor defines key to the last lockId whose rewards were auto-compounded.
+	KeyAutoCompoundCursor = []byte{0x0D}
+
+	// KeyPrefixValidatorSuperfluidTokens defines prefix to connect validator and the osmo superfluid delegated to it.
+	KeyPrefixValidatorSuperfluidTokens = []byte{0x0E}
+
+	// KeyTotalSuperfluidTokens defines key to the osmo superfluid delegated to all the validators.
+	KeyTotalSuperfluidTokens = []byte{0x0F}
 )
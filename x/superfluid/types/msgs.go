@@ -9,12 +9,14 @@ import (
 
 // constants.
 const (
-	TypeMsgSuperfluidDelegate        = "superfluid_delegate"
-	TypeMsgSuperfluidUndelegate      = "superfluid_undelegate"
-	TypeMsgSuperfluidRedelegate      = "superfluid_redelegate"
-	TypeMsgSuperfluidUnbondLock      = "superfluid_unbond_underlying_lock"
-	TypeMsgLockAndSuperfluidDelegate = "lock_and_superfluid_delegate"
-	TypeMsgUnPoolWhitelistedPool     = "unpool_whitelisted_pool"
+	TypeMsgSuperfluidDelegate          = "superfluid_delegate"
+	TypeMsgSuperfluidUndelegate        = "superfluid_undelegate"
+	TypeMsgSuperfluidRedelegate        = "superfluid_redelegate"
+	TypeMsgSuperfluidPartialUndelegate = "superfluid_partial_undelegate"
+	TypeMsgSuperfluidUnbondLock        = "superfluid_unbond_underlying_lock"
+	TypeMsgLockAndSuperfluidDelegate   = "lock_and_superfluid_delegate"
+	TypeMsgUnPoolWhitelistedPool       = "unpool_whitelisted_pool"
+	TypeMsgSetLockAutoCompound         = "set_lock_auto_compound"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -83,38 +85,75 @@ func (m MsgSuperfluidUndelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// var _ sdk.Msg = &MsgSuperfluidRedelegate{}
-
-// // NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation
-// func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
-// 	return &MsgSuperfluidRedelegate{
-// 		Sender:     sender.String(),
-// 		LockId:     lockId,
-// 		NewValAddr: newValAddr.String(),
-// 	}
-// }
-
-// func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
-// func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
-// func (m MsgSuperfluidRedelegate) ValidateBasic() error {
-// 	if m.Sender == "" {
-// 		return fmt.Errorf("sender should not be an empty address")
-// 	}
-// 	if m.LockId == 0 {
-// 		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
-// 	}
-// 	if m.NewValAddr == "" {
-// 		return fmt.Errorf("NewValAddr should not be empty")
-// 	}
-// 	return nil
-// }
-// func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
-// 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
-// }
-// func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
-// 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
-// 	return []sdk.AccAddress{sender}
-// }
+var _ sdk.Msg = &MsgSuperfluidRedelegate{}
+
+// NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation.
+func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
+	return &MsgSuperfluidRedelegate{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		NewValAddr: newValAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
+func (m MsgSuperfluidRedelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if m.NewValAddr == "" {
+		return fmt.Errorf("NewValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidPartialUndelegate{}
+
+// NewMsgSuperfluidPartialUndelegate creates a message to do superfluid undelegation for part of a lock.
+func NewMsgSuperfluidPartialUndelegate(sender sdk.AccAddress, lockId uint64, coin sdk.Coin) *MsgSuperfluidPartialUndelegate {
+	return &MsgSuperfluidPartialUndelegate{
+		Sender: sender.String(),
+		LockId: lockId,
+		Coin:   coin,
+	}
+}
+
+func (m MsgSuperfluidPartialUndelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidPartialUndelegate) Type() string  { return TypeMsgSuperfluidPartialUndelegate }
+func (m MsgSuperfluidPartialUndelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return fmt.Errorf("coin should be positive: %s", m.Coin)
+	}
+	return nil
+}
+
+func (m MsgSuperfluidPartialUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidPartialUndelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
 
 var _ sdk.Msg = &MsgSuperfluidUnbondLock{}
 
@@ -219,3 +258,35 @@ func (msg MsgUnPoolWhitelistedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetLockAutoCompound{}
+
+// NewMsgSetLockAutoCompound creates a message to enable or disable the auto-compounding of the rewards of a lock.
+func NewMsgSetLockAutoCompound(sender sdk.AccAddress, lockID uint64, enabled bool) *MsgSetLockAutoCompound {
+	return &MsgSetLockAutoCompound{
+		Sender:  sender.String(),
+		LockId:  lockID,
+		Enabled: enabled,
+	}
+}
+
+func (m MsgSetLockAutoCompound) Route() string { return RouterKey }
+func (m MsgSetLockAutoCompound) Type() string  { return TypeMsgSetLockAutoCompound }
+func (m MsgSetLockAutoCompound) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lockID should be set")
+	}
+	return nil
+}
+
+func (m MsgSetLockAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetLockAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -12,6 +12,23 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = sdk.NewDecWithPrec(5, 1) // 50%
+
+	KeyLockRewardHistoryEpochs     = []byte("LockRewardHistoryEpochs")
+	defaultLockRewardHistoryEpochs = uint64(14)
+	// MaxLockRewardHistoryEpochs bounds the per epoch superfluid staking rewards kept for every lock.
+	MaxLockRewardHistoryEpochs = uint64(100)
+
+	KeyStaleAssetZeroingEpochs     = []byte("StaleAssetZeroingEpochs")
+	defaultStaleAssetZeroingEpochs = uint64(3)
+
+	KeyAutoCompoundLocksPerEpoch     = []byte("AutoCompoundLocksPerEpoch")
+	defaultAutoCompoundLocksPerEpoch = uint64(100)
+
+	KeyMaxValidatorSuperfluidShare     = []byte("MaxValidatorSuperfluidShare")
+	defaultMaxValidatorSuperfluidShare = sdk.ZeroDec() // no cap
+
+	KeyMaxTotalSuperfluidShare     = []byte("MaxTotalSuperfluidShare")
+	defaultMaxTotalSuperfluidShare = sdk.ZeroDec() // no cap
 )
 
 // ParamTable for minting module.
@@ -19,16 +36,28 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor sdk.Dec) Params {
+func NewParams(minimumRiskFactor sdk.Dec, lockRewardHistoryEpochs, staleAssetZeroingEpochs, autoCompoundLocksPerEpoch uint64,
+	maxValidatorSuperfluidShare, maxTotalSuperfluidShare sdk.Dec,
+) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:           minimumRiskFactor,
+		LockRewardHistoryEpochs:     lockRewardHistoryEpochs,
+		StaleAssetZeroingEpochs:     staleAssetZeroingEpochs,
+		AutoCompoundLocksPerEpoch:   autoCompoundLocksPerEpoch,
+		MaxValidatorSuperfluidShare: maxValidatorSuperfluidShare,
+		MaxTotalSuperfluidShare:     maxTotalSuperfluidShare,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:           defaultMinimumRiskFactor, // 5%
+		LockRewardHistoryEpochs:     defaultLockRewardHistoryEpochs,
+		StaleAssetZeroingEpochs:     defaultStaleAssetZeroingEpochs,
+		AutoCompoundLocksPerEpoch:   defaultAutoCompoundLocksPerEpoch,
+		MaxValidatorSuperfluidShare: defaultMaxValidatorSuperfluidShare,
+		MaxTotalSuperfluidShare:     defaultMaxTotalSuperfluidShare,
 	}
 }
 
@@ -41,6 +70,11 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyLockRewardHistoryEpochs, &p.LockRewardHistoryEpochs, ValidateLockRewardHistoryEpochs),
+		paramtypes.NewParamSetPair(KeyStaleAssetZeroingEpochs, &p.StaleAssetZeroingEpochs, ValidateStaleAssetZeroingEpochs),
+		paramtypes.NewParamSetPair(KeyAutoCompoundLocksPerEpoch, &p.AutoCompoundLocksPerEpoch, ValidateAutoCompoundLocksPerEpoch),
+		paramtypes.NewParamSetPair(KeyMaxValidatorSuperfluidShare, &p.MaxValidatorSuperfluidShare, ValidateMaxSuperfluidShare),
+		paramtypes.NewParamSetPair(KeyMaxTotalSuperfluidShare, &p.MaxTotalSuperfluidShare, ValidateMaxSuperfluidShare),
 	}
 }
 
@@ -57,6 +91,50 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 	return nil
 }
 
+func ValidateLockRewardHistoryEpochs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxLockRewardHistoryEpochs {
+		return fmt.Errorf("lock reward history epochs should be at most %d: %d", MaxLockRewardHistoryEpochs, v)
+	}
+
+	return nil
+}
+
+func ValidateStaleAssetZeroingEpochs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func ValidateAutoCompoundLocksPerEpoch(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func ValidateMaxSuperfluidShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max superfluid share should be at least 0 and less than 1: %s", v.String())
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
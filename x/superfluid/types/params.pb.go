@@ -30,6 +30,22 @@ type Params struct {
 	// the risk_factor is to be cut on OSMO equivalent value of lp tokens for
 	// superfluid staking, default: 5%
 	MinimumRiskFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// the number of epochs the per epoch superfluid staking rewards of locks
+	// are kept for
+	LockRewardHistoryEpochs uint64 `protobuf:"varint,2,opt,name=lock_reward_history_epochs,json=lockRewardHistoryEpochs,proto3" json:"lock_reward_history_epochs,omitempty" yaml:"lock_reward_history_epochs"`
+	// the number of epochs in a row the osmo equivalent multiplier of a
+	// superfluid asset can fail to update before it is zeroed, zero never
+	// zeroes it
+	StaleAssetZeroingEpochs uint64 `protobuf:"varint,3,opt,name=stale_asset_zeroing_epochs,json=staleAssetZeroingEpochs,proto3" json:"stale_asset_zeroing_epochs,omitempty" yaml:"stale_asset_zeroing_epochs"`
+	// the maximum number of locks whose rewards are auto-compounded at an
+	// epoch, the other locks are compounded at the next epochs
+	AutoCompoundLocksPerEpoch uint64 `protobuf:"varint,4,opt,name=auto_compound_locks_per_epoch,json=autoCompoundLocksPerEpoch,proto3" json:"auto_compound_locks_per_epoch,omitempty" yaml:"auto_compound_locks_per_epoch"`
+	// the maximum share of a validator's tokens that can be superfluid
+	// delegated, zero is no cap
+	MaxValidatorSuperfluidShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_validator_superfluid_share,json=maxValidatorSuperfluidShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_superfluid_share" yaml:"max_validator_superfluid_share"`
+	// the maximum share of the bonded tokens that can be superfluid delegated,
+	// zero is no cap
+	MaxTotalSuperfluidShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_total_superfluid_share,json=maxTotalSuperfluidShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_total_superfluid_share" yaml:"max_total_superfluid_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,6 +81,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetLockRewardHistoryEpochs() uint64 {
+	if m != nil {
+		return m.LockRewardHistoryEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetStaleAssetZeroingEpochs() uint64 {
+	if m != nil {
+		return m.StaleAssetZeroingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundLocksPerEpoch() uint64 {
+	if m != nil {
+		return m.AutoCompoundLocksPerEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.superfluid.Params")
 }
@@ -72,24 +109,38 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x8d, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xb4,
-	0x50, 0x0d, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76,
-	0x7c, 0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xcf, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x27, 0x83, 0x5d, 0x01, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0xf5, 0x5c, 0x52, 0x93, 0x3f, 0xdd, 0x93, 0x97, 0xaa, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2,
-	0x62, 0xa4, 0x52, 0x90, 0x20, 0x54, 0x34, 0x28, 0xb3, 0x38, 0xdb, 0x0d, 0x2c, 0xe6, 0x14, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0x48, 0x56, 0x42, 0xfd, 0xad,
-	0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x1a, 0xe8, 0x57, 0x20, 0x87, 0x15, 0xd8,
-	0x19, 0x49, 0x6c, 0x60, 0x1f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x20, 0x36, 0xb0,
-	0x4e, 0x01, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0x68, 0x23, 0xe1, 0x1d, 0x06, 0x29, 0xc1, 0x08, 0xbb, 0xb5, 0x28, 0xca, 0xa6,
+	0x19, 0x10, 0x12, 0x0b, 0x76, 0x84, 0x1f, 0xb1, 0xe8, 0x22, 0x72, 0x10, 0x48, 0xdd, 0x8c, 0xc6,
+	0xf6, 0xc4, 0x19, 0xe2, 0xc9, 0xb5, 0x66, 0xc6, 0xc5, 0x41, 0x3c, 0x44, 0x1f, 0x80, 0x07, 0xea,
+	0xb2, 0x4b, 0xc4, 0xc2, 0x42, 0xc9, 0x96, 0x55, 0x9e, 0x00, 0xcd, 0xd8, 0x21, 0x55, 0xff, 0xa4,
+	0xac, 0xec, 0x99, 0xef, 0xf8, 0x9c, 0x23, 0x5d, 0x5f, 0xdb, 0x07, 0xc9, 0x41, 0x32, 0x89, 0x64,
+	0x91, 0x53, 0x31, 0xce, 0x0a, 0x96, 0xa0, 0x9c, 0x08, 0xc2, 0x65, 0x3f, 0x17, 0xa0, 0xc0, 0x71,
+	0x1a, 0x41, 0x7f, 0x23, 0x70, 0x1f, 0xa6, 0x90, 0x82, 0xc1, 0x48, 0xbf, 0xd5, 0x4a, 0xd7, 0x4b,
+	0x01, 0xd2, 0x8c, 0x22, 0x73, 0x8a, 0x8a, 0x31, 0x4a, 0x0a, 0x41, 0x14, 0x83, 0x59, 0xcd, 0x83,
+	0xbf, 0xbb, 0x76, 0x7b, 0x68, 0xac, 0x9d, 0x1f, 0xf6, 0x03, 0xce, 0x66, 0x8c, 0x17, 0x1c, 0x0b,
+	0x26, 0xa7, 0x78, 0x4c, 0x62, 0x05, 0xa2, 0x6b, 0xed, 0x59, 0xbd, 0x7b, 0x83, 0xa3, 0xb3, 0xca,
+	0x6f, 0xfd, 0xae, 0xfc, 0x67, 0x29, 0x53, 0x93, 0x22, 0xea, 0xc7, 0xc0, 0x51, 0x6c, 0x5a, 0x34,
+	0x8f, 0x43, 0x99, 0x4c, 0x91, 0x9a, 0xe7, 0x54, 0xf6, 0xdf, 0xd1, 0x78, 0x55, 0xf9, 0xee, 0x9c,
+	0xf0, 0xec, 0x75, 0x70, 0x8d, 0x65, 0x10, 0xde, 0x6f, 0x6e, 0x43, 0x26, 0xa7, 0x1f, 0xcc, 0x9d,
+	0x13, 0xd9, 0x6e, 0x06, 0xf1, 0x14, 0x0b, 0xfa, 0x8d, 0x88, 0x04, 0x4f, 0x98, 0x54, 0x20, 0xe6,
+	0x98, 0xe6, 0x10, 0x4f, 0x64, 0xf7, 0xce, 0x9e, 0xd5, 0xdb, 0x19, 0x1c, 0xac, 0x2a, 0x7f, 0xbf,
+	0xb6, 0xbd, 0x59, 0x1b, 0x84, 0x1d, 0x0d, 0x43, 0xc3, 0x3e, 0xd6, 0xe8, 0xbd, 0x21, 0x3a, 0x43,
+	0x2a, 0x92, 0x51, 0x4c, 0xa4, 0xa4, 0x0a, 0x7f, 0xa7, 0x02, 0xd8, 0x2c, 0x5d, 0x67, 0xdc, 0xbd,
+	0x9c, 0x71, 0xb3, 0x36, 0x08, 0x3b, 0x06, 0xbe, 0xd1, 0xec, 0xb8, 0x46, 0x4d, 0xc6, 0x57, 0xfb,
+	0x09, 0x29, 0x14, 0xe0, 0x18, 0x78, 0x0e, 0xc5, 0x2c, 0xc1, 0xba, 0x8c, 0xc4, 0x39, 0x15, 0xf5,
+	0xb7, 0xdd, 0x1d, 0x13, 0xd3, 0x5b, 0x55, 0xfe, 0xd3, 0x3a, 0xe6, 0x56, 0x79, 0x10, 0x3e, 0xd2,
+	0xfc, 0x6d, 0x83, 0x8f, 0x34, 0x1d, 0x52, 0x61, 0xc2, 0x9c, 0x9f, 0x96, 0xed, 0x71, 0x52, 0xe2,
+	0x13, 0x92, 0xb1, 0x84, 0x28, 0x10, 0x78, 0xf3, 0x3f, 0x60, 0x39, 0x21, 0x82, 0x76, 0x77, 0xcd,
+	0xf4, 0xbe, 0x6c, 0x3d, 0xbd, 0x83, 0x66, 0x7a, 0xb7, 0xba, 0x07, 0xe1, 0x63, 0x4e, 0xca, 0xcf,
+	0x6b, 0x3e, 0xfa, 0x8f, 0x47, 0x9a, 0x3a, 0xa7, 0x96, 0xed, 0x6a, 0x03, 0x05, 0x8a, 0x64, 0x57,
+	0xab, 0xb5, 0x4d, 0xb5, 0xd1, 0xd6, 0xd5, 0xf6, 0x37, 0xd5, 0xae, 0x77, 0x0e, 0xc2, 0x0e, 0x27,
+	0xe5, 0x27, 0xcd, 0x2e, 0x55, 0x1a, 0x0c, 0xcf, 0x16, 0x9e, 0x75, 0xbe, 0xf0, 0xac, 0x3f, 0x0b,
+	0xcf, 0x3a, 0x5d, 0x7a, 0xad, 0xf3, 0xa5, 0xd7, 0xfa, 0xb5, 0xf4, 0x5a, 0xc7, 0xaf, 0x2e, 0xe4,
+	0x37, 0xdb, 0x75, 0x98, 0x91, 0x48, 0xae, 0x0f, 0xe8, 0xe4, 0xc5, 0x73, 0x54, 0x5e, 0xdc, 0x48,
+	0xd3, 0x29, 0x6a, 0x9b, 0x3d, 0x7a, 0xf9, 0x6f, 0x00, 0x8d, 0x3a, 0x27, 0xa1, 0xb4, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +163,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxTotalSuperfluidShare.Size()
+		i -= size
+		if _, err := m.MaxTotalSuperfluidShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxValidatorSuperfluidShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorSuperfluidShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AutoCompoundLocksPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundLocksPerEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StaleAssetZeroingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StaleAssetZeroingEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LockRewardHistoryEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LockRewardHistoryEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -144,6 +230,19 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.LockRewardHistoryEpochs != 0 {
+		n += 1 + sovParams(uint64(m.LockRewardHistoryEpochs))
+	}
+	if m.StaleAssetZeroingEpochs != 0 {
+		n += 1 + sovParams(uint64(m.StaleAssetZeroingEpochs))
+	}
+	if m.AutoCompoundLocksPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.AutoCompoundLocksPerEpoch))
+	}
+	l = m.MaxValidatorSuperfluidShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxTotalSuperfluidShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -216,6 +315,131 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardHistoryEpochs", wireType)
+			}
+			m.LockRewardHistoryEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockRewardHistoryEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAssetZeroingEpochs", wireType)
+			}
+			m.StaleAssetZeroingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleAssetZeroingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundLocksPerEpoch", wireType)
+			}
+			m.AutoCompoundLocksPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundLocksPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorSuperfluidShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorSuperfluidShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSuperfluidShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalSuperfluidShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1029,6 +1029,388 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) GetSyntheticLocks() []types
 	return nil
 }
 
+type SuperfluidRedelegationsByDelegatorRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *SuperfluidRedelegationsByDelegatorRequest) Reset() {
+	*m = SuperfluidRedelegationsByDelegatorRequest{}
+}
+func (m *SuperfluidRedelegationsByDelegatorRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SuperfluidRedelegationsByDelegatorRequest) ProtoMessage() {}
+func (*SuperfluidRedelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{21}
+}
+func (m *SuperfluidRedelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidRedelegationsByDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidRedelegationsByDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidRedelegationsByDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidRedelegationsByDelegatorRequest.Merge(m, src)
+}
+func (m *SuperfluidRedelegationsByDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidRedelegationsByDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidRedelegationsByDelegatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidRedelegationsByDelegatorRequest proto.InternalMessageInfo
+
+func (m *SuperfluidRedelegationsByDelegatorRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+type SuperfluidRedelegationsByDelegatorResponse struct {
+	SuperfluidRedelegationRecords []SuperfluidRedelegationRecord           `protobuf:"bytes,1,rep,name=superfluid_redelegation_records,json=superfluidRedelegationRecords,proto3" json:"superfluid_redelegation_records"`
+	TotalRedelegatedCoins         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_redelegated_coins,json=totalRedelegatedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_redelegated_coins"`
+}
+
+func (m *SuperfluidRedelegationsByDelegatorResponse) Reset() {
+	*m = SuperfluidRedelegationsByDelegatorResponse{}
+}
+func (m *SuperfluidRedelegationsByDelegatorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SuperfluidRedelegationsByDelegatorResponse) ProtoMessage() {}
+func (*SuperfluidRedelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{22}
+}
+func (m *SuperfluidRedelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidRedelegationsByDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidRedelegationsByDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidRedelegationsByDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidRedelegationsByDelegatorResponse.Merge(m, src)
+}
+func (m *SuperfluidRedelegationsByDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidRedelegationsByDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidRedelegationsByDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidRedelegationsByDelegatorResponse proto.InternalMessageInfo
+
+func (m *SuperfluidRedelegationsByDelegatorResponse) GetSuperfluidRedelegationRecords() []SuperfluidRedelegationRecord {
+	if m != nil {
+		return m.SuperfluidRedelegationRecords
+	}
+	return nil
+}
+
+func (m *SuperfluidRedelegationsByDelegatorResponse) GetTotalRedelegatedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRedelegatedCoins
+	}
+	return nil
+}
+
+type SuperfluidLockRewardsRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *SuperfluidLockRewardsRequest) Reset()         { *m = SuperfluidLockRewardsRequest{} }
+func (m *SuperfluidLockRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardsRequest) ProtoMessage()    {}
+func (*SuperfluidLockRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{23}
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardsRequest.Merge(m, src)
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidLockRewardsRequest proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewardsRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type SuperfluidLockRewardsResponse struct {
+	AccruedRewards      []SuperfluidLockRewards                  `protobuf:"bytes,1,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards"`
+	TotalAccruedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_accrued_rewards,json=totalAccruedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_accrued_rewards"`
+	History             []SuperfluidLockRewardRecord             `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+}
+
+func (m *SuperfluidLockRewardsResponse) Reset()         { *m = SuperfluidLockRewardsResponse{} }
+func (m *SuperfluidLockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardsResponse) ProtoMessage()    {}
+func (*SuperfluidLockRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{24}
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardsResponse.Merge(m, src)
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidLockRewardsResponse proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewardsResponse) GetAccruedRewards() []SuperfluidLockRewards {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+func (m *SuperfluidLockRewardsResponse) GetTotalAccruedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAccruedRewards
+	}
+	return nil
+}
+
+func (m *SuperfluidLockRewardsResponse) GetHistory() []SuperfluidLockRewardRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type StaleAssetsRequest struct {
+}
+
+func (m *StaleAssetsRequest) Reset()         { *m = StaleAssetsRequest{} }
+func (m *StaleAssetsRequest) String() string { return proto.CompactTextString(m) }
+func (*StaleAssetsRequest) ProtoMessage()    {}
+func (*StaleAssetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{25}
+}
+func (m *StaleAssetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleAssetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StaleAssetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleAssetsRequest.Merge(m, src)
+}
+func (m *StaleAssetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleAssetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleAssetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleAssetsRequest proto.InternalMessageInfo
+
+type StaleAssetsResponse struct {
+	StaleAssets []StaleSuperfluidAsset `protobuf:"bytes,1,rep,name=stale_assets,json=staleAssets,proto3" json:"stale_assets"`
+}
+
+func (m *StaleAssetsResponse) Reset()         { *m = StaleAssetsResponse{} }
+func (m *StaleAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*StaleAssetsResponse) ProtoMessage()    {}
+func (*StaleAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{26}
+}
+func (m *StaleAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StaleAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleAssetsResponse.Merge(m, src)
+}
+func (m *StaleAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleAssetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleAssetsResponse proto.InternalMessageInfo
+
+func (m *StaleAssetsResponse) GetStaleAssets() []StaleSuperfluidAsset {
+	if m != nil {
+		return m.StaleAssets
+	}
+	return nil
+}
+
+type SuperfluidDelegationHeadroomRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *SuperfluidDelegationHeadroomRequest) Reset()         { *m = SuperfluidDelegationHeadroomRequest{} }
+func (m *SuperfluidDelegationHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidDelegationHeadroomRequest) ProtoMessage()    {}
+func (*SuperfluidDelegationHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{27}
+}
+func (m *SuperfluidDelegationHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidDelegationHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidDelegationHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidDelegationHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidDelegationHeadroomRequest.Merge(m, src)
+}
+func (m *SuperfluidDelegationHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidDelegationHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidDelegationHeadroomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidDelegationHeadroomRequest proto.InternalMessageInfo
+
+func (m *SuperfluidDelegationHeadroomRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type SuperfluidDelegationHeadroomResponse struct {
+	// the osmo superfluid delegated to the validator
+	ValidatorSuperfluidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=validator_superfluid_amount,json=validatorSuperfluidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_superfluid_amount"`
+	// the tokens of the validator
+	ValidatorTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=validator_tokens,json=validatorTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_tokens"`
+	// the osmo that can still be superfluid delegated to the validator, unset
+	// when there is no per validator cap
+	ValidatorHeadroom *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=validator_headroom,json=validatorHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_headroom,omitempty"`
+	// the osmo superfluid delegated to all the validators
+	TotalSuperfluidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_superfluid_amount,json=totalSuperfluidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_superfluid_amount"`
+	// the bonded tokens
+	TotalBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_bonded_tokens,json=totalBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_bonded_tokens"`
+	// the osmo that can still be superfluid delegated to any validator, unset
+	// when there is no global cap
+	TotalHeadroom *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=total_headroom,json=totalHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_headroom,omitempty"`
+}
+
+func (m *SuperfluidDelegationHeadroomResponse) Reset()         { *m = SuperfluidDelegationHeadroomResponse{} }
+func (m *SuperfluidDelegationHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidDelegationHeadroomResponse) ProtoMessage()    {}
+func (*SuperfluidDelegationHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{28}
+}
+func (m *SuperfluidDelegationHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidDelegationHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidDelegationHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidDelegationHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidDelegationHeadroomResponse.Merge(m, src)
+}
+func (m *SuperfluidDelegationHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidDelegationHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidDelegationHeadroomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidDelegationHeadroomResponse proto.InternalMessageInfo
+
 type SuperfluidDelegationsByValidatorDenomRequest struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -1042,7 +1424,7 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomRequest) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{21}
+	return fileDescriptor_e3d9448e4ed3943f, []int{29}
 }
 func (m *SuperfluidDelegationsByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1479,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomResponse) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{22}
+	return fileDescriptor_e3d9448e4ed3943f, []int{30}
 }
 func (m *SuperfluidDelegationsByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1528,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) String() stri
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{23}
+	return fileDescriptor_e3d9448e4ed3943f, []int{31}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1583,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) String() str
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{24}
+	return fileDescriptor_e3d9448e4ed3943f, []int{32}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,136 +1619,428 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) GetTotalDele
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
-	proto.RegisterType((*AssetTypeRequest)(nil), "osmosis.superfluid.AssetTypeRequest")
-	proto.RegisterType((*AssetTypeResponse)(nil), "osmosis.superfluid.AssetTypeResponse")
-	proto.RegisterType((*AllAssetsRequest)(nil), "osmosis.superfluid.AllAssetsRequest")
-	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
-	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
-	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
-	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
-	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
-	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
-	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
-	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
-	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
-	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
-	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
-	proto.RegisterType((*SuperfluidDelegationAmountResponse)(nil), "osmosis.superfluid.SuperfluidDelegationAmountResponse")
-	proto.RegisterType((*SuperfluidDelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorRequest")
-	proto.RegisterType((*SuperfluidDelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorResponse")
-	proto.RegisterType((*SuperfluidUndelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorRequest")
-	proto.RegisterType((*SuperfluidUndelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorResponse")
-	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomRequest")
-	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomResponse")
-	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomRequest")
-	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse")
+type SuperfluidUnbondingsByDelegatorRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SuperfluidUnbondingsByDelegatorRequest) Reset() {
+	*m = SuperfluidUnbondingsByDelegatorRequest{}
+}
+func (m *SuperfluidUnbondingsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidUnbondingsByDelegatorRequest) ProtoMessage()    {}
+func (*SuperfluidUnbondingsByDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{33}
+}
+func (m *SuperfluidUnbondingsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidUnbondingsByDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidUnbondingsByDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidUnbondingsByDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidUnbondingsByDelegatorRequest.Merge(m, src)
+}
+func (m *SuperfluidUnbondingsByDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidUnbondingsByDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidUnbondingsByDelegatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidUnbondingsByDelegatorRequest proto.InternalMessageInfo
+
+func (m *SuperfluidUnbondingsByDelegatorRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidUnbondingsByDelegatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type SuperfluidUnbondingsByDelegatorResponse struct {
+	SuperfluidUnbondingRecords []SuperfluidUnbondingRecord              `protobuf:"bytes,1,rep,name=superfluid_unbonding_records,json=superfluidUnbondingRecords,proto3" json:"superfluid_unbonding_records"`
+	TotalUnbondingCoins        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_unbonding_coins,json=totalUnbondingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_unbonding_coins"`
+	Pagination                 *query.PageResponse                      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) Reset() {
+	*m = SuperfluidUnbondingsByDelegatorResponse{}
+}
+func (m *SuperfluidUnbondingsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidUnbondingsByDelegatorResponse) ProtoMessage()    {}
+func (*SuperfluidUnbondingsByDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{34}
+}
+func (m *SuperfluidUnbondingsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidUnbondingsByDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidUnbondingsByDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidUnbondingsByDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidUnbondingsByDelegatorResponse.Merge(m, src)
+}
+func (m *SuperfluidUnbondingsByDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidUnbondingsByDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidUnbondingsByDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidUnbondingsByDelegatorResponse proto.InternalMessageInfo
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) GetSuperfluidUnbondingRecords() []SuperfluidUnbondingRecord {
+	if m != nil {
+		return m.SuperfluidUnbondingRecords
+	}
+	return nil
+}
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) GetTotalUnbondingCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalUnbondingCoins
+	}
+	return nil
+}
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type SuperfluidUnbondingsByValidatorDenomRequest struct {
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) Reset() {
+	*m = SuperfluidUnbondingsByValidatorDenomRequest{}
+}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SuperfluidUnbondingsByValidatorDenomRequest) ProtoMessage() {}
+func (*SuperfluidUnbondingsByValidatorDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{35}
+}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomRequest.Merge(m, src)
+}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomRequest proto.InternalMessageInfo
+
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type SuperfluidUnbondingsByValidatorDenomResponse struct {
+	SuperfluidUnbondingRecords []SuperfluidUnbondingRecord              `protobuf:"bytes,1,rep,name=superfluid_unbonding_records,json=superfluidUnbondingRecords,proto3" json:"superfluid_unbonding_records"`
+	TotalUnbondingCoins        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_unbonding_coins,json=totalUnbondingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_unbonding_coins"`
+	Pagination                 *query.PageResponse                      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) Reset() {
+	*m = SuperfluidUnbondingsByValidatorDenomResponse{}
+}
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SuperfluidUnbondingsByValidatorDenomResponse) ProtoMessage() {}
+func (*SuperfluidUnbondingsByValidatorDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{36}
+}
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomResponse.Merge(m, src)
+}
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidUnbondingsByValidatorDenomResponse proto.InternalMessageInfo
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) GetSuperfluidUnbondingRecords() []SuperfluidUnbondingRecord {
+	if m != nil {
+		return m.SuperfluidUnbondingRecords
+	}
+	return nil
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) GetTotalUnbondingCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalUnbondingCoins
+	}
+	return nil
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
+	proto.RegisterType((*AssetTypeRequest)(nil), "osmosis.superfluid.AssetTypeRequest")
+	proto.RegisterType((*AssetTypeResponse)(nil), "osmosis.superfluid.AssetTypeResponse")
+	proto.RegisterType((*AllAssetsRequest)(nil), "osmosis.superfluid.AllAssetsRequest")
+	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
+	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
+	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
+	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
+	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
+	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
+	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
+	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
+	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
+	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
+	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
+	proto.RegisterType((*SuperfluidDelegationAmountResponse)(nil), "osmosis.superfluid.SuperfluidDelegationAmountResponse")
+	proto.RegisterType((*SuperfluidDelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidDelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidUndelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidUndelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidRedelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidRedelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidRedelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidRedelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidLockRewardsRequest)(nil), "osmosis.superfluid.SuperfluidLockRewardsRequest")
+	proto.RegisterType((*SuperfluidLockRewardsResponse)(nil), "osmosis.superfluid.SuperfluidLockRewardsResponse")
+	proto.RegisterType((*StaleAssetsRequest)(nil), "osmosis.superfluid.StaleAssetsRequest")
+	proto.RegisterType((*StaleAssetsResponse)(nil), "osmosis.superfluid.StaleAssetsResponse")
+	proto.RegisterType((*SuperfluidDelegationHeadroomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationHeadroomRequest")
+	proto.RegisterType((*SuperfluidDelegationHeadroomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationHeadroomResponse")
+	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomRequest")
+	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomResponse")
+	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomRequest")
+	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse")
+	proto.RegisterType((*SuperfluidUnbondingsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUnbondingsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidUnbondingsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUnbondingsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidUnbondingsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidUnbondingsByValidatorDenomRequest")
+	proto.RegisterType((*SuperfluidUnbondingsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidUnbondingsByValidatorDenomResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
 
 var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 1558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0xd4, 0xd6,
-	0x16, 0xcf, 0x9d, 0x40, 0x02, 0x07, 0x09, 0xc2, 0x85, 0xf7, 0x18, 0x0c, 0x4c, 0xc0, 0x81, 0x64,
-	0x5e, 0x00, 0x9b, 0x84, 0x07, 0xe4, 0xf1, 0x0a, 0x62, 0x42, 0x80, 0x46, 0x0a, 0x85, 0x0e, 0x24,
-	0x48, 0xfd, 0x90, 0xe5, 0x19, 0x5f, 0x06, 0x2b, 0x1e, 0x7b, 0x32, 0xd7, 0x4e, 0x19, 0x21, 0x54,
-	0x95, 0xaa, 0x52, 0x51, 0x17, 0xad, 0xc4, 0x3f, 0xd0, 0x65, 0xdb, 0x45, 0xd5, 0x5d, 0x37, 0xdd,
-	0x54, 0xdd, 0x20, 0x55, 0x95, 0x90, 0xba, 0xa9, 0xba, 0x80, 0x0a, 0xba, 0xed, 0xa6, 0xcb, 0x76,
-	0x53, 0xf9, 0xde, 0xeb, 0x8f, 0xc9, 0xd8, 0x9e, 0x99, 0x94, 0xc2, 0x6a, 0xec, 0x7b, 0x3e, 0x7f,
-	0xe7, 0xe3, 0xfa, 0x9c, 0x81, 0x82, 0x43, 0xeb, 0x0e, 0x35, 0xa9, 0x4a, 0xbd, 0x06, 0x69, 0xde,
-	0xb4, 0x3c, 0xd3, 0x50, 0x57, 0x3c, 0xd2, 0x6c, 0x29, 0x8d, 0xa6, 0xe3, 0x3a, 0x18, 0x0b, 0xba,
-	0x12, 0xd1, 0xa5, 0x9d, 0x35, 0xa7, 0xe6, 0x30, 0xb2, 0xea, 0x3f, 0x71, 0x4e, 0xa9, 0x50, 0x65,
-	0xac, 0x6a, 0x45, 0xa7, 0x44, 0x5d, 0x9d, 0xaa, 0x10, 0x57, 0x9f, 0x52, 0xab, 0x8e, 0x69, 0x0b,
-	0xfa, 0xde, 0x9a, 0xe3, 0xd4, 0x2c, 0xa2, 0xea, 0x0d, 0x53, 0xd5, 0x6d, 0xdb, 0x71, 0x75, 0xd7,
-	0x74, 0x6c, 0x2a, 0xa8, 0xa3, 0x82, 0xca, 0xde, 0x2a, 0xde, 0x4d, 0xd5, 0x35, 0xeb, 0x84, 0xba,
-	0x7a, 0xbd, 0x11, 0xa8, 0x5f, 0xcb, 0x60, 0x78, 0x4d, 0xa6, 0x41, 0xd0, 0xc7, 0x12, 0x80, 0x44,
-	0x8f, 0x81, 0x95, 0x04, 0xa6, 0x86, 0xde, 0xd4, 0xeb, 0x81, 0x1b, 0xbb, 0x03, 0x06, 0xcb, 0xa9,
-	0x2e, 0x7b, 0x0d, 0xf6, 0x23, 0x48, 0x93, 0x71, 0x7c, 0x2c, 0x44, 0x21, 0xca, 0x86, 0x5e, 0x33,
-	0xed, 0x98, 0x33, 0xf2, 0x4e, 0xc0, 0xaf, 0xfb, 0x1c, 0x57, 0x99, 0xee, 0x32, 0x59, 0xf1, 0x08,
-	0x75, 0xe5, 0x2b, 0xb0, 0xa3, 0xed, 0x94, 0x36, 0x1c, 0x9b, 0x12, 0x3c, 0x03, 0x43, 0xdc, 0x87,
-	0x3c, 0xda, 0x8f, 0x8a, 0x5b, 0xa6, 0x25, 0xa5, 0x33, 0xe6, 0x0a, 0x97, 0x99, 0xdd, 0xf0, 0xf0,
-	0xf1, 0xe8, 0x40, 0x59, 0xf0, 0xcb, 0x45, 0x18, 0x29, 0x51, 0x4a, 0xdc, 0xeb, 0xad, 0x06, 0x11,
-	0x46, 0xf0, 0x4e, 0xd8, 0x68, 0x10, 0xdb, 0xa9, 0x33, 0x65, 0x9b, 0xcb, 0xfc, 0x45, 0x7e, 0x13,
-	0xb6, 0xc7, 0x38, 0x85, 0xe1, 0x8b, 0x00, 0xba, 0x7f, 0xa8, 0xb9, 0xad, 0x06, 0x61, 0xfc, 0x5b,
-	0xa7, 0x27, 0x92, 0x8c, 0x5f, 0x0b, 0x1f, 0x23, 0x25, 0x9b, 0xf5, 0xe0, 0x51, 0xc6, 0x30, 0x52,
-	0xb2, 0x2c, 0x46, 0x0a, 0xb1, 0x2e, 0xc1, 0xf6, 0xd8, 0x99, 0x30, 0x58, 0x82, 0x21, 0x26, 0xe5,
-	0x23, 0x1d, 0x2c, 0x6e, 0x99, 0x1e, 0xeb, 0xc1, 0x58, 0x00, 0x99, 0x0b, 0xca, 0x0a, 0xfc, 0x9b,
-	0x1d, 0x5f, 0xf6, 0x2c, 0xd7, 0x6c, 0x58, 0x26, 0x69, 0x66, 0x03, 0xff, 0x08, 0xc1, 0xae, 0x0e,
-	0x01, 0xe1, 0x4e, 0x03, 0x24, 0xdf, 0xbe, 0x46, 0x56, 0x3c, 0x73, 0x55, 0xb7, 0x88, 0xed, 0x6a,
-	0xf5, 0x90, 0x4b, 0x24, 0x63, 0x3a, 0xc9, 0xc5, 0x2b, 0xb4, 0xee, 0x5c, 0x08, 0x85, 0xe2, 0x9a,
-	0xab, 0x4e, 0xd3, 0x28, 0xe7, 0x9d, 0x14, 0xba, 0x7c, 0x1f, 0xc1, 0x81, 0x08, 0xdf, 0xbc, 0xed,
-	0x92, 0x66, 0x9d, 0x18, 0xa6, 0xde, 0x6c, 0x95, 0xaa, 0x55, 0xc7, 0xb3, 0xdd, 0x79, 0xfb, 0xa6,
-	0x93, 0x8c, 0x04, 0xef, 0x86, 0x4d, 0xab, 0xba, 0xa5, 0xe9, 0x86, 0xd1, 0xcc, 0xe7, 0x18, 0x61,
-	0x78, 0x55, 0xb7, 0x4a, 0x86, 0xd1, 0xf4, 0x49, 0x35, 0xdd, 0xab, 0x11, 0xcd, 0x34, 0xf2, 0x83,
-	0xfb, 0x51, 0x71, 0x43, 0x79, 0x98, 0xbd, 0xcf, 0x1b, 0x38, 0x0f, 0xc3, 0xbe, 0x04, 0xa1, 0x34,
-	0xbf, 0x81, 0x0b, 0x89, 0x57, 0xf9, 0x16, 0x14, 0x4a, 0x96, 0x95, 0xe0, 0x43, 0x90, 0x43, 0xbf,
-	0x3e, 0xa2, 0xca, 0x16, 0xf1, 0x18, 0x57, 0x78, 0x1b, 0x28, 0x7e, 0x1b, 0x28, 0xfc, 0xa6, 0x10,
-	0x6d, 0xa0, 0x5c, 0xd5, 0x6b, 0x41, 0x19, 0x96, 0x63, 0x92, 0xf2, 0x77, 0x08, 0x46, 0x53, 0x4d,
-	0x89, 0x5c, 0xdc, 0x80, 0x4d, 0xba, 0x38, 0x13, 0xc5, 0x71, 0x22, 0xbb, 0x38, 0x52, 0x82, 0x27,
-	0xca, 0x25, 0x54, 0x86, 0x2f, 0xb5, 0x81, 0xc8, 0x31, 0x10, 0x13, 0x5d, 0x41, 0x70, 0xaf, 0xda,
-	0x50, 0x9c, 0x85, 0xb1, 0xf3, 0x8e, 0x6d, 0x93, 0xaa, 0x4b, 0x92, 0x8c, 0x07, 0x41, 0xdb, 0x05,
-	0xc3, 0xfe, 0xa5, 0xe1, 0xa7, 0x02, 0xb1, 0x54, 0x0c, 0xf9, 0xaf, 0xf3, 0x86, 0xfc, 0x0e, 0x1c,
-	0xcc, 0x96, 0x17, 0x91, 0xb8, 0x02, 0xc3, 0xc2, 0x79, 0x11, 0xf2, 0xf5, 0x05, 0xa2, 0x1c, 0x68,
-	0x91, 0xc7, 0xe0, 0xc0, 0x75, 0xc7, 0xd5, 0xad, 0x48, 0x64, 0x8e, 0x58, 0xa4, 0xc6, 0xaf, 0xdf,
-	0xa0, 0x5f, 0x3f, 0x43, 0x20, 0x67, 0x71, 0x09, 0xe7, 0xde, 0x43, 0x30, 0xe2, 0xfa, 0x6c, 0x31,
-	0x22, 0x2f, 0xd3, 0xd9, 0x45, 0x3f, 0xf0, 0x3f, 0x3f, 0x1e, 0x1d, 0xaf, 0x99, 0xee, 0x2d, 0xaf,
-	0xa2, 0x54, 0x9d, 0xba, 0x2a, 0xae, 0x4c, 0xfe, 0x73, 0x94, 0x1a, 0xcb, 0xaa, 0x7f, 0xd5, 0x50,
-	0x65, 0xde, 0x76, 0x7f, 0x7f, 0x3c, 0x3a, 0xd6, 0xd2, 0xeb, 0xd6, 0x69, 0x99, 0xe9, 0xd3, 0x22,
-	0x6c, 0x9a, 0x11, 0xe9, 0x96, 0xcb, 0x1d, 0xe6, 0xe4, 0x07, 0x6d, 0x4d, 0x14, 0x51, 0x4a, 0xf5,
-	0x78, 0x1e, 0x0e, 0xc3, 0x76, 0xa1, 0xc7, 0x69, 0x6a, 0x41, 0x0b, 0xf0, 0x86, 0x1a, 0x09, 0x09,
-	0x25, 0x7e, 0xee, 0x33, 0xaf, 0xea, 0x96, 0x69, 0xb4, 0x31, 0xf3, 0x26, 0x1b, 0x09, 0x09, 0x01,
-	0x73, 0xd8, 0x9e, 0x83, 0xf1, 0x8b, 0xe6, 0x3e, 0x02, 0x39, 0xcb, 0x2b, 0x11, 0xc0, 0x2a, 0x0c,
-	0xe9, 0x75, 0x91, 0x5c, 0xbf, 0xca, 0x77, 0xb7, 0x95, 0x62, 0x50, 0x84, 0xe7, 0x1d, 0xd3, 0x9e,
-	0x3d, 0xe6, 0x07, 0xf4, 0x8b, 0x27, 0xa3, 0xc5, 0x1e, 0x02, 0xea, 0x0b, 0xd0, 0xb2, 0x50, 0x2d,
-	0x2f, 0xc1, 0x44, 0x62, 0x1a, 0x67, 0x5b, 0x73, 0x01, 0xf2, 0xf5, 0x84, 0x49, 0xfe, 0x7a, 0x10,
-	0x8a, 0xdd, 0x15, 0x0b, 0xa4, 0xb7, 0x61, 0x5f, 0x62, 0x4e, 0xb5, 0x26, 0xbb, 0x25, 0x83, 0x36,
-	0x57, 0xb2, 0xab, 0x3b, 0x32, 0xc2, 0x2f, 0x57, 0xd1, 0xdf, 0x7b, 0x68, 0x2a, 0x07, 0xc5, 0xef,
-	0xc2, 0xbf, 0x78, 0x4d, 0x09, 0xa3, 0xc4, 0xd0, 0xfc, 0x39, 0xc4, 0xcf, 0xe8, 0x73, 0x0f, 0xf9,
-	0x8e, 0x78, 0x79, 0x12, 0x83, 0x1d, 0xe2, 0x8f, 0x11, 0x14, 0xb8, 0x07, 0xb1, 0x4f, 0x0b, 0x75,
-	0xf5, 0x65, 0x62, 0x68, 0x22, 0xfb, 0x83, 0xac, 0xb5, 0x33, 0x5c, 0x51, 0x85, 0x2b, 0x13, 0x3d,
-	0xba, 0x52, 0xde, 0xc3, 0x2c, 0x46, 0x9f, 0x9d, 0x6b, 0xcc, 0x1e, 0x2f, 0x3f, 0xd9, 0x86, 0xff,
-	0x44, 0x31, 0x5d, 0xb4, 0x8d, 0xe7, 0x56, 0x13, 0x51, 0x37, 0xe4, 0xe2, 0xdd, 0xf0, 0x47, 0x0e,
-	0x26, 0x7b, 0x31, 0xf8, 0xd2, 0x6b, 0xe5, 0x7d, 0x04, 0xbb, 0x78, 0xaa, 0x3c, 0xfb, 0x05, 0x94,
-	0x0b, 0x2f, 0xcc, 0xc5, 0xc8, 0x14, 0x2f, 0x98, 0x05, 0xd8, 0x46, 0x5b, 0xb6, 0x7b, 0x8b, 0xb8,
-	0x66, 0x55, 0xf3, 0xbf, 0x17, 0x34, 0x3f, 0xc8, 0x8c, 0xef, 0x0b, 0x11, 0xf3, 0x81, 0x54, 0xb9,
-	0x16, 0xb0, 0x2d, 0x38, 0xd5, 0x65, 0x01, 0x70, 0x2b, 0x8d, 0x1f, 0x52, 0x79, 0x05, 0x8e, 0xa4,
-	0x74, 0xe9, 0x52, 0x70, 0x97, 0xcd, 0xf9, 0x59, 0x8a, 0xe5, 0xbb, 0xf3, 0xf6, 0x43, 0xdd, 0x6e,
-	0xbf, 0xb6, 0x7c, 0x7f, 0x8e, 0xe0, 0x68, 0x8f, 0x36, 0x5f, 0x76, 0xca, 0xe5, 0xbb, 0x30, 0x73,
-	0x81, 0xba, 0x66, 0x5d, 0x77, 0x49, 0x87, 0xa2, 0xa0, 0x61, 0xfe, 0xc1, 0x50, 0x7d, 0x83, 0xe0,
-	0x7f, 0xeb, 0xb0, 0x2f, 0xc2, 0x96, 0x7a, 0xb7, 0xa1, 0x17, 0x73, 0xb7, 0x4d, 0x7f, 0xb5, 0x03,
-	0x36, 0xb2, 0x2d, 0x06, 0x7f, 0x80, 0x60, 0x88, 0xaf, 0x25, 0x78, 0x3c, 0x29, 0x4b, 0x9d, 0x1b,
-	0x90, 0x34, 0xd1, 0x95, 0x8f, 0xc3, 0x94, 0x27, 0xef, 0xfd, 0xf8, 0xeb, 0x83, 0xdc, 0x41, 0x2c,
-	0xab, 0x09, 0x1b, 0x5b, 0xb4, 0x76, 0x31, 0xe3, 0x1f, 0x22, 0xd8, 0x1c, 0xee, 0x25, 0xf8, 0x60,
-	0x92, 0x89, 0xb5, 0x5b, 0x92, 0x74, 0xa8, 0x0b, 0x97, 0x70, 0x43, 0x61, 0x6e, 0x14, 0xf1, 0x78,
-	0x96, 0x1b, 0xd1, 0x0e, 0xc5, 0x5d, 0x09, 0xd6, 0x9e, 0x14, 0x57, 0xd6, 0x6c, 0x4a, 0x29, 0xae,
-	0xac, 0xdd, 0x9d, 0x7a, 0x74, 0xc5, 0xb2, 0x34, 0xbe, 0x28, 0xe1, 0x4f, 0x11, 0x6c, 0x5b, 0xb3,
-	0xf8, 0xe0, 0xc9, 0x54, 0xd4, 0x1d, 0xeb, 0x94, 0x74, 0xb8, 0x27, 0x5e, 0xe1, 0xdc, 0x7f, 0x99,
-	0x73, 0x0a, 0x3e, 0xd2, 0x3d, 0x4e, 0xd1, 0x86, 0x85, 0xbf, 0xf5, 0x77, 0xb3, 0xe4, 0xbd, 0x00,
-	0x4f, 0xa7, 0x44, 0x25, 0x63, 0x5f, 0x91, 0x8e, 0xf7, 0x25, 0x23, 0x5c, 0x3f, 0xc3, 0x5c, 0x3f,
-	0x85, 0x4f, 0x74, 0x8b, 0xab, 0x19, 0xd3, 0xa2, 0x85, 0xeb, 0xc5, 0x13, 0x04, 0x7b, 0xb3, 0xc6,
-	0x7a, 0x7c, 0x2a, 0xc9, 0xa9, 0x1e, 0x16, 0x09, 0x69, 0xa6, 0x7f, 0x41, 0x01, 0x69, 0x81, 0x41,
-	0xba, 0x88, 0xe7, 0xb2, 0x20, 0x55, 0x03, 0x4d, 0x89, 0xc0, 0xd4, 0x3b, 0x62, 0x89, 0xb9, 0x8b,
-	0xbf, 0x47, 0x20, 0xa5, 0x6f, 0x06, 0x38, 0x71, 0x3b, 0xe9, 0xba, 0x6f, 0x48, 0x27, 0xfb, 0x15,
-	0x13, 0xd8, 0xce, 0x32, 0x6c, 0x33, 0xf8, 0x64, 0xb7, 0x74, 0x25, 0xef, 0x13, 0xf8, 0x07, 0x04,
-	0x52, 0xfa, 0x98, 0x8e, 0x4f, 0xf4, 0xfa, 0xb9, 0x69, 0x5b, 0x36, 0x92, 0xd1, 0x74, 0xdf, 0x06,
-	0xe4, 0x73, 0x0c, 0xcd, 0x69, 0x3c, 0x93, 0x85, 0x26, 0xf9, 0x33, 0xc9, 0xe7, 0x48, 0xfc, 0x1b,
-	0x82, 0xfd, 0xdd, 0x46, 0x72, 0xfc, 0xff, 0x5e, 0xdd, 0x4b, 0x98, 0x06, 0xa5, 0x57, 0xd6, 0x27,
-	0x2c, 0x10, 0xbe, 0xc6, 0x10, 0xbe, 0x8a, 0x2f, 0xf6, 0x8d, 0x90, 0xaa, 0x77, 0x3a, 0xa6, 0xd0,
-	0xbb, 0xf8, 0x5e, 0x2e, 0xbe, 0x66, 0xa5, 0x0d, 0x96, 0xf8, 0x4c, 0xb6, 0xd3, 0x5d, 0x26, 0x60,
-	0xe9, 0xec, 0x7a, 0xc5, 0x05, 0xea, 0xb7, 0x19, 0xea, 0x1b, 0x78, 0xb1, 0x47, 0xd4, 0x5e, 0x5c,
-	0xa1, 0x56, 0x69, 0x69, 0x21, 0xf2, 0xc4, 0x20, 0xfc, 0x89, 0xe0, 0x50, 0x4f, 0xd3, 0x16, 0x3e,
-	0xd7, 0x47, 0xf2, 0x12, 0x27, 0x1e, 0xa9, 0xf4, 0x37, 0x34, 0x88, 0x68, 0x5c, 0x66, 0xd1, 0xb8,
-	0x84, 0x2f, 0xf4, 0x5f, 0x03, 0x7e, 0x2c, 0xa2, 0x81, 0x8b, 0xff, 0x11, 0xf6, 0x65, 0x0e, 0xa6,
-	0xfa, 0x1e, 0xa0, 0xf0, 0x42, 0x12, 0x8e, 0xf5, 0xce, 0x81, 0xd2, 0xe5, 0xe7, 0xa4, 0x4d, 0x44,
-	0xe8, 0x2d, 0x16, 0xa1, 0x25, 0x7c, 0x3d, 0x2b, 0x42, 0x44, 0xa8, 0xd7, 0xb2, 0x2e, 0x84, 0x84,
-	0x80, 0xcd, 0x5e, 0x7d, 0xf8, 0xb4, 0x80, 0x1e, 0x3d, 0x2d, 0xa0, 0x5f, 0x9e, 0x16, 0xd0, 0x27,
-	0xcf, 0x0a, 0x03, 0x8f, 0x9e, 0x15, 0x06, 0x7e, 0x7a, 0x56, 0x18, 0x78, 0xe3, 0x64, 0x6c, 0x16,
-	0x14, 0x96, 0x8f, 0x5a, 0x7a, 0x85, 0x86, 0x6e, 0xac, 0x4e, 0x1d, 0x53, 0x6f, 0xc7, 0x9d, 0x61,
-	0xf3, 0x61, 0x65, 0x88, 0xfd, 0xcd, 0x7d, 0xfc, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0xa5,
-	0x4b, 0x0c, 0x3e, 0x18, 0x00, 0x00,
+	// 2141 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
+	0x15, 0xf6, 0xac, 0x64, 0x29, 0x7e, 0x6e, 0x6d, 0x79, 0xec, 0xd4, 0x32, 0x2d, 0xad, 0x1c, 0x4a,
+	0x96, 0x14, 0xd9, 0x5e, 0x4a, 0x4a, 0x6d, 0xab, 0x4e, 0xed, 0x78, 0x15, 0x5b, 0x89, 0x00, 0xff,
+	0xd2, 0xb6, 0x92, 0xf4, 0x8f, 0xa0, 0x96, 0xe3, 0x15, 0x21, 0x2e, 0xb9, 0xe6, 0x90, 0x72, 0x16,
+	0x86, 0x5b, 0x34, 0x45, 0x81, 0x06, 0x3d, 0x34, 0x40, 0xce, 0x05, 0x7a, 0x6c, 0x7b, 0xe8, 0xa1,
+	0x97, 0xf6, 0xd0, 0x4b, 0x91, 0x4b, 0xd0, 0xa2, 0x40, 0x80, 0x5e, 0x8a, 0x1e, 0x9c, 0xc0, 0x2e,
+	0x50, 0xb4, 0x40, 0x51, 0xa0, 0x97, 0x02, 0xed, 0xa5, 0xe0, 0x70, 0xf8, 0xb3, 0xda, 0x59, 0x2e,
+	0xb9, 0x96, 0x9d, 0x4b, 0x4f, 0xda, 0xe5, 0xbc, 0xbf, 0xef, 0x7b, 0x6f, 0x86, 0x6f, 0xde, 0x0a,
+	0xca, 0x0e, 0x6d, 0x38, 0xd4, 0xa4, 0x0a, 0xf5, 0x9b, 0xc4, 0xbd, 0x6b, 0xf9, 0xa6, 0xa1, 0xdc,
+	0xf3, 0x89, 0xdb, 0xaa, 0x34, 0x5d, 0xc7, 0x73, 0x30, 0xe6, 0xeb, 0x95, 0x64, 0x5d, 0x3a, 0x54,
+	0x77, 0xea, 0x0e, 0x5b, 0x56, 0x82, 0x4f, 0xa1, 0xa4, 0x54, 0xae, 0x31, 0x51, 0x65, 0x5d, 0xa7,
+	0x44, 0xd9, 0x5a, 0x58, 0x27, 0x9e, 0xbe, 0xa0, 0xd4, 0x1c, 0xd3, 0xe6, 0xeb, 0x63, 0x75, 0xc7,
+	0xa9, 0x5b, 0x44, 0xd1, 0x9b, 0xa6, 0xa2, 0xdb, 0xb6, 0xe3, 0xe9, 0x9e, 0xe9, 0xd8, 0x94, 0xaf,
+	0x4e, 0xf0, 0x55, 0xf6, 0x6d, 0xdd, 0xbf, 0xab, 0x78, 0x66, 0x83, 0x50, 0x4f, 0x6f, 0x34, 0x23,
+	0xf3, 0xdb, 0x05, 0x0c, 0xdf, 0x65, 0x16, 0xf8, 0xfa, 0xa4, 0x00, 0x48, 0xf2, 0x31, 0xf2, 0x22,
+	0x10, 0x6a, 0xea, 0xae, 0xde, 0x88, 0xc2, 0x38, 0x12, 0x09, 0x58, 0x4e, 0x6d, 0xd3, 0x6f, 0xb2,
+	0x3f, 0x7c, 0x69, 0x2e, 0x8d, 0x8f, 0x51, 0x14, 0xa3, 0x6c, 0xea, 0x75, 0xd3, 0x4e, 0x05, 0x23,
+	0x1f, 0x02, 0x7c, 0x33, 0x90, 0xb8, 0xc1, 0x6c, 0xab, 0xe4, 0x9e, 0x4f, 0xa8, 0x27, 0x5f, 0x87,
+	0x83, 0x6d, 0x4f, 0x69, 0xd3, 0xb1, 0x29, 0xc1, 0x4b, 0x30, 0x14, 0xc6, 0x30, 0x8a, 0x8e, 0xa1,
+	0xd9, 0xbd, 0x8b, 0x52, 0xa5, 0x93, 0xf3, 0x4a, 0xa8, 0xb3, 0x3c, 0xf8, 0xf1, 0xa3, 0x89, 0x5d,
+	0x2a, 0x97, 0x97, 0x67, 0x61, 0xa4, 0x4a, 0x29, 0xf1, 0x6e, 0xb7, 0x9a, 0x84, 0x3b, 0xc1, 0x87,
+	0x60, 0xb7, 0x41, 0x6c, 0xa7, 0xc1, 0x8c, 0xed, 0x51, 0xc3, 0x2f, 0xf2, 0xd7, 0xe1, 0x40, 0x4a,
+	0x92, 0x3b, 0x5e, 0x01, 0xd0, 0x83, 0x87, 0x9a, 0xd7, 0x6a, 0x12, 0x26, 0xbf, 0x6f, 0x71, 0x46,
+	0xe4, 0xfc, 0x56, 0xfc, 0x31, 0x31, 0xb2, 0x47, 0x8f, 0x3e, 0xca, 0x18, 0x46, 0xaa, 0x96, 0xc5,
+	0x96, 0x62, 0xac, 0x6b, 0x70, 0x20, 0xf5, 0x8c, 0x3b, 0xac, 0xc2, 0x10, 0xd3, 0x0a, 0x90, 0x0e,
+	0xcc, 0xee, 0x5d, 0x9c, 0xcc, 0xe1, 0x2c, 0x82, 0x1c, 0x2a, 0xca, 0x15, 0xf8, 0x12, 0x7b, 0x7c,
+	0xd5, 0xb7, 0x3c, 0xb3, 0x69, 0x99, 0xc4, 0xcd, 0x06, 0xfe, 0x43, 0x04, 0x87, 0x3b, 0x14, 0x78,
+	0x38, 0x4d, 0x90, 0x02, 0xff, 0x1a, 0xb9, 0xe7, 0x9b, 0x5b, 0xba, 0x45, 0x6c, 0x4f, 0x6b, 0xc4,
+	0x52, 0x3c, 0x19, 0x8b, 0xa2, 0x10, 0xaf, 0xd3, 0x86, 0x73, 0x39, 0x56, 0x4a, 0x5b, 0xae, 0x39,
+	0xae, 0xa1, 0x8e, 0x3a, 0x5d, 0xd6, 0xe5, 0xf7, 0x11, 0xbc, 0x94, 0xe0, 0x5b, 0xb5, 0x3d, 0xe2,
+	0x36, 0x88, 0x61, 0xea, 0x6e, 0xab, 0x5a, 0xab, 0x39, 0xbe, 0xed, 0xad, 0xda, 0x77, 0x1d, 0x31,
+	0x12, 0x7c, 0x04, 0x5e, 0xd8, 0xd2, 0x2d, 0x4d, 0x37, 0x0c, 0x77, 0xb4, 0xc4, 0x16, 0x86, 0xb7,
+	0x74, 0xab, 0x6a, 0x18, 0x6e, 0xb0, 0x54, 0xd7, 0xfd, 0x3a, 0xd1, 0x4c, 0x63, 0x74, 0xe0, 0x18,
+	0x9a, 0x1d, 0x54, 0x87, 0xd9, 0xf7, 0x55, 0x03, 0x8f, 0xc2, 0x70, 0xa0, 0x41, 0x28, 0x1d, 0x1d,
+	0x0c, 0x95, 0xf8, 0x57, 0x79, 0x03, 0xca, 0x55, 0xcb, 0x12, 0xc4, 0x10, 0xe5, 0x30, 0xa8, 0x8f,
+	0xa4, 0xb2, 0x39, 0x1f, 0xd3, 0x95, 0x70, 0x1b, 0x54, 0x82, 0x6d, 0x50, 0x09, 0x4f, 0x0a, 0xbe,
+	0x0d, 0x2a, 0x37, 0xf4, 0x7a, 0x54, 0x86, 0x6a, 0x4a, 0x53, 0xfe, 0x08, 0xc1, 0x44, 0x57, 0x57,
+	0x3c, 0x17, 0x6f, 0xc1, 0x0b, 0x3a, 0x7f, 0xc6, 0x8b, 0xe3, 0x74, 0x76, 0x71, 0x74, 0x21, 0x8f,
+	0x97, 0x4b, 0x6c, 0x0c, 0xbf, 0xd1, 0x06, 0xa2, 0xc4, 0x40, 0xcc, 0xf4, 0x04, 0x11, 0x46, 0xd5,
+	0x86, 0xe2, 0x02, 0x4c, 0xbe, 0xee, 0xd8, 0x36, 0xa9, 0x79, 0x44, 0xe4, 0x3c, 0x22, 0xed, 0x30,
+	0x0c, 0x07, 0x87, 0x46, 0x90, 0x0a, 0xc4, 0x52, 0x31, 0x14, 0x7c, 0x5d, 0x35, 0xe4, 0xfb, 0x30,
+	0x95, 0xad, 0xcf, 0x99, 0xb8, 0x0e, 0xc3, 0x3c, 0x78, 0x4e, 0x79, 0x7f, 0x44, 0xa8, 0x91, 0x15,
+	0x79, 0x12, 0x5e, 0xba, 0xed, 0x78, 0xba, 0x95, 0xa8, 0x5c, 0x22, 0x16, 0xa9, 0x87, 0xc7, 0x6f,
+	0xb4, 0x5f, 0x7f, 0x8a, 0x40, 0xce, 0x92, 0xe2, 0xc1, 0x7d, 0x17, 0xc1, 0x88, 0x17, 0x88, 0xa5,
+	0x16, 0xc3, 0x32, 0x5d, 0xbe, 0x13, 0x10, 0xff, 0xe7, 0x47, 0x13, 0xd3, 0x75, 0xd3, 0xdb, 0xf0,
+	0xd7, 0x2b, 0x35, 0xa7, 0xa1, 0xf0, 0x23, 0x33, 0xfc, 0x73, 0x8a, 0x1a, 0x9b, 0x4a, 0x70, 0xd4,
+	0xd0, 0xca, 0xaa, 0xed, 0xfd, 0xeb, 0xd1, 0xc4, 0x64, 0x4b, 0x6f, 0x58, 0xe7, 0x64, 0x66, 0x4f,
+	0x4b, 0xb0, 0x69, 0x46, 0x62, 0x5b, 0x56, 0x3b, 0xdc, 0xc9, 0x1f, 0xb6, 0x6d, 0xa2, 0x64, 0xa5,
+	0xda, 0x48, 0xe7, 0xe1, 0x04, 0x1c, 0xe0, 0x76, 0x1c, 0x57, 0x8b, 0xb6, 0x40, 0xb8, 0xa1, 0x46,
+	0xe2, 0x85, 0x6a, 0xf8, 0x3c, 0x10, 0xde, 0xd2, 0x2d, 0xd3, 0x68, 0x13, 0x0e, 0x37, 0xd9, 0x48,
+	0xbc, 0x10, 0x09, 0xc7, 0xdb, 0x73, 0x20, 0x7d, 0xd0, 0xbc, 0x8f, 0x40, 0xce, 0x8a, 0x8a, 0x13,
+	0x58, 0x83, 0x21, 0xbd, 0xc1, 0x93, 0x1b, 0x54, 0xf9, 0x91, 0xb6, 0x52, 0x8c, 0x8a, 0xf0, 0x75,
+	0xc7, 0xb4, 0x97, 0xe7, 0x03, 0x42, 0x7f, 0xfe, 0xe9, 0xc4, 0x6c, 0x0e, 0x42, 0x03, 0x05, 0xaa,
+	0x72, 0xd3, 0xf2, 0x1a, 0xcc, 0x08, 0xd3, 0xb8, 0xdc, 0xba, 0x14, 0x21, 0xef, 0x87, 0x26, 0xf9,
+	0x57, 0x03, 0x30, 0xdb, 0xdb, 0x30, 0x47, 0xfa, 0x2e, 0x8c, 0x0b, 0x73, 0xaa, 0xb9, 0xec, 0x94,
+	0x8c, 0xb6, 0x79, 0x25, 0xbb, 0xba, 0x13, 0x27, 0xe1, 0xe1, 0xca, 0xf7, 0xf7, 0x51, 0xda, 0x55,
+	0x82, 0xe2, 0xef, 0xc0, 0x8b, 0x61, 0x4d, 0x71, 0xa7, 0xc4, 0xd0, 0x82, 0x3e, 0x24, 0xc8, 0xe8,
+	0x8e, 0x53, 0x7e, 0x30, 0x5d, 0x9e, 0xc4, 0x60, 0x0f, 0xf1, 0x8f, 0x10, 0x94, 0xc3, 0x08, 0x52,
+	0xaf, 0x16, 0xea, 0xe9, 0x9b, 0xc4, 0xd0, 0x78, 0xf6, 0x07, 0x8e, 0xa1, 0xec, 0x50, 0x14, 0x1e,
+	0xca, 0x4c, 0xce, 0x50, 0xd4, 0xa3, 0xcc, 0x63, 0xf2, 0xda, 0xb9, 0xc5, 0xfc, 0x85, 0xe5, 0x27,
+	0xdb, 0xf0, 0x72, 0xc2, 0xe9, 0x1d, 0xdb, 0xd8, 0xb1, 0x9a, 0x48, 0x76, 0x43, 0x29, 0xbd, 0x1b,
+	0xfe, 0x53, 0x82, 0xb9, 0x3c, 0x0e, 0x3f, 0xf7, 0x5a, 0xf9, 0x1e, 0x82, 0xc3, 0x61, 0xaa, 0x7c,
+	0xfb, 0x39, 0x94, 0x4b, 0x58, 0x98, 0x77, 0x12, 0x57, 0x61, 0xc1, 0x5c, 0x81, 0xfd, 0xb4, 0x65,
+	0x7b, 0x1b, 0xc4, 0x33, 0x6b, 0x5a, 0xf0, 0xbe, 0xa0, 0xa3, 0x03, 0xcc, 0xf9, 0x78, 0x8c, 0x38,
+	0x6c, 0x48, 0x2b, 0xb7, 0x22, 0xb1, 0x2b, 0x4e, 0x6d, 0x93, 0x03, 0xdc, 0x47, 0xd3, 0x0f, 0xa9,
+	0xfc, 0x76, 0x3a, 0xd9, 0x2a, 0xd9, 0xb9, 0x64, 0xcb, 0xbf, 0x6c, 0x4b, 0xab, 0x4a, 0x7a, 0xa4,
+	0xf5, 0xdb, 0x30, 0x91, 0x4a, 0xab, 0x4b, 0xba, 0x26, 0x76, 0x3e, 0x3b, 0xb1, 0x69, 0x47, 0x6d,
+	0xa9, 0x1d, 0xa7, 0x19, 0x32, 0xe9, 0xe4, 0xba, 0xe4, 0xb9, 0x25, 0x57, 0x25, 0xed, 0xc9, 0x95,
+	0xcf, 0xc2, 0x58, 0x02, 0x25, 0xc8, 0x90, 0x4a, 0xee, 0xeb, 0xae, 0x41, 0x7b, 0x76, 0x0c, 0xbf,
+	0x2b, 0xc1, 0x78, 0x17, 0x4d, 0x4e, 0xf0, 0xdb, 0xb0, 0x5f, 0xaf, 0xd5, 0x5c, 0x9f, 0x04, 0xec,
+	0xde, 0xd7, 0x13, 0x42, 0x5f, 0xce, 0x26, 0x34, 0x65, 0x2b, 0xaa, 0x21, 0x6e, 0x87, 0x3f, 0x4d,
+	0xce, 0xd0, 0xed, 0xf6, 0x9f, 0xd9, 0x19, 0x5a, 0x6d, 0x0f, 0xe0, 0x1a, 0x0c, 0x6f, 0x98, 0xd4,
+	0x73, 0xdc, 0xd6, 0xe8, 0x40, 0x9e, 0xcd, 0x9f, 0x40, 0x6a, 0xab, 0x90, 0xc8, 0x48, 0x70, 0x25,
+	0xbb, 0xe5, 0xe9, 0x16, 0x69, 0xbf, 0xa6, 0x6c, 0xc0, 0xc1, 0xb6, 0xa7, 0x9c, 0xd7, 0x9b, 0xf0,
+	0x05, 0x1a, 0x3c, 0xd6, 0xda, 0xae, 0x2b, 0xb3, 0xc2, 0x08, 0x02, 0x39, 0xf1, 0x9d, 0x65, 0x2f,
+	0x4d, 0x4c, 0xcb, 0x2a, 0x4c, 0x8a, 0x4e, 0xaa, 0x37, 0x89, 0x6e, 0xb8, 0x8e, 0xd3, 0x48, 0x6d,
+	0xc7, 0xce, 0x4e, 0x04, 0x89, 0x3b, 0x11, 0xf9, 0xaf, 0x83, 0x30, 0x95, 0x6d, 0x94, 0xe3, 0xb1,
+	0xe1, 0x68, 0x62, 0x35, 0xb5, 0x25, 0xe3, 0x56, 0x24, 0x68, 0xe0, 0x2a, 0xc5, 0x1a, 0x38, 0xf5,
+	0x48, 0x6c, 0x32, 0x05, 0x9f, 0x19, 0xc4, 0xef, 0x40, 0x12, 0xac, 0xe6, 0x39, 0x9b, 0xc4, 0xe6,
+	0xed, 0x54, 0x61, 0x27, 0xfb, 0x63, 0x3b, 0xb7, 0x99, 0x19, 0xfc, 0x0e, 0xe0, 0xc4, 0xf4, 0x06,
+	0x07, 0x1a, 0xb6, 0x62, 0xcb, 0x73, 0x05, 0x0c, 0x27, 0x34, 0x47, 0x6c, 0xe1, 0xbb, 0xd1, 0x69,
+	0xd1, 0xc9, 0xd0, 0x60, 0x5f, 0xc1, 0x87, 0x5b, 0xa8, 0x83, 0x9d, 0x6f, 0x41, 0x58, 0xf1, 0xda,
+	0xba, 0x63, 0x1b, 0xc4, 0x88, 0x08, 0xda, 0xdd, 0x97, 0x8f, 0x03, 0xcc, 0xd4, 0x32, 0xb3, 0xc4,
+	0x29, 0xba, 0x09, 0xfb, 0x42, 0xfb, 0x31, 0x3d, 0x43, 0x85, 0xe9, 0xf9, 0x22, 0xb3, 0x10, 0x51,
+	0x23, 0xdf, 0x83, 0x93, 0x5d, 0x1a, 0xbf, 0xb5, 0x88, 0xc6, 0x4b, 0xc4, 0xee, 0xaf, 0x8c, 0xbb,
+	0xb4, 0x10, 0x3f, 0x43, 0x70, 0x2a, 0xa7, 0xcf, 0xcf, 0xbb, 0x8b, 0x90, 0x1f, 0xc2, 0xd2, 0x65,
+	0xea, 0x99, 0x0d, 0xdd, 0x23, 0x1d, 0x86, 0xa2, 0x1e, 0xec, 0x19, 0x52, 0xf5, 0x1b, 0x04, 0x5f,
+	0xe9, 0xc3, 0x3f, 0xa7, 0xad, 0x6b, 0xbb, 0x8c, 0x9e, 0x4f, 0xbb, 0x2c, 0xff, 0x18, 0xc1, 0x74,
+	0xba, 0x59, 0x0c, 0xb6, 0x85, 0x69, 0xd7, 0x9f, 0xba, 0x35, 0x5d, 0x11, 0x5c, 0xfd, 0xfb, 0x99,
+	0x5f, 0xfc, 0xbd, 0x04, 0x33, 0x3d, 0xe3, 0xe3, 0x64, 0xfa, 0x30, 0x96, 0xaa, 0x41, 0x3f, 0x92,
+	0xdd, 0x56, 0x82, 0xa7, 0xb2, 0x4b, 0x30, 0x76, 0xd1, 0x56, 0x81, 0x12, 0xed, 0x26, 0x90, 0x7a,
+	0x5d, 0x27, 0x1e, 0x9f, 0xf1, 0x95, 0x27, 0x0e, 0x81, 0x3d, 0xdc, 0x36, 0x66, 0x19, 0xe8, 0x7f,
+	0xcc, 0xf2, 0x6b, 0x04, 0x27, 0xc4, 0x64, 0x3f, 0xab, 0xed, 0x83, 0x57, 0x04, 0xb1, 0xf7, 0x53,
+	0x27, 0xff, 0x2c, 0xc1, 0xc9, 0x7c, 0xa1, 0xff, 0xbf, 0x58, 0x76, 0xa4, 0x58, 0x16, 0x3f, 0x1b,
+	0x87, 0xdd, 0x6c, 0xa4, 0x8e, 0xbf, 0x8f, 0x60, 0x28, 0x9c, 0x91, 0xe3, 0x69, 0x11, 0x5f, 0x9d,
+	0xe3, 0x78, 0x69, 0xa6, 0xa7, 0x5c, 0xe8, 0x51, 0x9e, 0x7b, 0xef, 0x8f, 0x7f, 0xf9, 0xb0, 0x34,
+	0x85, 0x65, 0x45, 0xf0, 0xf3, 0x41, 0xf2, 0x1b, 0x00, 0x73, 0xfe, 0x03, 0x04, 0x7b, 0xe2, 0x21,
+	0x39, 0x9e, 0x12, 0xb9, 0xd8, 0x3e, 0xb2, 0x97, 0x8e, 0xf7, 0x90, 0xe2, 0x61, 0x54, 0x58, 0x18,
+	0xb3, 0x78, 0x3a, 0x2b, 0x8c, 0x64, 0xa0, 0x1f, 0x86, 0x12, 0xcd, 0xe0, 0xbb, 0x84, 0xb2, 0x6d,
+	0x6c, 0x2f, 0x1d, 0xef, 0x21, 0x55, 0x28, 0x14, 0xcb, 0xe2, 0xfd, 0x33, 0xfe, 0x09, 0x82, 0xfd,
+	0xdb, 0xa6, 0xf0, 0x78, 0xae, 0x2b, 0xea, 0x8e, 0xd9, 0xbe, 0x74, 0x22, 0x97, 0x2c, 0x0f, 0xee,
+	0xcb, 0x2c, 0xb8, 0x0a, 0x3e, 0xd9, 0x9b, 0xa7, 0x64, 0xdc, 0x8f, 0x7f, 0x1b, 0xfc, 0x50, 0x20,
+	0x1e, 0x52, 0xe3, 0xc5, 0x2e, 0xac, 0x64, 0x0c, 0xcf, 0xa5, 0x57, 0x0a, 0xe9, 0xf0, 0xd0, 0xcf,
+	0xb3, 0xd0, 0xcf, 0xe2, 0xd3, 0xbd, 0x78, 0x35, 0x53, 0x56, 0xb4, 0x78, 0xd6, 0xfd, 0x29, 0x82,
+	0xb1, 0xac, 0x19, 0x33, 0x3e, 0x2b, 0x0a, 0x2a, 0xc7, 0x54, 0x5b, 0x5a, 0x2a, 0xae, 0xc8, 0x21,
+	0x5d, 0x61, 0x90, 0x56, 0xf0, 0xa5, 0x2c, 0x48, 0xb5, 0xc8, 0x92, 0x10, 0x98, 0xf2, 0x80, 0xdf,
+	0x8f, 0x1f, 0xe2, 0xdf, 0x23, 0x90, 0xba, 0x8f, 0xa9, 0xb1, 0x70, 0x54, 0xde, 0x73, 0xf8, 0x2d,
+	0x9d, 0x29, 0xaa, 0xc6, 0xb1, 0x5d, 0x60, 0xd8, 0x96, 0xf0, 0x99, 0x5e, 0xe9, 0x12, 0x0f, 0xb7,
+	0xf1, 0x1f, 0x10, 0x48, 0xdd, 0x67, 0xc6, 0xf8, 0x74, 0xde, 0x46, 0xb5, 0x6d, 0xf2, 0x2d, 0x9d,
+	0x29, 0xaa, 0xc6, 0xd1, 0x5c, 0x64, 0x68, 0xce, 0xe1, 0xa5, 0x2c, 0x34, 0xe2, 0x06, 0x3b, 0xbc,
+	0x25, 0xe1, 0x7f, 0x20, 0x38, 0xd6, 0x6b, 0x3e, 0x8c, 0x5f, 0xcd, 0x1b, 0x9e, 0xa0, 0xff, 0x93,
+	0xbe, 0xda, 0x9f, 0x32, 0x47, 0x78, 0x8d, 0x21, 0x7c, 0x13, 0xaf, 0x14, 0x46, 0x48, 0x95, 0x07,
+	0x1d, 0x7d, 0xe7, 0x43, 0xfc, 0x5e, 0x29, 0x3d, 0xf3, 0xef, 0x36, 0xe5, 0xc4, 0xe7, 0x7b, 0xbd,
+	0xc0, 0x33, 0x27, 0x74, 0xd2, 0x85, 0x7e, 0xd5, 0x39, 0xea, 0x6f, 0x32, 0xd4, 0x6f, 0xe1, 0x3b,
+	0x39, 0x51, 0xfb, 0x69, 0x83, 0xda, 0x7a, 0x4b, 0x8b, 0x91, 0xe7, 0x20, 0x41, 0x25, 0xfd, 0x91,
+	0xa0, 0x92, 0xa7, 0x22, 0x41, 0x25, 0x3b, 0x4c, 0x82, 0x4b, 0x0a, 0x91, 0xf0, 0x11, 0x82, 0x17,
+	0x85, 0xe3, 0x35, 0x3c, 0x9f, 0x7b, 0x12, 0x17, 0x41, 0x5d, 0x28, 0xa0, 0xc1, 0xd1, 0x5d, 0x66,
+	0xe8, 0x5e, 0xc3, 0xe7, 0x73, 0xa2, 0x63, 0xe7, 0x29, 0x9f, 0xe8, 0xa5, 0x4e, 0xd7, 0x0f, 0x10,
+	0xec, 0x4d, 0x8d, 0xc3, 0xc4, 0x9d, 0x54, 0xe7, 0x14, 0x4d, 0x9a, 0xe9, 0x29, 0xc7, 0xe3, 0x9c,
+	0x67, 0x71, 0xce, 0xe1, 0xd9, 0xcc, 0x38, 0x53, 0x93, 0x37, 0xfc, 0x5f, 0x04, 0xc7, 0x73, 0x4d,
+	0x01, 0xf0, 0xc5, 0x02, 0x47, 0x83, 0xf0, 0x2a, 0x21, 0x55, 0x9f, 0xc2, 0x02, 0x07, 0x78, 0x95,
+	0x01, 0x7c, 0x03, 0x5f, 0x2e, 0x7e, 0xc2, 0x04, 0x45, 0x96, 0xdc, 0x64, 0xc2, 0x9b, 0xc9, 0x2f,
+	0x4a, 0xb0, 0x50, 0xf8, 0x62, 0x8f, 0xaf, 0x88, 0x70, 0xf4, 0x3b, 0x9f, 0x90, 0xae, 0xee, 0x90,
+	0x35, 0xce, 0xd0, 0x37, 0x18, 0x43, 0x6b, 0xf8, 0x76, 0x16, 0x43, 0x84, 0x9b, 0xd7, 0xb2, 0x5e,
+	0x37, 0x22, 0xc2, 0xfe, 0x86, 0x60, 0xac, 0x23, 0xa6, 0xd4, 0x44, 0x54, 0xdc, 0x01, 0xe5, 0x18,
+	0xcc, 0x4a, 0x4b, 0xc5, 0x15, 0x39, 0xe2, 0x35, 0x86, 0xf8, 0x06, 0xbe, 0x56, 0xfc, 0xbd, 0x1a,
+	0x0d, 0xf0, 0x94, 0x07, 0x1d, 0x77, 0xdc, 0x87, 0x01, 0xd6, 0x89, 0x1e, 0x63, 0x09, 0x7c, 0x2e,
+	0xe7, 0xdd, 0x51, 0x74, 0xe4, 0xbe, 0xda, 0x97, 0x6e, 0x9f, 0x1b, 0x21, 0xbe, 0x8a, 0x8a, 0xdf,
+	0xb4, 0xff, 0x46, 0x30, 0x25, 0x76, 0xbd, 0xad, 0xf6, 0x5f, 0xcb, 0x1f, 0xb4, 0xb8, 0xdc, 0x2f,
+	0xf6, 0x6f, 0xa0, 0x48, 0xc7, 0x2b, 0x84, 0x2e, 0xa8, 0xe8, 0xe5, 0x1b, 0x1f, 0x3f, 0x2e, 0xa3,
+	0x4f, 0x1e, 0x97, 0xd1, 0x67, 0x8f, 0xcb, 0xe8, 0x83, 0x27, 0xe5, 0x5d, 0x9f, 0x3c, 0x29, 0xef,
+	0xfa, 0xd3, 0x93, 0xf2, 0xae, 0xaf, 0x9d, 0x49, 0x5d, 0xc2, 0xb9, 0xa7, 0x53, 0x96, 0xbe, 0x4e,
+	0x63, 0xb7, 0x5b, 0x0b, 0xf3, 0xca, 0xbb, 0x69, 0xe7, 0xec, 0x62, 0xbe, 0x3e, 0xc4, 0xfe, 0x47,
+	0xed, 0x95, 0xff, 0x0d, 0x00, 0x9f, 0x34, 0x80, 0x9c, 0xfb, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1402,6 +2076,14 @@ type QueryClient interface {
 	// Returns all the superfluid poistions for a specific delegator
 	SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error)
 	SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error)
+	// Returns all the superfluid redelegations in progress of a delegator
+	SuperfluidRedelegationsByDelegator(ctx context.Context, in *SuperfluidRedelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidRedelegationsByDelegatorResponse, error)
+	// Returns the superfluid staking rewards accrued by a lock, and the ones it
+	// got at each of the recent epochs, by validator
+	SuperfluidLockRewards(ctx context.Context, in *SuperfluidLockRewardsRequest, opts ...grpc.CallOption) (*SuperfluidLockRewardsResponse, error)
+	// Returns the superfluid assets whose osmo equivalent multiplier failed to
+	// update at the last epochs
+	StaleAssets(ctx context.Context, in *StaleAssetsRequest, opts ...grpc.CallOption) (*StaleAssetsResponse, error)
 	// Returns all the superfluid positions of a specific denom delegated to one
 	// validator
 	SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error)
@@ -1409,6 +2091,14 @@ type QueryClient interface {
 	// This is labeled an estimate, because the way it calculates the amount can
 	// lead rounding errors from the true delegated amount
 	EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+	// Returns the osmo that can still be superfluid delegated to a validator
+	// before reaching the superfluid delegation caps
+	SuperfluidDelegationHeadroom(ctx context.Context, in *SuperfluidDelegationHeadroomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationHeadroomResponse, error)
+	// Returns all the unbonding superfluid positions of a delegator
+	SuperfluidUnbondingsByDelegator(ctx context.Context, in *SuperfluidUnbondingsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUnbondingsByDelegatorResponse, error)
+	// Returns all the unbonding superfluid positions of a specific denom
+	// unbonding from one validator
+	SuperfluidUnbondingsByValidatorDenom(ctx context.Context, in *SuperfluidUnbondingsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidUnbondingsByValidatorDenomResponse, error)
 }
 
 type queryClient struct {
@@ -1509,6 +2199,33 @@ func (c *queryClient) SuperfluidUndelegationsByDelegator(ctx context.Context, in
 	return out, nil
 }
 
+func (c *queryClient) SuperfluidRedelegationsByDelegator(ctx context.Context, in *SuperfluidRedelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidRedelegationsByDelegatorResponse, error) {
+	out := new(SuperfluidRedelegationsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidRedelegationsByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SuperfluidLockRewards(ctx context.Context, in *SuperfluidLockRewardsRequest, opts ...grpc.CallOption) (*SuperfluidLockRewardsResponse, error) {
+	out := new(SuperfluidLockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidLockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StaleAssets(ctx context.Context, in *StaleAssetsRequest, opts ...grpc.CallOption) (*StaleAssetsResponse, error) {
+	out := new(StaleAssetsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/StaleAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
 	out := new(SuperfluidDelegationsByValidatorDenomResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom", in, out, opts...)
@@ -1527,6 +2244,33 @@ func (c *queryClient) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx cont
 	return out, nil
 }
 
+func (c *queryClient) SuperfluidDelegationHeadroom(ctx context.Context, in *SuperfluidDelegationHeadroomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationHeadroomResponse, error) {
+	out := new(SuperfluidDelegationHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SuperfluidUnbondingsByDelegator(ctx context.Context, in *SuperfluidUnbondingsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUnbondingsByDelegatorResponse, error) {
+	out := new(SuperfluidUnbondingsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidUnbondingsByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SuperfluidUnbondingsByValidatorDenom(ctx context.Context, in *SuperfluidUnbondingsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidUnbondingsByValidatorDenomResponse, error) {
+	out := new(SuperfluidUnbondingsByValidatorDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidUnbondingsByValidatorDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -1550,6 +2294,14 @@ type QueryServer interface {
 	// Returns all the superfluid poistions for a specific delegator
 	SuperfluidDelegationsByDelegator(context.Context, *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error)
 	SuperfluidUndelegationsByDelegator(context.Context, *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error)
+	// Returns all the superfluid redelegations in progress of a delegator
+	SuperfluidRedelegationsByDelegator(context.Context, *SuperfluidRedelegationsByDelegatorRequest) (*SuperfluidRedelegationsByDelegatorResponse, error)
+	// Returns the superfluid staking rewards accrued by a lock, and the ones it
+	// got at each of the recent epochs, by validator
+	SuperfluidLockRewards(context.Context, *SuperfluidLockRewardsRequest) (*SuperfluidLockRewardsResponse, error)
+	// Returns the superfluid assets whose osmo equivalent multiplier failed to
+	// update at the last epochs
+	StaleAssets(context.Context, *StaleAssetsRequest) (*StaleAssetsResponse, error)
 	// Returns all the superfluid positions of a specific denom delegated to one
 	// validator
 	SuperfluidDelegationsByValidatorDenom(context.Context, *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error)
@@ -1557,6 +2309,14 @@ type QueryServer interface {
 	// This is labeled an estimate, because the way it calculates the amount can
 	// lead rounding errors from the true delegated amount
 	EstimateSuperfluidDelegatedAmountByValidatorDenom(context.Context, *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+	// Returns the osmo that can still be superfluid delegated to a validator
+	// before reaching the superfluid delegation caps
+	SuperfluidDelegationHeadroom(context.Context, *SuperfluidDelegationHeadroomRequest) (*SuperfluidDelegationHeadroomResponse, error)
+	// Returns all the unbonding superfluid positions of a delegator
+	SuperfluidUnbondingsByDelegator(context.Context, *SuperfluidUnbondingsByDelegatorRequest) (*SuperfluidUnbondingsByDelegatorResponse, error)
+	// Returns all the unbonding superfluid positions of a specific denom
+	// unbonding from one validator
+	SuperfluidUnbondingsByValidatorDenom(context.Context, *SuperfluidUnbondingsByValidatorDenomRequest) (*SuperfluidUnbondingsByValidatorDenomResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1593,12 +2353,30 @@ func (*UnimplementedQueryServer) SuperfluidDelegationsByDelegator(ctx context.Co
 func (*UnimplementedQueryServer) SuperfluidUndelegationsByDelegator(ctx context.Context, req *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegationsByDelegator not implemented")
 }
+func (*UnimplementedQueryServer) SuperfluidRedelegationsByDelegator(ctx context.Context, req *SuperfluidRedelegationsByDelegatorRequest) (*SuperfluidRedelegationsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegationsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidLockRewards(ctx context.Context, req *SuperfluidLockRewardsRequest) (*SuperfluidLockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidLockRewards not implemented")
+}
+func (*UnimplementedQueryServer) StaleAssets(ctx context.Context, req *StaleAssetsRequest) (*StaleAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaleAssets not implemented")
+}
 func (*UnimplementedQueryServer) SuperfluidDelegationsByValidatorDenom(ctx context.Context, req *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByValidatorDenom not implemented")
 }
 func (*UnimplementedQueryServer) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, req *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSuperfluidDelegatedAmountByValidatorDenom not implemented")
 }
+func (*UnimplementedQueryServer) SuperfluidDelegationHeadroom(ctx context.Context, req *SuperfluidDelegationHeadroomRequest) (*SuperfluidDelegationHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationHeadroom not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidUnbondingsByDelegator(ctx context.Context, req *SuperfluidUnbondingsByDelegatorRequest) (*SuperfluidUnbondingsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondingsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidUnbondingsByValidatorDenom(ctx context.Context, req *SuperfluidUnbondingsByValidatorDenomRequest) (*SuperfluidUnbondingsByValidatorDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondingsByValidatorDenom not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1784,66 +2562,174 @@ func _Query_SuperfluidUndelegationsByDelegator_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_SuperfluidDelegationsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationsByValidatorDenomRequest)
+func _Query_SuperfluidRedelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidRedelegationsByDelegatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, in)
+		return srv.(QueryServer).SuperfluidRedelegationsByDelegator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom",
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidRedelegationsByDelegator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, req.(*SuperfluidDelegationsByValidatorDenomRequest))
+		return srv.(QueryServer).SuperfluidRedelegationsByDelegator(ctx, req.(*SuperfluidRedelegationsByDelegatorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)
+func _Query_SuperfluidLockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidLockRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, in)
+		return srv.(QueryServer).SuperfluidLockRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom",
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidLockRewards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, req.(*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest))
+		return srv.(QueryServer).SuperfluidLockRewards(ctx, req.(*SuperfluidLockRewardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.superfluid.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AssetType",
-			Handler:    _Query_AssetType_Handler,
-		},
-		{
-			MethodName: "AllAssets",
-			Handler:    _Query_AllAssets_Handler,
-		},
-		{
-			MethodName: "AssetMultiplier",
-			Handler:    _Query_AssetMultiplier_Handler,
-		},
-		{
-			MethodName: "AllIntermediaryAccounts",
-			Handler:    _Query_AllIntermediaryAccounts_Handler,
-		},
+func _Query_StaleAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaleAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StaleAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/StaleAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaleAssets(ctx, req.(*StaleAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuperfluidDelegationsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationsByValidatorDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, req.(*SuperfluidDelegationsByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, req.(*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuperfluidDelegationHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationHeadroom(ctx, req.(*SuperfluidDelegationHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuperfluidUnbondingsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidUnbondingsByDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidUnbondingsByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidUnbondingsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidUnbondingsByDelegator(ctx, req.(*SuperfluidUnbondingsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuperfluidUnbondingsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidUnbondingsByValidatorDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidUnbondingsByValidatorDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidUnbondingsByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidUnbondingsByValidatorDenom(ctx, req.(*SuperfluidUnbondingsByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.superfluid.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AssetType",
+			Handler:    _Query_AssetType_Handler,
+		},
+		{
+			MethodName: "AllAssets",
+			Handler:    _Query_AllAssets_Handler,
+		},
+		{
+			MethodName: "AssetMultiplier",
+			Handler:    _Query_AssetMultiplier_Handler,
+		},
+		{
+			MethodName: "AllIntermediaryAccounts",
+			Handler:    _Query_AllIntermediaryAccounts_Handler,
+		},
 		{
 			MethodName: "ConnectedIntermediaryAccount",
 			Handler:    _Query_ConnectedIntermediaryAccount_Handler,
@@ -1864,6 +2750,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegationsByDelegator",
 			Handler:    _Query_SuperfluidUndelegationsByDelegator_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegationsByDelegator",
+			Handler:    _Query_SuperfluidRedelegationsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidLockRewards",
+			Handler:    _Query_SuperfluidLockRewards_Handler,
+		},
+		{
+			MethodName: "StaleAssets",
+			Handler:    _Query_StaleAssets_Handler,
+		},
 		{
 			MethodName: "SuperfluidDelegationsByValidatorDenom",
 			Handler:    _Query_SuperfluidDelegationsByValidatorDenom_Handler,
@@ -1872,6 +2770,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSuperfluidDelegatedAmountByValidatorDenom",
 			Handler:    _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler,
 		},
+		{
+			MethodName: "SuperfluidDelegationHeadroom",
+			Handler:    _Query_SuperfluidDelegationHeadroom_Handler,
+		},
+		{
+			MethodName: "SuperfluidUnbondingsByDelegator",
+			Handler:    _Query_SuperfluidUnbondingsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidUnbondingsByValidatorDenom",
+			Handler:    _Query_SuperfluidUnbondingsByValidatorDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/query.proto",
@@ -2642,7 +3552,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidRedelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2652,34 +3562,27 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidRedelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidRedelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidRedelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2689,20 +3592,34 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidRedelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidRedelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TotalRedelegatedCoins) > 0 {
+		for iNdEx := len(m.TotalRedelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TotalRedelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SuperfluidRedelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidRedelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidRedelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2716,7 +3633,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidLockRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2726,34 +3643,25 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dA
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidLockRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidLockRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidLockRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2763,20 +3671,48 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (d
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidLockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidLockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalDelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalAccruedRewards) > 0 {
+		for iNdEx := len(m.TotalAccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2790,402 +3726,2413 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSiz
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StaleAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *StaleAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StaleAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *StaleAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AssetTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StaleAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaleAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AssetType != 0 {
-		n += 1 + sovQuery(uint64(m.AssetType))
+	if len(m.StaleAssets) > 0 {
+		for iNdEx := len(m.StaleAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StaleAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidDelegationHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *SuperfluidDelegationHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetMultiplierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidDelegationHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetMultiplierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OsmoEquivalentMultiplier != nil {
-		l = m.OsmoEquivalentMultiplier.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SuperfluidDelegationHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidDelegationHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TotalHeadroom != nil {
+		{
+			size := m.TotalHeadroom.Size()
+			i -= size
+			if _, err := m.TotalHeadroom.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.TotalBondedTokens.Size()
+		i -= size
+		if _, err := m.TotalBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.GaugeId != 0 {
-		n += 1 + sovQuery(uint64(m.GaugeId))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSuperfluidAmount.Size()
+		i -= size
+		if _, err := m.TotalSuperfluidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.ValidatorHeadroom != nil {
+		{
+			size := m.ValidatorHeadroom.Size()
+			i -= size
+			if _, err := m.ValidatorHeadroom.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *AllIntermediaryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.ValidatorTokens.Size()
+		i -= size
+		if _, err := m.ValidatorTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ValidatorSuperfluidAmount.Size()
+		i -= size
+		if _, err := m.ValidatorSuperfluidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AllIntermediaryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConnectedIntermediaryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LockId != 0 {
-		n += 1 + sovQuery(uint64(m.LockId))
-	}
-	return n
+func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConnectedIntermediaryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalSuperfluidDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TotalSuperfluidDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalDelegations.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for _, e := range m.SuperfluidDelegationRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if len(m.TotalDelegatedCoins) > 0 {
-		for _, e := range m.TotalDelegatedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = m.TotalEquivalentStakedAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidUnbondingsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUnbondingsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUnbondingsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidUnbondingsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for _, e := range m.SuperfluidDelegationRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TotalUndelegatedCoins) > 0 {
-		for _, e := range m.TotalUndelegatedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalUnbondingCoins) > 0 {
+		for iNdEx := len(m.TotalUnbondingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalUnbondingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SyntheticLocks) > 0 {
-		for _, e := range m.SyntheticLocks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SuperfluidUnbondingRecords) > 0 {
+		for iNdEx := len(m.SuperfluidUnbondingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidUnbondingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for _, e := range m.SuperfluidDelegationRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalUnbondingCoins) > 0 {
+		for iNdEx := len(m.TotalUnbondingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalUnbondingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SuperfluidUnbondingRecords) > 0 {
+		for iNdEx := len(m.SuperfluidUnbondingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidUnbondingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AssetTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	return n
+}
+
+func (m *AllAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetMultiplierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	return n
+}
+
+func (m *AssetMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OsmoEquivalentMultiplier != nil {
+		l = m.OsmoEquivalentMultiplier.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Size() (n int) {
+func (m *AllIntermediaryAccountsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TotalDelegatedCoins) > 0 {
-		for _, e := range m.TotalDelegatedCoins {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllIntermediaryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ConnectedIntermediaryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ConnectedIntermediaryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *TotalSuperfluidDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TotalSuperfluidDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalDelegations.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SuperfluidDelegationAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for _, e := range m.SuperfluidDelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalDelegatedCoins) > 0 {
+		for _, e := range m.TotalDelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalEquivalentStakedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SuperfluidUndelegationsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUndelegationsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for _, e := range m.SuperfluidDelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalUndelegatedCoins) > 0 {
+		for _, e := range m.TotalUndelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SyntheticLocks) > 0 {
+		for _, e := range m.SyntheticLocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidRedelegationsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidRedelegationsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidRedelegationRecords) > 0 {
+		for _, e := range m.SuperfluidRedelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRedelegatedCoins) > 0 {
+		for _, e := range m.TotalRedelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalAccruedRewards) > 0 {
+		for _, e := range m.TotalAccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StaleAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StaleAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StaleAssets) > 0 {
+		for _, e := range m.StaleAssets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSuperfluidAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ValidatorHeadroom != nil {
+		l = m.ValidatorHeadroom.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalSuperfluidAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TotalHeadroom != nil {
+		l = m.TotalHeadroom.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for _, e := range m.SuperfluidDelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalDelegatedCoins) > 0 {
+		for _, e := range m.TotalDelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidUnbondingsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUnbondingsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidUnbondingRecords) > 0 {
+		for _, e := range m.SuperfluidUnbondingRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalUnbondingCoins) > 0 {
+		for _, e := range m.TotalUnbondingCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidUnbondingRecords) > 0 {
+		for _, e := range m.SuperfluidUnbondingRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalUnbondingCoins) > 0 {
+		for _, e := range m.TotalUnbondingCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, SuperfluidAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OsmoEquivalentMultiplier == nil {
+				m.OsmoEquivalentMultiplier = &OsmoEquivalentMultiplierRecord{}
+			}
+			if err := m.OsmoEquivalentMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, SuperfluidIntermediaryAccountInfo{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &SuperfluidIntermediaryAccountInfo{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -3193,25 +6140,121 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3233,7 +6276,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3256,15 +6299,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3291,7 +6334,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3316,7 +6360,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3339,15 +6383,15 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3375,7 +6419,7 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3398,7 +6442,7 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3421,17 +6465,17 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			m.AssetType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3441,114 +6485,63 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllAssetsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
+			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllAssetsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEquivalentStakedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3575,8 +6568,7 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, SuperfluidAsset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalEquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3601,7 +6593,7 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3624,15 +6616,15 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetMultiplierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3654,69 +6646,19 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssetMultiplierResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3726,27 +6668,23 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OsmoEquivalentMultiplier == nil {
-				m.OsmoEquivalentMultiplier = &OsmoEquivalentMultiplierRecord{}
-			}
-			if err := m.OsmoEquivalentMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3769,7 +6707,7 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3792,17 +6730,17 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3812,29 +6750,31 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegatedCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3844,48 +6784,31 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.TotalUndelegatedCoins = append(m.TotalUndelegatedCoins, types.Coin{})
+			if err := m.TotalUndelegatedCoins[len(m.TotalUndelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
-			}
-			m.GaugeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3895,23 +6818,25 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SyntheticLocks = append(m.SyntheticLocks, types1.SyntheticLock{})
+			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3934,7 +6859,7 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidRedelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3957,17 +6882,17 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidRedelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidRedelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3977,27 +6902,23 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4020,7 +6941,7 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidRedelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4043,15 +6964,15 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidRedelegationsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidRedelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidRedelegationRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4078,14 +6999,14 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, SuperfluidIntermediaryAccountInfo{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SuperfluidRedelegationRecords = append(m.SuperfluidRedelegationRecords, SuperfluidRedelegationRecord{})
+			if err := m.SuperfluidRedelegationRecords[len(m.SuperfluidRedelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRedelegatedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4112,10 +7033,8 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalRedelegatedCoins = append(m.TotalRedelegatedCoins, types.Coin{})
+			if err := m.TotalRedelegatedCoins[len(m.TotalRedelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4140,7 +7059,7 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4163,10 +7082,10 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4209,7 +7128,7 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4232,15 +7151,15 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4267,10 +7186,76 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &SuperfluidIntermediaryAccountInfo{}
+			m.AccruedRewards = append(m.AccruedRewards, SuperfluidLockRewards{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAccruedRewards = append(m.TotalAccruedRewards, types.Coin{})
+			if err := m.TotalAccruedRewards[len(m.TotalAccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, SuperfluidLockRewardRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4295,7 +7280,57 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *StaleAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StaleAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StaleAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StaleAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4318,12 +7353,46 @@ func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StaleAssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StaleAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StaleAssets = append(m.StaleAssets, StaleSuperfluidAsset{})
+			if err := m.StaleAssets[len(m.StaleAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4345,7 +7414,7 @@ func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationHeadroomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4368,15 +7437,15 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationHeadroomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4404,9 +7473,7 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4429,7 +7496,7 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationHeadroomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4452,15 +7519,15 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationHeadroomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSuperfluidAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4488,11 +7555,13 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorSuperfluidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4520,11 +7589,13 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorHeadroom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4552,7 +7623,115 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ValidatorHeadroom = &v
+			if err := m.ValidatorHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSuperfluidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSuperfluidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHeadroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.TotalHeadroom = &v
+			if err := m.TotalHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4575,7 +7754,7 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4598,17 +7777,17 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4618,25 +7797,55 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4659,7 +7868,7 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4682,17 +7891,17 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4702,23 +7911,25 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4741,7 +7952,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4764,17 +7975,17 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4784,31 +7995,29 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4818,29 +8027,77 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
-			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEquivalentStakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4867,7 +8124,8 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalEquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
+			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4892,7 +8150,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUnbondingsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4915,10 +8173,10 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUnbondingsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUnbondingsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4955,9 +8213,9 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4967,23 +8225,27 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5006,7 +8268,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUnbondingsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5029,15 +8291,15 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUnbondingsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUnbondingsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidUnbondingRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5064,14 +8326,14 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SuperfluidUnbondingRecords = append(m.SuperfluidUnbondingRecords, SuperfluidUnbondingRecord{})
+			if err := m.SuperfluidUnbondingRecords[len(m.SuperfluidUnbondingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5098,14 +8360,14 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalUndelegatedCoins = append(m.TotalUndelegatedCoins, types.Coin{})
-			if err := m.TotalUndelegatedCoins[len(m.TotalUndelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalUnbondingCoins = append(m.TotalUnbondingCoins, types.Coin{})
+			if err := m.TotalUnbondingCoins[len(m.TotalUnbondingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5132,8 +8394,10 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyntheticLocks = append(m.SyntheticLocks, types1.SyntheticLock{})
-			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5158,7 +8422,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUnbondingsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5181,10 +8445,10 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUnbondingsByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUnbondingsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5251,59 +8515,9 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5330,8 +8544,10 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5356,7 +8572,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUnbondingsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5379,17 +8595,17 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUnbondingsByValidatorDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUnbondingsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidUnbondingRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5399,29 +8615,31 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.SuperfluidUnbondingRecords = append(m.SuperfluidUnbondingRecords, SuperfluidUnbondingRecord{})
+			if err := m.SuperfluidUnbondingRecords[len(m.SuperfluidUnbondingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5431,77 +8649,29 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.TotalUnbondingCoins = append(m.TotalUnbondingCoins, types.Coin{})
+			if err := m.TotalUnbondingCoins[len(m.TotalUnbondingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5528,8 +8698,10 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dA
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
-			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -37,3 +39,9 @@ func GetSuperfluidIntermediaryAccountAddr(denom, valAddr string) sdk.AccAddress
 	// We are launching with the address as is, so this will have to be done as a migration in the future.
 	return authtypes.NewModuleAddress(denom + valAddr)
 }
+
+// GetAutoCompoundEscrowAddr returns the account that receives the rewards of an auto-compounding lock,
+// and holds them until they are compounded.
+func GetAutoCompoundEscrowAddr(lockID uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/auto-compound/%d", ModuleName, lockID))
+}
@@ -9,17 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +59,14 @@ func (SuperfluidAssetType) EnumDescriptor() ([]byte, []int) {
 type SuperfluidAsset struct {
 	Denom     string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	AssetType SuperfluidAssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=osmosis.superfluid.SuperfluidAssetType" json:"asset_type,omitempty"`
+	// risk_factor is the share of the asset's osmo equivalent value that is not
+	// counted towards its superfluid delegation. It is floored by the
+	// minimum_risk_factor param, so leaving it unset applies the global minimum.
+	RiskFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=risk_factor,json=riskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"risk_factor" yaml:"risk_factor"`
+	// price_pool_id is the gamm pool pairing a native asset with OSMO, whose
+	// time-averaged price sets the asset's osmo equivalent multiplier. It is
+	// unused by LP shares.
+	PricePoolId uint64 `protobuf:"varint,4,opt,name=price_pool_id,json=pricePoolId,proto3" json:"price_pool_id,omitempty" yaml:"price_pool_id"`
 }
 
 func (m *SuperfluidAsset) Reset()         { *m = SuperfluidAsset{} }
@@ -285,6 +296,170 @@ func (m *SuperfluidDelegationRecord) GetEquivalentStakedAmount() *types.Coin {
 	return nil
 }
 
+// SuperfluidUnbondingRecord is a superfluid undelegated lock, unbonding from
+// its validator until its end time.
+type SuperfluidUnbondingRecord struct {
+	LockId           uint64     `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	DelegatorAddress string     `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	EndTime          time.Time  `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *SuperfluidUnbondingRecord) Reset()         { *m = SuperfluidUnbondingRecord{} }
+func (m *SuperfluidUnbondingRecord) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidUnbondingRecord) ProtoMessage()    {}
+func (*SuperfluidUnbondingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{4}
+}
+func (m *SuperfluidUnbondingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidUnbondingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidUnbondingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidUnbondingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidUnbondingRecord.Merge(m, src)
+}
+func (m *SuperfluidUnbondingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidUnbondingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidUnbondingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidUnbondingRecord proto.InternalMessageInfo
+
+func (m *SuperfluidUnbondingRecord) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *SuperfluidUnbondingRecord) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidUnbondingRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidUnbondingRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SuperfluidUnbondingRecord) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// SuperfluidRedelegationRecord is a superfluid redelegation of a lock in
+// progress, which completes once the staking unbonding time has passed.
+type SuperfluidRedelegationRecord struct {
+	LockId              uint64     `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	DelegatorAddress    string     `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SrcValidatorAddress string     `protobuf:"bytes,3,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	DstValidatorAddress string     `protobuf:"bytes,4,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	Amount              types.Coin `protobuf:"bytes,5,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	CompletionTime      time.Time  `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *SuperfluidRedelegationRecord) Reset()         { *m = SuperfluidRedelegationRecord{} }
+func (m *SuperfluidRedelegationRecord) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidRedelegationRecord) ProtoMessage()    {}
+func (*SuperfluidRedelegationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
+}
+func (m *SuperfluidRedelegationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidRedelegationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidRedelegationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidRedelegationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidRedelegationRecord.Merge(m, src)
+}
+func (m *SuperfluidRedelegationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidRedelegationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidRedelegationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidRedelegationRecord proto.InternalMessageInfo
+
+func (m *SuperfluidRedelegationRecord) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *SuperfluidRedelegationRecord) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidRedelegationRecord) GetSrcValidatorAddress() string {
+	if m != nil {
+		return m.SrcValidatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidRedelegationRecord) GetDstValidatorAddress() string {
+	if m != nil {
+		return m.DstValidatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidRedelegationRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SuperfluidRedelegationRecord) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 type LockIdIntermediaryAccountConnection struct {
 	LockId              uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 	IntermediaryAccount string `protobuf:"bytes,2,opt,name=intermediary_account,json=intermediaryAccount,proto3" json:"intermediary_account,omitempty"`
@@ -294,7 +469,7 @@ func (m *LockIdIntermediaryAccountConnection) Reset()         { *m = LockIdInter
 func (m *LockIdIntermediaryAccountConnection) String() string { return proto.CompactTextString(m) }
 func (*LockIdIntermediaryAccountConnection) ProtoMessage()    {}
 func (*LockIdIntermediaryAccountConnection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{4}
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
 }
 func (m *LockIdIntermediaryAccountConnection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,22 +512,28 @@ func (m *LockIdIntermediaryAccountConnection) GetIntermediaryAccount() string {
 	return ""
 }
 
-type UnpoolWhitelistedPools struct {
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+// SuperfluidUnbondingStartHeight records the block height at which the
+// superfluid unbonding of a lock through a synthetic denom started, so that
+// validator slashes only charge the unbondings started at or after the
+// infraction height.
+type SuperfluidUnbondingStartHeight struct {
+	LockId     uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Height     int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	SynthDenom string `protobuf:"bytes,3,opt,name=synth_denom,json=synthDenom,proto3" json:"synth_denom,omitempty"`
 }
 
-func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{} }
-func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
-func (*UnpoolWhitelistedPools) ProtoMessage()    {}
-func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{5}
+func (m *SuperfluidUnbondingStartHeight) Reset()         { *m = SuperfluidUnbondingStartHeight{} }
+func (m *SuperfluidUnbondingStartHeight) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidUnbondingStartHeight) ProtoMessage()    {}
+func (*SuperfluidUnbondingStartHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
 }
-func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
+func (m *SuperfluidUnbondingStartHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnpoolWhitelistedPools) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SuperfluidUnbondingStartHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnpoolWhitelistedPools.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SuperfluidUnbondingStartHeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -362,498 +543,2992 @@ func (m *UnpoolWhitelistedPools) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *UnpoolWhitelistedPools) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnpoolWhitelistedPools.Merge(m, src)
+func (m *SuperfluidUnbondingStartHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidUnbondingStartHeight.Merge(m, src)
 }
-func (m *UnpoolWhitelistedPools) XXX_Size() int {
+func (m *SuperfluidUnbondingStartHeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnpoolWhitelistedPools) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnpoolWhitelistedPools.DiscardUnknown(m)
+func (m *SuperfluidUnbondingStartHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidUnbondingStartHeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnpoolWhitelistedPools proto.InternalMessageInfo
+var xxx_messageInfo_SuperfluidUnbondingStartHeight proto.InternalMessageInfo
 
-func (m *UnpoolWhitelistedPools) GetIds() []uint64 {
+func (m *SuperfluidUnbondingStartHeight) GetLockId() uint64 {
 	if m != nil {
-		return m.Ids
+		return m.LockId
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
-	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
-	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
-	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
-	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
-	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
-	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
+func (m *SuperfluidUnbondingStartHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("osmosis/superfluid/superfluid.proto", fileDescriptor_79d3c29d82dbb734)
+func (m *SuperfluidUnbondingStartHeight) GetSynthDenom() string {
+	if m != nil {
+		return m.SynthDenom
+	}
+	return ""
 }
 
-var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x4e,
-	0x18, 0xde, 0xb2, 0xfb, 0x03, 0x76, 0xf8, 0x45, 0x97, 0x42, 0x70, 0xd9, 0x84, 0x2e, 0x96, 0x44,
-	0x36, 0x10, 0x5a, 0x17, 0x13, 0x0f, 0xdc, 0x16, 0xd0, 0x84, 0x04, 0x91, 0x14, 0x8d, 0x09, 0x97,
-	0xcd, 0xb4, 0x33, 0x74, 0x27, 0x3b, 0xed, 0x94, 0xce, 0xb4, 0xba, 0x37, 0x8f, 0x1c, 0xfd, 0x08,
-	0x24, 0xde, 0xfc, 0x10, 0x9e, 0x39, 0x72, 0x34, 0x1e, 0xd0, 0xc0, 0xc5, 0x33, 0x9f, 0xc0, 0x74,
-	0xda, 0xed, 0x56, 0xc0, 0xa8, 0xa7, 0xce, 0xbc, 0xcf, 0xfb, 0x3e, 0xef, 0xf3, 0xfe, 0xe9, 0x80,
-	0x25, 0xc6, 0x3d, 0xc6, 0x09, 0x37, 0x79, 0x14, 0xe0, 0xf0, 0x88, 0x46, 0x04, 0x15, 0x8e, 0x46,
-	0x10, 0x32, 0xc1, 0x54, 0x35, 0x73, 0x32, 0x46, 0x48, 0x63, 0xd6, 0x65, 0x2e, 0x93, 0xb0, 0x99,
-	0x9c, 0x52, 0xcf, 0x86, 0xe6, 0x32, 0xe6, 0x52, 0x6c, 0xca, 0x9b, 0x1d, 0x1d, 0x99, 0x28, 0x0a,
-	0xa1, 0x20, 0xcc, 0xcf, 0xf0, 0xe6, 0x4d, 0x5c, 0x10, 0x0f, 0x73, 0x01, 0xbd, 0x60, 0x48, 0xe0,
-	0xc8, 0x5c, 0xa6, 0x0d, 0x39, 0x36, 0xe3, 0xb6, 0x8d, 0x05, 0x6c, 0x9b, 0x0e, 0x23, 0x19, 0x81,
-	0x3e, 0x00, 0xf7, 0x0f, 0x72, 0x11, 0x1d, 0xce, 0xb1, 0x50, 0x67, 0xc1, 0x7f, 0x08, 0xfb, 0xcc,
-	0xab, 0x2b, 0x8b, 0x4a, 0xab, 0x6a, 0xa5, 0x17, 0xf5, 0x39, 0x00, 0x30, 0x81, 0xbb, 0x62, 0x10,
-	0xe0, 0xfa, 0xd8, 0xa2, 0xd2, 0xba, 0xb7, 0xbe, 0x6c, 0xdc, 0x2e, 0xc4, 0xb8, 0x41, 0xf7, 0x6a,
-	0x10, 0x60, 0xab, 0x0a, 0x87, 0xc7, 0x8d, 0xc9, 0x93, 0xd3, 0x66, 0xe9, 0xc7, 0x69, 0x53, 0xd1,
-	0xfb, 0x60, 0x61, 0xe4, 0xbb, 0xe3, 0x0b, 0x1c, 0x7a, 0x18, 0x11, 0x18, 0x0e, 0x3a, 0x8e, 0xc3,
-	0x22, 0xff, 0x77, 0x42, 0xe6, 0xc1, 0x64, 0x0c, 0x69, 0x17, 0x22, 0x14, 0x4a, 0x19, 0x55, 0x6b,
-	0x22, 0x86, 0xb4, 0x83, 0x50, 0x98, 0x40, 0x2e, 0x8c, 0x5c, 0xdc, 0x25, 0xa8, 0x5e, 0x5e, 0x54,
-	0x5a, 0x15, 0x6b, 0x42, 0xde, 0x77, 0x90, 0xfe, 0x59, 0x01, 0xda, 0x4b, 0xee, 0xb1, 0x67, 0xc7,
-	0x11, 0x89, 0x21, 0xc5, 0xbe, 0x78, 0x11, 0x51, 0x41, 0x02, 0x4a, 0x70, 0x68, 0x61, 0x87, 0x85,
-	0x48, 0x7d, 0x08, 0xfe, 0xc7, 0x01, 0x73, 0x7a, 0x5d, 0x3f, 0xf2, 0x6c, 0x1c, 0xca, 0xac, 0x65,
-	0x6b, 0x4a, 0xda, 0xf6, 0xa4, 0x69, 0xa4, 0x68, 0xac, 0xa8, 0xc8, 0x01, 0xc0, 0xcb, 0xc9, 0x64,
-	0xe2, 0xea, 0xe6, 0xd6, 0xd9, 0x45, 0xb3, 0xf4, 0xf5, 0xa2, 0xf9, 0xc8, 0x25, 0xa2, 0x17, 0xd9,
-	0x86, 0xc3, 0x3c, 0x33, 0x1b, 0x45, 0xfa, 0x59, 0xe3, 0xa8, 0x6f, 0x26, 0xbd, 0xe4, 0xc6, 0x36,
-	0x76, 0xae, 0x2f, 0x9a, 0xd3, 0x03, 0xe8, 0xd1, 0x0d, 0x7d, 0xc4, 0xa4, 0x5b, 0x05, 0x5a, 0xfd,
-	0x7a, 0x0c, 0x34, 0x46, 0xed, 0xda, 0xc6, 0x14, 0xbb, 0x72, 0x11, 0x32, 0xf1, 0xab, 0x60, 0x1a,
-	0xa5, 0x36, 0x16, 0xca, 0xde, 0x60, 0xce, 0xb3, 0xbe, 0xd5, 0x72, 0xa0, 0x93, 0xda, 0x13, 0xe7,
-	0x18, 0x52, 0x82, 0x7e, 0x71, 0x4e, 0x4b, 0xaa, 0xe5, 0xc0, 0xd0, 0xf9, 0x6d, 0xce, 0x4c, 0x98,
-	0xdf, 0x85, 0x5e, 0x32, 0x1a, 0x59, 0xe4, 0xd4, 0xfa, 0xbc, 0x91, 0xd6, 0x62, 0x24, 0xdb, 0x65,
-	0x64, 0xdb, 0x65, 0x6c, 0x31, 0xe2, 0x6f, 0x9a, 0x49, 0xfd, 0x9f, 0xbe, 0x35, 0x97, 0xff, 0xa2,
-	0xfe, 0x24, 0x20, 0x57, 0x49, 0x98, 0xdf, 0x91, 0x39, 0xd4, 0xf7, 0x0a, 0xa8, 0xe3, 0x7c, 0x5c,
-	0x5d, 0x2e, 0x60, 0x1f, 0xa3, 0xa1, 0x80, 0xca, 0x9f, 0x04, 0xac, 0xfe, 0x4b, 0xf2, 0xb9, 0x51,
-	0x9e, 0x03, 0x99, 0x26, 0x95, 0xa0, 0x1f, 0x83, 0xa5, 0x5d, 0xe6, 0xf4, 0x77, 0xee, 0x5a, 0xcf,
-	0x2d, 0xe6, 0xfb, 0xd8, 0x49, 0xf4, 0xaa, 0x0f, 0xc0, 0x04, 0x65, 0x4e, 0x3f, 0x59, 0x3b, 0x45,
-	0xae, 0xdd, 0x38, 0x95, 0x51, 0x6a, 0x1b, 0xcc, 0x92, 0x42, 0x64, 0x17, 0xa6, 0xa1, 0x59, 0xaf,
-	0x67, 0xc8, 0x6d, 0x56, 0x7d, 0x05, 0xcc, 0xbd, 0xf6, 0x03, 0xc6, 0xe8, 0x9b, 0x1e, 0x11, 0x98,
-	0x12, 0x2e, 0x30, 0xda, 0x67, 0x8c, 0x72, 0xb5, 0x06, 0xca, 0x04, 0x25, 0x43, 0x2d, 0xb7, 0x2a,
-	0x56, 0x72, 0x5c, 0x39, 0x04, 0x33, 0x77, 0xfc, 0x6d, 0xea, 0x02, 0x98, 0xbf, 0xc3, 0xbc, 0x07,
-	0x05, 0x89, 0x71, 0xad, 0xa4, 0x6a, 0xc5, 0x45, 0xca, 0xe1, 0xdd, 0xfd, 0x83, 0x1e, 0x0c, 0x71,
-	0x4d, 0x69, 0x54, 0x4e, 0x3e, 0x6a, 0xa5, 0xcd, 0xfd, 0xb3, 0x4b, 0x4d, 0x39, 0xbf, 0xd4, 0x94,
-	0xef, 0x97, 0x9a, 0xf2, 0xe1, 0x4a, 0x2b, 0x9d, 0x5f, 0x69, 0xa5, 0x2f, 0x57, 0x5a, 0xe9, 0xf0,
-	0x69, 0xa1, 0xab, 0xd9, 0xff, 0xbf, 0x46, 0xa1, 0xcd, 0x87, 0x17, 0x33, 0x6e, 0x3f, 0x36, 0xdf,
-	0x15, 0x1f, 0x40, 0xd9, 0x69, 0x7b, 0x5c, 0xbe, 0x38, 0x4f, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xd3, 0xa7, 0xdf, 0xd8, 0x23, 0x05, 0x00, 0x00,
+// SuperfluidLockRewards are the superfluid staking rewards a lock accrued
+// through the intermediary account of a validator.
+type SuperfluidLockRewards struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (this *SuperfluidAsset) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SuperfluidAsset)
-	if !ok {
-		that2, ok := that.(SuperfluidAsset)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *SuperfluidLockRewards) Reset()         { *m = SuperfluidLockRewards{} }
+func (m *SuperfluidLockRewards) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewards) ProtoMessage()    {}
+func (*SuperfluidLockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{8}
+}
+func (m *SuperfluidLockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.AssetType != that1.AssetType {
-		return false
+}
+func (m *SuperfluidLockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewards.Merge(m, src)
+}
+func (m *SuperfluidLockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidLockRewards proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (m *SuperfluidAsset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *SuperfluidLockRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SuperfluidAsset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SuperfluidLockRewardRecord is the superfluid staking rewards a lock got
+// through the intermediary account of a validator at an epoch.
+type SuperfluidLockRewardRecord struct {
+	EpochNumber      int64                                    `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	ValidatorAddress string                                   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AssetType != 0 {
-		i = encodeVarintSuperfluid(dAtA, i, uint64(m.AssetType))
-		i--
-		dAtA[i] = 0x10
+func (m *SuperfluidLockRewardRecord) Reset()         { *m = SuperfluidLockRewardRecord{} }
+func (m *SuperfluidLockRewardRecord) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardRecord) ProtoMessage()    {}
+func (*SuperfluidLockRewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{9}
+}
+func (m *SuperfluidLockRewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SuperfluidLockRewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardRecord.Merge(m, src)
+}
+func (m *SuperfluidLockRewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidLockRewardRecord proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewardRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SuperfluidIntermediaryAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewardRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SuperfluidIntermediaryAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SuperfluidLockRewardRecord) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func (m *SuperfluidIntermediaryAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GaugeId != 0 {
-		i = encodeVarintSuperfluid(dAtA, i, uint64(m.GaugeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0x12
+// LockAccruedRewards are the superfluid staking rewards a lock accrued through
+// the intermediary account of a validator, as kept in genesis.
+type LockAccruedRewards struct {
+	LockId  uint64                `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Rewards SuperfluidLockRewards `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *LockAccruedRewards) Reset()         { *m = LockAccruedRewards{} }
+func (m *LockAccruedRewards) String() string { return proto.CompactTextString(m) }
+func (*LockAccruedRewards) ProtoMessage()    {}
+func (*LockAccruedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{10}
+}
+func (m *LockAccruedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockAccruedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockAccruedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *LockAccruedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAccruedRewards.Merge(m, src)
+}
+func (m *LockAccruedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockAccruedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAccruedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAccruedRewards proto.InternalMessageInfo
+
+func (m *LockAccruedRewards) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OsmoEquivalentMultiplierRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LockAccruedRewards) GetRewards() SuperfluidLockRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return SuperfluidLockRewards{}
 }
 
-func (m *OsmoEquivalentMultiplierRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LockRewardHistoryRecord is the superfluid staking rewards a lock got through
+// the intermediary account of a validator at an epoch, as kept in genesis.
+type LockRewardHistoryRecord struct {
+	LockId uint64                     `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Record SuperfluidLockRewardRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
 }
 
-func (m *OsmoEquivalentMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Multiplier.Size()
-		i -= size
-		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *LockRewardHistoryRecord) Reset()         { *m = LockRewardHistoryRecord{} }
+func (m *LockRewardHistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*LockRewardHistoryRecord) ProtoMessage()    {}
+func (*LockRewardHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{11}
+}
+func (m *LockRewardHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardHistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintSuperfluid(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *LockRewardHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardHistoryRecord.Merge(m, src)
+}
+func (m *LockRewardHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardHistoryRecord proto.InternalMessageInfo
+
+func (m *LockRewardHistoryRecord) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SuperfluidDelegationRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LockRewardHistoryRecord) GetRecord() SuperfluidLockRewardRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return SuperfluidLockRewardRecord{}
 }
 
-func (m *SuperfluidDelegationRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NativeAssetPriceRecord accumulates the OSMO spot price of a native
+// superfluid asset over time, since the osmo equivalent multiplier of the
+// asset was last updated.
+type NativeAssetPriceRecord struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// spot price at the last sample
+	LastSpotPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=last_spot_price,json=lastSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_spot_price"`
+	LastSampleTime time.Time                              `protobuf:"bytes,3,opt,name=last_sample_time,json=lastSampleTime,proto3,stdtime" json:"last_sample_time"`
+	// sum of the spot prices weighted by the milliseconds they were held since
+	// start_time
+	PriceTimeSum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_time_sum,json=priceTimeSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_time_sum"`
+	StartTime    time.Time                              `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 }
 
-func (m *SuperfluidDelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EquivalentStakedAmount != nil {
-		{
-			size, err := m.EquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.DelegationAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *NativeAssetPriceRecord) Reset()         { *m = NativeAssetPriceRecord{} }
+func (m *NativeAssetPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*NativeAssetPriceRecord) ProtoMessage()    {}
+func (*NativeAssetPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{12}
+}
+func (m *NativeAssetPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NativeAssetPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NativeAssetPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *NativeAssetPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NativeAssetPriceRecord.Merge(m, src)
+}
+func (m *NativeAssetPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *NativeAssetPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NativeAssetPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NativeAssetPriceRecord proto.InternalMessageInfo
+
+func (m *NativeAssetPriceRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *NativeAssetPriceRecord) GetLastSampleTime() time.Time {
+	if m != nil {
+		return m.LastSampleTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *LockIdIntermediaryAccountConnection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NativeAssetPriceRecord) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *LockIdIntermediaryAccountConnection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AutoCompoundLock is a lock whose rewards are auto-compounded. Its rewards
+// are held in its escrow account until they are compounded.
+type AutoCompoundLock struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
 
-func (m *LockIdIntermediaryAccountConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IntermediaryAccount) > 0 {
-		i -= len(m.IntermediaryAccount)
-		copy(dAtA[i:], m.IntermediaryAccount)
-		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.IntermediaryAccount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LockId != 0 {
-		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
-		i--
-		dAtA[i] = 0x8
+func (m *AutoCompoundLock) Reset()         { *m = AutoCompoundLock{} }
+func (m *AutoCompoundLock) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundLock) ProtoMessage()    {}
+func (*AutoCompoundLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{13}
+}
+func (m *AutoCompoundLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AutoCompoundLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundLock.Merge(m, src)
+}
+func (m *AutoCompoundLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundLock.DiscardUnknown(m)
 }
 
-func (m *UnpoolWhitelistedPools) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AutoCompoundLock proto.InternalMessageInfo
+
+func (m *AutoCompoundLock) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UnpoolWhitelistedPools) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StaleSuperfluidAsset is a superfluid asset whose osmo equivalent multiplier
+// failed to update at the last epochs. It keeps the multiplier of its last
+// successful update, until the multiplier is zeroed.
+type StaleSuperfluidAsset struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch of the first of the failed updates in a row
+	SinceEpoch int64 `protobuf:"varint,2,opt,name=since_epoch,json=sinceEpoch,proto3" json:"since_epoch,omitempty"`
+	// number of failed updates in a row
+	FailedEpochs uint64 `protobuf:"varint,3,opt,name=failed_epochs,json=failedEpochs,proto3" json:"failed_epochs,omitempty"`
+	// error of the last failed update
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// whether the multiplier of the asset was zeroed
+	MultiplierZeroed bool `protobuf:"varint,5,opt,name=multiplier_zeroed,json=multiplierZeroed,proto3" json:"multiplier_zeroed,omitempty"`
 }
 
-func (m *UnpoolWhitelistedPools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *StaleSuperfluidAsset) Reset()         { *m = StaleSuperfluidAsset{} }
+func (m *StaleSuperfluidAsset) String() string { return proto.CompactTextString(m) }
+func (*StaleSuperfluidAsset) ProtoMessage()    {}
+func (*StaleSuperfluidAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{14}
+}
+func (m *StaleSuperfluidAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleSuperfluidAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleSuperfluidAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintSuperfluid(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StaleSuperfluidAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleSuperfluidAsset.Merge(m, src)
+}
+func (m *StaleSuperfluidAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleSuperfluidAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleSuperfluidAsset.DiscardUnknown(m)
 }
 
-func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSuperfluid(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_StaleSuperfluidAsset proto.InternalMessageInfo
+
+func (m *StaleSuperfluidAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *SuperfluidAsset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+
+func (m *StaleSuperfluidAsset) GetSinceEpoch() int64 {
+	if m != nil {
+		return m.SinceEpoch
 	}
-	if m.AssetType != 0 {
-		n += 1 + sovSuperfluid(uint64(m.AssetType))
+	return 0
+}
+
+func (m *StaleSuperfluidAsset) GetFailedEpochs() uint64 {
+	if m != nil {
+		return m.FailedEpochs
 	}
-	return n
+	return 0
 }
 
-func (m *SuperfluidIntermediaryAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StaleSuperfluidAsset) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+	return ""
+}
+
+func (m *StaleSuperfluidAsset) GetMultiplierZeroed() bool {
+	if m != nil {
+		return m.MultiplierZeroed
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+	return false
+}
+
+type UnpoolWhitelistedPools struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{} }
+func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
+func (*UnpoolWhitelistedPools) ProtoMessage()    {}
+func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{15}
+}
+func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpoolWhitelistedPools) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpoolWhitelistedPools.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GaugeId != 0 {
-		n += 1 + sovSuperfluid(uint64(m.GaugeId))
+}
+func (m *UnpoolWhitelistedPools) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpoolWhitelistedPools.Merge(m, src)
+}
+func (m *UnpoolWhitelistedPools) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpoolWhitelistedPools) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpoolWhitelistedPools.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpoolWhitelistedPools proto.InternalMessageInfo
+
+func (m *UnpoolWhitelistedPools) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
-	return n
+	return nil
 }
 
-func (m *OsmoEquivalentMultiplierRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
+	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
+	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
+	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
+	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
+	proto.RegisterType((*SuperfluidUnbondingRecord)(nil), "osmosis.superfluid.SuperfluidUnbondingRecord")
+	proto.RegisterType((*SuperfluidRedelegationRecord)(nil), "osmosis.superfluid.SuperfluidRedelegationRecord")
+	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
+	proto.RegisterType((*SuperfluidUnbondingStartHeight)(nil), "osmosis.superfluid.SuperfluidUnbondingStartHeight")
+	proto.RegisterType((*SuperfluidLockRewards)(nil), "osmosis.superfluid.SuperfluidLockRewards")
+	proto.RegisterType((*SuperfluidLockRewardRecord)(nil), "osmosis.superfluid.SuperfluidLockRewardRecord")
+	proto.RegisterType((*LockAccruedRewards)(nil), "osmosis.superfluid.LockAccruedRewards")
+	proto.RegisterType((*LockRewardHistoryRecord)(nil), "osmosis.superfluid.LockRewardHistoryRecord")
+	proto.RegisterType((*NativeAssetPriceRecord)(nil), "osmosis.superfluid.NativeAssetPriceRecord")
+	proto.RegisterType((*AutoCompoundLock)(nil), "osmosis.superfluid.AutoCompoundLock")
+	proto.RegisterType((*StaleSuperfluidAsset)(nil), "osmosis.superfluid.StaleSuperfluidAsset")
+	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/superfluid/superfluid.proto", fileDescriptor_79d3c29d82dbb734)
+}
+
+var fileDescriptor_79d3c29d82dbb734 = []byte{
+	// 1291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x1b, 0xf7, 0xda, 0x26, 0x1f, 0x4f, 0x80, 0x98, 0x25, 0x04, 0xc7, 0xef, 0x8b, 0x9d, 0x77, 0x91,
+	0x5e, 0x52, 0x10, 0x6b, 0x92, 0x4a, 0x3d, 0xa0, 0x5e, 0xec, 0x04, 0x44, 0x24, 0x4a, 0xa3, 0x35,
+	0x50, 0x89, 0xcb, 0x6a, 0xbc, 0x33, 0xb1, 0x47, 0xd9, 0xdd, 0x31, 0x33, 0xb3, 0x86, 0xf4, 0xc4,
+	0x91, 0x23, 0xd7, 0x5e, 0x2a, 0xa4, 0xde, 0xda, 0xff, 0xa1, 0xc7, 0x8a, 0x23, 0x52, 0x2f, 0x55,
+	0x0f, 0xa1, 0x82, 0x4b, 0xaf, 0xcd, 0x5f, 0x50, 0xcd, 0xcc, 0xda, 0x6b, 0x82, 0x4d, 0x9c, 0xaa,
+	0x9c, 0xb2, 0xf3, 0x7c, 0xfc, 0x9e, 0x8f, 0xdf, 0xcc, 0xe3, 0x27, 0x70, 0x99, 0x89, 0x88, 0x09,
+	0x2a, 0xea, 0x22, 0xe9, 0x11, 0xbe, 0x1b, 0x26, 0x14, 0x8f, 0x7c, 0xba, 0x3d, 0xce, 0x24, 0xb3,
+	0xed, 0xd4, 0xc8, 0xcd, 0x34, 0x95, 0xa5, 0x0e, 0xeb, 0x30, 0xad, 0xae, 0xab, 0x2f, 0x63, 0x59,
+	0xa9, 0x76, 0x18, 0xeb, 0x84, 0xa4, 0xae, 0x4f, 0xed, 0x64, 0xb7, 0x8e, 0x13, 0x8e, 0x24, 0x65,
+	0x71, 0xaa, 0xaf, 0x1d, 0xd5, 0x4b, 0x1a, 0x11, 0x21, 0x51, 0xd4, 0x1b, 0x00, 0x04, 0x3a, 0x56,
+	0xbd, 0x8d, 0x04, 0xa9, 0xf7, 0xd7, 0xdb, 0x44, 0xa2, 0xf5, 0x7a, 0xc0, 0x68, 0x0a, 0xe0, 0x7c,
+	0x9f, 0x87, 0xc5, 0xd6, 0x30, 0x8b, 0x86, 0x10, 0x44, 0xda, 0x4b, 0x70, 0x0a, 0x93, 0x98, 0x45,
+	0x65, 0x6b, 0xd5, 0x5a, 0x9b, 0xf7, 0xcc, 0xc1, 0xbe, 0x0d, 0x80, 0x94, 0xda, 0x97, 0xfb, 0x3d,
+	0x52, 0xce, 0xaf, 0x5a, 0x6b, 0x67, 0x37, 0xae, 0xb8, 0x1f, 0x56, 0xe2, 0x1e, 0x81, 0xbb, 0xbf,
+	0xdf, 0x23, 0xde, 0x3c, 0x1a, 0x7c, 0xda, 0x04, 0x16, 0x38, 0x15, 0x7b, 0xfe, 0x2e, 0x0a, 0x24,
+	0xe3, 0xe5, 0x82, 0x8a, 0xd1, 0xdc, 0x7a, 0x75, 0x50, 0xcb, 0xfd, 0x7e, 0x50, 0xfb, 0x7f, 0x87,
+	0xca, 0x6e, 0xd2, 0x76, 0x03, 0x16, 0xd5, 0xd3, 0xcc, 0xcd, 0x9f, 0xeb, 0x02, 0xef, 0xd5, 0x55,
+	0x64, 0xe1, 0x6e, 0x91, 0xe0, 0xf0, 0xa0, 0x66, 0xef, 0xa3, 0x28, 0xbc, 0xe9, 0x8c, 0x40, 0x39,
+	0x1e, 0xa8, 0xd3, 0x6d, 0x7d, 0xb0, 0xbf, 0x84, 0x33, 0x3d, 0x4e, 0x03, 0xe2, 0xf7, 0x18, 0x0b,
+	0x7d, 0x8a, 0xcb, 0xc5, 0x55, 0x6b, 0xad, 0xd8, 0x2c, 0x1f, 0x1e, 0xd4, 0x96, 0x8c, 0xeb, 0x7b,
+	0x6a, 0xc7, 0x5b, 0xd0, 0xe7, 0x1d, 0xc6, 0xc2, 0x6d, 0x7c, 0x73, 0xee, 0xf9, 0xcb, 0x5a, 0xee,
+	0xcf, 0x97, 0x35, 0xcb, 0xd9, 0x83, 0x4b, 0x59, 0x41, 0xdb, 0xb1, 0x24, 0x3c, 0x22, 0x98, 0x22,
+	0xbe, 0xdf, 0x08, 0x02, 0x96, 0xc4, 0x93, 0xba, 0xb5, 0x02, 0x73, 0x7d, 0x14, 0xfa, 0x08, 0x63,
+	0xae, 0x7b, 0x35, 0xef, 0xcd, 0xf6, 0x51, 0xd8, 0xc0, 0x98, 0x2b, 0x55, 0x07, 0x25, 0x1d, 0xa2,
+	0x92, 0x52, 0xd5, 0x17, 0xbd, 0x59, 0x7d, 0xde, 0xc6, 0xce, 0xcf, 0x16, 0x54, 0xbf, 0x16, 0x11,
+	0xbb, 0xf5, 0x38, 0xa1, 0x7d, 0x14, 0x92, 0x58, 0x7e, 0x95, 0x84, 0x92, 0xf6, 0x42, 0x4a, 0xb8,
+	0x47, 0x02, 0xc6, 0xb1, 0xfd, 0x3f, 0x38, 0x4d, 0x7a, 0x2c, 0xe8, 0xfa, 0x71, 0x12, 0xb5, 0x09,
+	0xd7, 0x51, 0x0b, 0xde, 0x82, 0x96, 0xdd, 0xd3, 0xa2, 0x2c, 0xa3, 0xfc, 0x68, 0x46, 0x01, 0x40,
+	0x34, 0x04, 0x4b, 0xdb, 0xbe, 0x79, 0xe2, 0xb6, 0x9f, 0x33, 0xbd, 0xcb, 0x90, 0x1c, 0x6f, 0x04,
+	0xd6, 0x39, 0xcc, 0x43, 0x25, 0x6b, 0xd7, 0x16, 0x09, 0x49, 0x47, 0x5f, 0xd7, 0x34, 0xf9, 0x6b,
+	0x70, 0x0e, 0x1b, 0x19, 0xe3, 0xba, 0x37, 0x44, 0x88, 0xb4, 0x6f, 0xa5, 0xa1, 0xa2, 0x61, 0xe4,
+	0xca, 0xb8, 0x8f, 0x42, 0x8a, 0xdf, 0x33, 0x36, 0x25, 0x95, 0x86, 0x8a, 0x81, 0xf1, 0x93, 0x21,
+	0x32, 0x65, 0xb1, 0x8f, 0x22, 0x45, 0x8d, 0x2e, 0x72, 0x61, 0x63, 0xc5, 0x35, 0xb5, 0xb8, 0xea,
+	0x0d, 0xb8, 0xe9, 0x1b, 0x70, 0x37, 0x19, 0x8d, 0x9b, 0x75, 0x55, 0xff, 0x8f, 0x6f, 0x6a, 0x57,
+	0xa6, 0xa8, 0x5f, 0x39, 0x0c, 0xb3, 0xa4, 0x2c, 0x6e, 0xe8, 0x18, 0xf6, 0x33, 0x0b, 0xca, 0x64,
+	0x48, 0x97, 0x2f, 0x24, 0xda, 0x23, 0x78, 0x90, 0x40, 0xf1, 0xb8, 0x04, 0xae, 0x9d, 0x24, 0xf8,
+	0x72, 0x16, 0xa7, 0xa5, 0xc3, 0x98, 0x14, 0x9c, 0x5f, 0xf2, 0xb0, 0x92, 0x35, 0xfd, 0x41, 0xdc,
+	0x66, 0x31, 0xa6, 0x71, 0x27, 0xed, 0xf9, 0x45, 0x98, 0x0d, 0x59, 0xb0, 0xa7, 0x6e, 0x9b, 0xa5,
+	0x6f, 0xdb, 0x8c, 0x3a, 0x6e, 0x4f, 0x20, 0x23, 0x7f, 0x12, 0x32, 0x0a, 0x13, 0xc8, 0x68, 0xc3,
+	0xcc, 0xb4, 0x0d, 0x38, 0x31, 0x03, 0x29, 0xb2, 0xed, 0xc1, 0x1c, 0x89, 0xb1, 0xaf, 0xe6, 0x5d,
+	0xf9, 0x94, 0x8e, 0x52, 0x71, 0xcd, 0x30, 0x74, 0x07, 0xc3, 0xd0, 0xbd, 0x3f, 0x18, 0x86, 0xcd,
+	0xff, 0xa8, 0x30, 0x87, 0x07, 0xb5, 0x45, 0x73, 0x7d, 0x07, 0x9e, 0xce, 0x8b, 0x37, 0x35, 0xcb,
+	0x9b, 0x25, 0x31, 0x56, 0xa6, 0xce, 0x77, 0x05, 0xf8, 0x6f, 0xd6, 0x48, 0x8f, 0xe0, 0xa3, 0xf7,
+	0xf7, 0xdf, 0xe9, 0xe5, 0x06, 0x5c, 0x10, 0x3c, 0xf0, 0x27, 0xf5, 0xf3, 0xbc, 0xe0, 0xc1, 0xc3,
+	0xa3, 0x2d, 0xdd, 0x80, 0x0b, 0x58, 0xc8, 0x31, 0x3e, 0x45, 0xe3, 0x83, 0x85, 0x7c, 0x38, 0x99,
+	0x86, 0x53, 0x9f, 0x8c, 0x86, 0x0e, 0x2c, 0x06, 0x2c, 0xea, 0x85, 0x44, 0xbf, 0x3b, 0xcd, 0xc6,
+	0xcc, 0xb1, 0x6c, 0x38, 0x29, 0x1b, 0xcb, 0x86, 0x8d, 0x23, 0x00, 0x86, 0x94, 0xb3, 0x99, 0x54,
+	0x73, 0xf3, 0x18, 0x2e, 0xdf, 0xd5, 0xbd, 0x1e, 0x33, 0x83, 0x37, 0x59, 0x1c, 0x93, 0x40, 0x99,
+	0x4e, 0x66, 0x68, 0x1d, 0x96, 0xe8, 0x88, 0xa7, 0x8f, 0x8c, 0x6b, 0x4a, 0xd2, 0x79, 0xfa, 0x21,
+	0xaa, 0xc3, 0xa1, 0x3a, 0xe6, 0x59, 0xb5, 0x24, 0xe2, 0xf2, 0x0e, 0xa1, 0x9d, 0xae, 0x9c, 0x1c,
+	0x6d, 0x19, 0x66, 0xba, 0xda, 0x44, 0xe3, 0x17, 0xbc, 0xf4, 0x64, 0xd7, 0x60, 0x41, 0xec, 0xc7,
+	0xb2, 0xeb, 0x9b, 0x01, 0x6d, 0x08, 0x07, 0x2d, 0xda, 0x52, 0x12, 0xe7, 0x27, 0x0b, 0x2e, 0x64,
+	0x41, 0x55, 0xc5, 0x1e, 0x79, 0x82, 0x38, 0x9e, 0xf0, 0x02, 0xad, 0x09, 0x2f, 0x90, 0xc0, 0x2c,
+	0x37, 0x7e, 0xe5, 0xfc, 0x6a, 0xe1, 0xe3, 0xdc, 0xdf, 0x48, 0xb9, 0x5f, 0x9b, 0x92, 0x7b, 0xe1,
+	0x0d, 0xb0, 0x9d, 0x5f, 0x2d, 0xa8, 0x8c, 0xcb, 0x76, 0xfa, 0xdf, 0xaa, 0x13, 0x0d, 0xf9, 0x91,
+	0xaa, 0x0a, 0x9f, 0xb0, 0xaa, 0xa7, 0x60, 0xab, 0x52, 0x1a, 0x41, 0xc0, 0x13, 0x82, 0x07, 0xfd,
+	0x9f, 0xc8, 0xf5, 0xf6, 0x68, 0xaf, 0xd5, 0xd5, 0xff, 0xec, 0xe3, 0x5b, 0xd1, 0x08, 0xa9, 0xcd,
+	0xa2, 0xca, 0x32, 0x8b, 0xfc, 0xcc, 0x82, 0x8b, 0x99, 0xfa, 0x0e, 0x15, 0x92, 0xf1, 0xfd, 0xe3,
+	0x66, 0xcf, 0x5d, 0x98, 0xe1, 0xda, 0x24, 0x0d, 0xef, 0x4e, 0x1b, 0xde, 0x00, 0xa7, 0x39, 0xa4,
+	0x18, 0xce, 0x5f, 0x79, 0x58, 0xbe, 0x87, 0x24, 0xed, 0x13, 0xbd, 0xbd, 0xed, 0xa8, 0xa5, 0x28,
+	0xcd, 0x60, 0xfc, 0xa6, 0xf3, 0x10, 0x16, 0x43, 0x24, 0xa4, 0x2f, 0x7a, 0x4c, 0xfa, 0x7a, 0x87,
+	0x32, 0xfc, 0x35, 0xdd, 0x93, 0x2d, 0x17, 0xde, 0x19, 0x05, 0xd3, 0xea, 0x31, 0x13, 0xd3, 0xbe,
+	0x07, 0x25, 0x83, 0x8b, 0xd4, 0x1c, 0x30, 0xa3, 0xa5, 0x70, 0xec, 0x68, 0x99, 0x53, 0x41, 0xcd,
+	0x00, 0xd1, 0x70, 0xda, 0x59, 0xa9, 0xed, 0xfb, 0x70, 0xd6, 0x6c, 0x7c, 0x0a, 0xc9, 0x17, 0x49,
+	0x54, 0x2e, 0xfe, 0xa3, 0x34, 0x4f, 0x6b, 0x14, 0x05, 0xd9, 0x4a, 0x22, 0x7b, 0x13, 0x40, 0xa8,
+	0x81, 0x30, 0xed, 0x0f, 0x51, 0x96, 0xdf, 0xbc, 0xf6, 0xd3, 0xb3, 0xed, 0x1a, 0x94, 0x1a, 0x89,
+	0x64, 0x9b, 0x2c, 0xea, 0xb1, 0x24, 0xd6, 0x0c, 0x4d, 0xa4, 0x5b, 0xed, 0x88, 0x4b, 0x2d, 0x89,
+	0x42, 0x32, 0xdd, 0xda, 0xae, 0x26, 0x0e, 0x8d, 0x03, 0xe2, 0xeb, 0x57, 0x97, 0x8e, 0x23, 0xd0,
+	0xa2, 0x5b, 0x4a, 0x62, 0x5f, 0x86, 0x33, 0xbb, 0x88, 0x86, 0x04, 0x1b, 0x0b, 0x91, 0xee, 0xa4,
+	0xa7, 0x8d, 0x50, 0xdb, 0x08, 0xfb, 0x12, 0x80, 0x26, 0x83, 0x70, 0xce, 0x78, 0xfa, 0x9b, 0x33,
+	0xaf, 0x24, 0xb7, 0x94, 0x40, 0xbd, 0xe2, 0x6c, 0x09, 0xf4, 0xbf, 0x25, 0x9c, 0x11, 0xac, 0x9b,
+	0x31, 0xe7, 0x95, 0x32, 0xc5, 0x23, 0x2d, 0x77, 0xae, 0xc2, 0xf2, 0x83, 0x58, 0xad, 0xdd, 0xdf,
+	0x74, 0xa9, 0x24, 0x21, 0x15, 0x92, 0x60, 0xb5, 0x76, 0x0b, 0xbb, 0x04, 0x05, 0x8a, 0xd5, 0x50,
+	0x2b, 0xac, 0x15, 0x3d, 0xf5, 0x79, 0xf5, 0x11, 0x9c, 0x1f, 0xf3, 0xef, 0x84, 0x7d, 0x09, 0x56,
+	0xc6, 0x88, 0xcd, 0xb5, 0x2d, 0xe5, 0xec, 0x2a, 0x54, 0xc6, 0xa8, 0xef, 0xee, 0xb4, 0xba, 0x88,
+	0x93, 0x92, 0x55, 0x29, 0x3e, 0xff, 0xa1, 0x9a, 0x6b, 0xee, 0xbc, 0x7a, 0x5b, 0xb5, 0x5e, 0xbf,
+	0xad, 0x5a, 0x7f, 0xbc, 0xad, 0x5a, 0x2f, 0xde, 0x55, 0x73, 0xaf, 0xdf, 0x55, 0x73, 0xbf, 0xbd,
+	0xab, 0xe6, 0x1e, 0x7d, 0x31, 0x72, 0x15, 0xd2, 0xb7, 0x74, 0x3d, 0x44, 0x6d, 0x31, 0x38, 0xd4,
+	0xfb, 0xeb, 0x37, 0xea, 0x4f, 0x47, 0xff, 0xc5, 0xd3, 0xd7, 0xa3, 0x3d, 0xa3, 0x09, 0xff, 0xfc,
+	0xef, 0x01, 0x00, 0x9e, 0xb8, 0x85, 0x76, 0x05, 0x0e, 0x00, 0x00,
+}
+
+func (this *SuperfluidAsset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovSuperfluid(uint64(m.EpochNumber))
+
+	that1, ok := that.(*SuperfluidAsset)
+	if !ok {
+		that2, ok := that.(SuperfluidAsset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = m.Multiplier.Size()
-	n += 1 + l + sovSuperfluid(uint64(l))
-	return n
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.AssetType != that1.AssetType {
+		return false
+	}
+	if !this.RiskFactor.Equal(that1.RiskFactor) {
+		return false
+	}
+	if this.PricePoolId != that1.PricePoolId {
+		return false
+	}
+	return true
 }
-
-func (m *SuperfluidDelegationRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+	if m.PricePoolId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.PricePoolId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+	{
+		size := m.RiskFactor.Size()
+		i -= size
+		if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
 	}
-	l = m.DelegationAmount.Size()
-	n += 1 + l + sovSuperfluid(uint64(l))
-	if m.EquivalentStakedAmount != nil {
-		l = m.EquivalentStakedAmount.Size()
-		n += 1 + l + sovSuperfluid(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.AssetType != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidIntermediaryAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidIntermediaryAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidIntermediaryAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LockId != 0 {
-		n += 1 + sovSuperfluid(uint64(m.LockId))
+	if m.GaugeId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.IntermediaryAccount)
-	if l > 0 {
-		n += 1 + l + sovSuperfluid(uint64(l))
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnpoolWhitelistedPools) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OsmoEquivalentMultiplierRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovSuperfluid(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *OsmoEquivalentMultiplierRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OsmoEquivalentMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidDelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidDelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EquivalentStakedAmount != nil {
+		{
+			size, err := m.EquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.DelegationAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidUnbondingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidRedelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidRedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidRedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockIdIntermediaryAccountConnection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockIdIntermediaryAccountConnection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockIdIntermediaryAccountConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntermediaryAccount) > 0 {
+		i -= len(m.IntermediaryAccount)
+		copy(dAtA[i:], m.IntermediaryAccount)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.IntermediaryAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidUnbondingStartHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUnbondingStartHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUnbondingStartHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SynthDenom) > 0 {
+		i -= len(m.SynthDenom)
+		copy(dAtA[i:], m.SynthDenom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.SynthDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockAccruedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockAccruedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockAccruedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewardHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NativeAssetPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NativeAssetPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NativeAssetPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PriceTimeSum.Size()
+		i -= size
+		if _, err := m.PriceTimeSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastSampleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastSampleTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LastSpotPrice.Size()
+		i -= size
+		if _, err := m.LastSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StaleSuperfluidAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StaleSuperfluidAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaleSuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MultiplierZeroed {
+		i--
+		if m.MultiplierZeroed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FailedEpochs != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.FailedEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SinceEpoch != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.SinceEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpoolWhitelistedPools) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpoolWhitelistedPools) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpoolWhitelistedPools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA12 := make([]byte, len(m.Ids)*10)
+		var j11 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintSuperfluid(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSuperfluid(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SuperfluidAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovSuperfluid(uint64(m.AssetType))
+	}
+	l = m.RiskFactor.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	if m.PricePoolId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.PricePoolId))
+	}
+	return n
+}
+
+func (m *SuperfluidIntermediaryAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *OsmoEquivalentMultiplierRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovSuperfluid(uint64(m.EpochNumber))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *SuperfluidDelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	if m.EquivalentStakedAmount != nil {
+		l = m.EquivalentStakedAmount.Size()
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUnbondingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *SuperfluidRedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.IntermediaryAccount)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUnbondingStartHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSuperfluid(uint64(m.Height))
+	}
+	l = len(m.SynthDenom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovSuperfluid(uint64(m.EpochNumber))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockAccruedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *LockRewardHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *NativeAssetPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.LastSpotPrice.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastSampleTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = m.PriceTimeSum.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *AutoCompoundLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *StaleSuperfluidAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.SinceEpoch != 0 {
+		n += 1 + sovSuperfluid(uint64(m.SinceEpoch))
+	}
+	if m.FailedEpochs != 0 {
+		n += 1 + sovSuperfluid(uint64(m.FailedEpochs))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.MultiplierZeroed {
+		n += 2
+	}
+	return n
+}
+
+func (m *UnpoolWhitelistedPools) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovSuperfluid(uint64(e))
+		}
+		n += 1 + sovSuperfluid(uint64(l)) + l
+	}
+	return n
+}
+
+func sovSuperfluid(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSuperfluid(x uint64) (n int) {
+	return sovSuperfluid(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePoolId", wireType)
+			}
+			m.PricePoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricePoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OsmoEquivalentMultiplierRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OsmoEquivalentMultiplierRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidDelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidDelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EquivalentStakedAmount == nil {
+				m.EquivalentStakedAmount = &types.Coin{}
+			}
+			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidUnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidUnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidUnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidRedelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidRedelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidRedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovSuperfluid(uint64(l)) + l
 	}
-	return n
-}
 
-func sovSuperfluid(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozSuperfluid(x uint64) (n int) {
-	return sovSuperfluid(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SuperfluidUnbondingStartHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidUnbondingStartHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidUnbondingStartHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SynthDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -876,15 +3551,15 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -912,13 +3587,13 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.AssetType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -928,11 +3603,26 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
@@ -954,7 +3644,7 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -977,15 +3667,34 @@ func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1013,13 +3722,13 @@ func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1029,29 +3738,100 @@ func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockAccruedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockAccruedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockAccruedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.GaugeId = 0
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1061,11 +3841,25 @@ func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
@@ -1087,7 +3881,7 @@ func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
+func (m *LockRewardHistoryRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,17 +3904,17 @@ func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OsmoEquivalentMultiplierRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRewardHistoryRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OsmoEquivalentMultiplierRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRewardHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.EpochNumber = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1130,48 +3924,16 @@ func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSuperfluid
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSuperfluid
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSuperfluid
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1181,23 +3943,22 @@ func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1222,7 +3983,7 @@ func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *NativeAssetPriceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1245,15 +4006,15 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: NativeAssetPriceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NativeAssetPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1281,11 +4042,11 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSpotPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1313,11 +4074,13 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSampleTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1344,13 +4107,47 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastSampleTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTimeSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceTimeSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1377,10 +4174,7 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EquivalentStakedAmount == nil {
-				m.EquivalentStakedAmount = &types.Coin{}
-			}
-			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1405,7 +4199,7 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1428,10 +4222,10 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompoundLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompoundLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1453,9 +4247,129 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StaleSuperfluidAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StaleSuperfluidAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StaleSuperfluidAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceEpoch", wireType)
+			}
+			m.SinceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEpochs", wireType)
+			}
+			m.FailedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1483,8 +4397,28 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IntermediaryAccount = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierZeroed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiplierZeroed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
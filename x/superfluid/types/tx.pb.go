@@ -303,6 +303,215 @@ func (m *MsgSuperfluidUnbondLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSuperfluidUnbondLockResponse proto.InternalMessageInfo
 
+// MsgSuperfluidRedelegate moves the superfluid delegation of a lock to a new
+// validator. The lock stays slashable by the old validator until the staking
+// unbonding time has passed, during which it can't be redelegated again.
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{6}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{7}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
+// MsgSuperfluidPartialUndelegate splits the coin off a superfluid delegated
+// lock into a new lock, and superfluid undelegates the new lock. The remainder
+// of the lock stays delegated.
+type MsgSuperfluidPartialUndelegate struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Coin   types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgSuperfluidPartialUndelegate) Reset()         { *m = MsgSuperfluidPartialUndelegate{} }
+func (m *MsgSuperfluidPartialUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidPartialUndelegate) ProtoMessage()    {}
+func (*MsgSuperfluidPartialUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{8}
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidPartialUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidPartialUndelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidPartialUndelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidPartialUndelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidPartialUndelegate) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+type MsgSuperfluidPartialUndelegateResponse struct {
+	// id of the new lock being superfluid undelegated
+	SplitLockId uint64 `protobuf:"varint,1,opt,name=split_lock_id,json=splitLockId,proto3" json:"split_lock_id,omitempty"`
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) Reset() {
+	*m = MsgSuperfluidPartialUndelegateResponse{}
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidPartialUndelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidPartialUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{9}
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidPartialUndelegateResponse) GetSplitLockId() uint64 {
+	if m != nil {
+		return m.SplitLockId
+	}
+	return 0
+}
+
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
@@ -316,7 +525,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{6}
+	return fileDescriptor_55b645f187d22814, []int{10}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +583,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{7}
+	return fileDescriptor_55b645f187d22814, []int{11}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +636,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{8}
+	return fileDescriptor_55b645f187d22814, []int{12}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +687,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{9}
+	return fileDescriptor_55b645f187d22814, []int{13}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,6 +723,106 @@ func (m *MsgUnPoolWhitelistedPoolResponse) GetExitedLockIds() []uint64 {
 	return nil
 }
 
+// MsgSetLockAutoCompound enables or disables the auto-compounding of the
+// rewards of a lock of LP shares. At every epoch, the rewards the lock got are
+// joined into its pool, and the shares are added to the lock, increasing its
+// superfluid delegation if any.
+type MsgSetLockAutoCompound struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId  uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetLockAutoCompound) Reset()         { *m = MsgSetLockAutoCompound{} }
+func (m *MsgSetLockAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockAutoCompound) ProtoMessage()    {}
+func (*MsgSetLockAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{14}
+}
+func (m *MsgSetLockAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLockAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockAutoCompound.Merge(m, src)
+}
+func (m *MsgSetLockAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLockAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetLockAutoCompound) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetLockAutoCompound) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSetLockAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetLockAutoCompoundResponse struct {
+}
+
+func (m *MsgSetLockAutoCompoundResponse) Reset()         { *m = MsgSetLockAutoCompoundResponse{} }
+func (m *MsgSetLockAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetLockAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{15}
+}
+func (m *MsgSetLockAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLockAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetLockAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLockAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -521,54 +830,71 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateResponse")
 	proto.RegisterType((*MsgSuperfluidUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
+	proto.RegisterType((*MsgSuperfluidPartialUndelegate)(nil), "osmosis.superfluid.MsgSuperfluidPartialUndelegate")
+	proto.RegisterType((*MsgSuperfluidPartialUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidPartialUndelegateResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
 	proto.RegisterType((*MsgUnPoolWhitelistedPoolResponse)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPoolResponse")
+	proto.RegisterType((*MsgSetLockAutoCompound)(nil), "osmosis.superfluid.MsgSetLockAutoCompound")
+	proto.RegisterType((*MsgSetLockAutoCompoundResponse)(nil), "osmosis.superfluid.MsgSetLockAutoCompoundResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0x93, 0xfc, 0x92, 0x1f, 0x83, 0x5a, 0x09, 0xab, 0x55, 0x1d, 0x03, 0x76, 0x30, 0x3d,
-	0x04, 0x95, 0x7a, 0x93, 0x16, 0x55, 0x88, 0x5b, 0x43, 0x0e, 0x44, 0x22, 0x52, 0x65, 0x54, 0x21,
-	0x21, 0xa1, 0xca, 0xce, 0x6e, 0x5d, 0x2b, 0x8e, 0x37, 0xf2, 0x3a, 0x51, 0x2a, 0x1e, 0x80, 0x2b,
-	0xcf, 0xc1, 0x8b, 0xd0, 0x63, 0x8f, 0x9c, 0x02, 0x4a, 0xde, 0xa0, 0xe2, 0x01, 0x90, 0xff, 0x86,
-	0x80, 0x1d, 0x12, 0x01, 0x27, 0xef, 0xcc, 0x7c, 0xfb, 0xcd, 0x37, 0x9a, 0x19, 0x2f, 0xdc, 0xa5,
-	0xac, 0x4f, 0x99, 0xc5, 0x10, 0x1b, 0x0e, 0x88, 0x7b, 0x6e, 0x0f, 0x2d, 0x8c, 0xbc, 0xb1, 0x3a,
-	0x70, 0xa9, 0x47, 0x79, 0x3e, 0x0a, 0xaa, 0xf3, 0xa0, 0xb8, 0x65, 0x52, 0x93, 0x06, 0x61, 0xe4,
-	0x9f, 0x42, 0xa4, 0x28, 0x99, 0x94, 0x9a, 0x36, 0x41, 0x81, 0x65, 0x0c, 0xcf, 0x11, 0x1e, 0xba,
-	0xba, 0x67, 0x51, 0x27, 0x8e, 0x77, 0x03, 0x2a, 0x64, 0xe8, 0x8c, 0xa0, 0x51, 0xc3, 0x20, 0x9e,
-	0xde, 0x40, 0x5d, 0x6a, 0xc5, 0xf1, 0x87, 0x29, 0x32, 0xe6, 0xc7, 0x10, 0xa4, 0x8c, 0x60, 0xbb,
-	0xc3, 0xcc, 0x57, 0x89, 0xbb, 0x45, 0x6c, 0x62, 0xea, 0x1e, 0xe1, 0x1f, 0x41, 0x89, 0x11, 0x07,
-	0x13, 0x57, 0xe0, 0xaa, 0x5c, 0xed, 0x56, 0xf3, 0xce, 0xcd, 0x44, 0xde, 0xb8, 0xd4, 0xfb, 0xf6,
-	0x33, 0x25, 0xf4, 0x2b, 0x5a, 0x04, 0xe0, 0x77, 0xa0, 0x6c, 0xd3, 0x6e, 0xef, 0xcc, 0xc2, 0x42,
-	0xbe, 0xca, 0xd5, 0x8a, 0x5a, 0xc9, 0x37, 0xdb, 0x98, 0xaf, 0xc0, 0xff, 0x23, 0xdd, 0x3e, 0xd3,
-	0x31, 0x76, 0x85, 0x82, 0xcf, 0xa2, 0x95, 0x47, 0xba, 0x7d, 0x8c, 0xb1, 0xab, 0xc8, 0x70, 0x3f,
-	0x35, 0xaf, 0x46, 0xd8, 0x80, 0x3a, 0x8c, 0x28, 0x6f, 0x61, 0x67, 0x01, 0x70, 0xea, 0xe0, 0xbf,
-	0x28, 0x4d, 0x79, 0x00, 0x72, 0x06, 0xfd, 0x12, 0x05, 0x06, 0x75, 0xf0, 0x4b, 0xda, 0xed, 0xfd,
-	0x23, 0x05, 0x31, 0x7d, 0xa2, 0xe0, 0x13, 0x07, 0xf7, 0x3a, 0xcc, 0xf4, 0x7d, 0xc7, 0x0e, 0xfe,
-	0xb3, 0x26, 0xe9, 0xf0, 0x9f, 0x3f, 0x1b, 0x4c, 0xc8, 0x57, 0x0b, 0xb5, 0xdb, 0x07, 0x15, 0x35,
-	0x9c, 0x1e, 0xd5, 0x9f, 0x1e, 0x35, 0x9a, 0x1e, 0xf5, 0x39, 0xb5, 0x9c, 0x66, 0xfd, 0x6a, 0x22,
-	0xe7, 0x3e, 0x7e, 0x91, 0x6b, 0xa6, 0xe5, 0x5d, 0x0c, 0x0d, 0xb5, 0x4b, 0xfb, 0x28, 0x1a, 0xb5,
-	0xf0, 0xb3, 0xcf, 0x70, 0x0f, 0x79, 0x97, 0x03, 0xc2, 0x82, 0x0b, 0x4c, 0x0b, 0x99, 0x97, 0xb5,
-	0xfb, 0x08, 0x76, 0x97, 0x15, 0x12, 0x57, 0xcc, 0x6f, 0x42, 0xbe, 0xdd, 0x0a, 0x8a, 0x29, 0x6a,
-	0xf9, 0x76, 0x4b, 0x71, 0x41, 0xe8, 0x30, 0xf3, 0xd4, 0x39, 0xa1, 0xd4, 0x7e, 0x7d, 0x61, 0x79,
-	0xc4, 0xb6, 0x98, 0x47, 0xb0, 0x6f, 0xae, 0x53, 0xfc, 0x1e, 0x94, 0x07, 0x94, 0xda, 0x49, 0x13,
-	0x9a, 0xfc, 0xcd, 0x44, 0xde, 0x0c, 0xb1, 0x51, 0x40, 0xd1, 0x4a, 0xfe, 0xa9, 0x8d, 0x95, 0x17,
-	0x50, 0xcd, 0xca, 0x99, 0xe8, 0xdc, 0x85, 0x0d, 0x32, 0xb6, 0x3c, 0x12, 0xf4, 0xab, 0x8d, 0x99,
-	0xc0, 0x55, 0x0b, 0xb5, 0xa2, 0xb6, 0xe8, 0x3c, 0xf8, 0x56, 0x84, 0x42, 0x87, 0x99, 0xbc, 0x0b,
-	0x7c, 0x5a, 0xf3, 0xd4, 0x5f, 0x7f, 0x05, 0x6a, 0xea, 0x52, 0x88, 0x8d, 0x95, 0xa1, 0x89, 0xc2,
-	0x31, 0x6c, 0xa5, 0x2e, 0xcf, 0xde, 0x6f, 0xa9, 0xe6, 0x60, 0xf1, 0x70, 0x0d, 0x70, 0x56, 0xe6,
-	0x64, 0x69, 0x56, 0xc9, 0x1c, 0x83, 0x57, 0xca, 0xfc, 0xf3, 0xbe, 0xf0, 0xef, 0x39, 0xa8, 0x64,
-	0x2f, 0x4b, 0x3d, 0x83, 0x32, 0xf3, 0x86, 0xf8, 0x74, 0xdd, 0x1b, 0x89, 0x92, 0x77, 0xb0, 0x9d,
-	0x3e, 0xb4, 0x8f, 0x33, 0x28, 0x53, 0xd1, 0xe2, 0x93, 0x75, 0xd0, 0x71, 0xf2, 0xe6, 0xc9, 0xd5,
-	0x54, 0xe2, 0xae, 0xa7, 0x12, 0xf7, 0x75, 0x2a, 0x71, 0x1f, 0x66, 0x52, 0xee, 0x7a, 0x26, 0xe5,
-	0x3e, 0xcf, 0xa4, 0xdc, 0x9b, 0xa3, 0x1f, 0x56, 0x3a, 0x62, 0xde, 0xb7, 0x75, 0x83, 0xc5, 0x06,
-	0x1a, 0x35, 0xea, 0x68, 0xbc, 0xf0, 0x6e, 0xf9, 0x6b, 0x6e, 0x94, 0x82, 0xc7, 0xe2, 0xf0, 0x7b,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xe7, 0x1c, 0x3a, 0xda, 0x06, 0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x93, 0x6c, 0xc2, 0x3e, 0x16, 0xa4, 0xf5, 0xc2, 0x12, 0xcc, 0xae, 0x93, 0xf5, 0xa2,
+	0x55, 0x76, 0x59, 0x6c, 0x92, 0xac, 0xd0, 0x8a, 0x1b, 0x81, 0x43, 0x23, 0x11, 0x09, 0xb9, 0xa2,
+	0x95, 0x2a, 0x55, 0x91, 0x9d, 0x19, 0x8c, 0x85, 0xe3, 0x89, 0x3c, 0x76, 0x08, 0xea, 0xa1, 0xc7,
+	0x9e, 0x2a, 0xf5, 0xda, 0xaf, 0xd0, 0x2f, 0x52, 0x8e, 0x1c, 0x7b, 0xa2, 0x15, 0x7c, 0x03, 0xa4,
+	0xde, 0x2b, 0xff, 0x4d, 0x43, 0xec, 0x80, 0xd5, 0xf4, 0x14, 0xbf, 0x79, 0xbf, 0x79, 0xbf, 0xdf,
+	0xbc, 0xf7, 0xe6, 0x4d, 0x60, 0x8d, 0xd0, 0x1e, 0xa1, 0x3a, 0x95, 0xa8, 0xd3, 0xc7, 0xd6, 0xb1,
+	0xe1, 0xe8, 0x48, 0xb2, 0x87, 0x62, 0xdf, 0x22, 0x36, 0x61, 0xd9, 0xc0, 0x29, 0x8e, 0x9c, 0xdc,
+	0x92, 0x46, 0x34, 0xe2, 0xb9, 0x25, 0xf7, 0xcb, 0x47, 0x72, 0xbc, 0x46, 0x88, 0x66, 0x60, 0xc9,
+	0xb3, 0x54, 0xe7, 0x58, 0x42, 0x8e, 0xa5, 0xd8, 0x3a, 0x31, 0x43, 0x7f, 0xd7, 0x0b, 0x25, 0xa9,
+	0x0a, 0xc5, 0xd2, 0xa0, 0xa6, 0x62, 0x5b, 0xa9, 0x49, 0x5d, 0xa2, 0x87, 0xfe, 0x3f, 0x63, 0x64,
+	0x8c, 0x3e, 0x7d, 0x90, 0x30, 0x80, 0xe5, 0x36, 0xd5, 0x1e, 0x47, 0xcb, 0xfb, 0xd8, 0xc0, 0x9a,
+	0x62, 0x63, 0xf6, 0x6f, 0x28, 0x50, 0x6c, 0x22, 0x6c, 0x95, 0x98, 0x0a, 0x53, 0xfd, 0xb1, 0xf9,
+	0xf3, 0xed, 0x55, 0x79, 0xe1, 0x5c, 0xe9, 0x19, 0x3b, 0x82, 0xbf, 0x2e, 0xc8, 0x01, 0x80, 0x5d,
+	0x81, 0xa2, 0x41, 0xba, 0xa7, 0x1d, 0x1d, 0x95, 0xb2, 0x15, 0xa6, 0x9a, 0x97, 0x0b, 0xae, 0xd9,
+	0x42, 0xec, 0x2a, 0xcc, 0x0d, 0x14, 0xa3, 0xa3, 0x20, 0x64, 0x95, 0x72, 0x6e, 0x14, 0xb9, 0x38,
+	0x50, 0x8c, 0x5d, 0x84, 0x2c, 0xa1, 0x0c, 0xbf, 0xc7, 0xf2, 0xca, 0x98, 0xf6, 0x89, 0x49, 0xb1,
+	0xf0, 0x1c, 0x56, 0xc6, 0x00, 0x47, 0x26, 0x9a, 0xa1, 0x34, 0xe1, 0x0f, 0x28, 0x27, 0x84, 0x9f,
+	0xa2, 0x40, 0x25, 0x26, 0x3a, 0x20, 0xdd, 0xd3, 0xef, 0xa4, 0x20, 0x0c, 0x1f, 0x29, 0x78, 0x79,
+	0x47, 0x81, 0x8c, 0x67, 0x99, 0x03, 0xb6, 0x02, 0x3f, 0x99, 0xf8, 0xac, 0x73, 0xa7, 0x44, 0x60,
+	0xe2, 0xb3, 0x27, 0x41, 0x95, 0xee, 0x6a, 0x1c, 0x09, 0x88, 0x34, 0xbe, 0x65, 0x80, 0x1f, 0xc3,
+	0x1c, 0x2a, 0x96, 0xad, 0x2b, 0xc6, 0x6c, 0xeb, 0xc5, 0x36, 0x20, 0xef, 0xb6, 0xb6, 0xa7, 0x71,
+	0xbe, 0xbe, 0x2a, 0xfa, 0xbd, 0x2f, 0xba, 0xbd, 0x2f, 0x06, 0xbd, 0x2f, 0xee, 0x11, 0xdd, 0x6c,
+	0xe6, 0x2f, 0xae, 0xca, 0x19, 0xd9, 0x03, 0x0b, 0x07, 0xf0, 0xd7, 0x74, 0x69, 0xe1, 0x29, 0x58,
+	0x01, 0x16, 0x68, 0xdf, 0xd0, 0xed, 0x4e, 0xc8, 0xce, 0x78, 0xec, 0xf3, 0xde, 0xe2, 0x81, 0x5f,
+	0xb0, 0xf7, 0x0c, 0xfc, 0xd6, 0xa6, 0x9a, 0x6b, 0xed, 0x9a, 0xe8, 0xdb, 0xae, 0x8c, 0x02, 0x3f,
+	0xb8, 0x0a, 0x69, 0x29, 0x5b, 0xc9, 0x4d, 0x3f, 0xcf, 0x96, 0x7b, 0x9e, 0x77, 0x1f, 0xcb, 0x55,
+	0x4d, 0xb7, 0x4f, 0x1c, 0x55, 0xec, 0x92, 0x9e, 0x14, 0x5c, 0x7c, 0xff, 0x67, 0x93, 0xa2, 0x53,
+	0xc9, 0x3e, 0xef, 0x63, 0xea, 0x6d, 0xa0, 0xb2, 0x1f, 0x79, 0xda, 0xe5, 0xdb, 0x86, 0xf5, 0x69,
+	0x07, 0x89, 0xb2, 0xb2, 0x08, 0xd9, 0xd6, 0x7e, 0x90, 0x8a, 0x6c, 0x6b, 0x5f, 0xb0, 0xa0, 0xd4,
+	0xa6, 0xda, 0x91, 0x79, 0x48, 0x88, 0xf1, 0xf4, 0x44, 0xb7, 0xb1, 0xa1, 0x53, 0x1b, 0x23, 0xd7,
+	0x4c, 0x73, 0xf8, 0x0d, 0x28, 0xf6, 0x09, 0x31, 0xa2, 0x22, 0x37, 0xd9, 0xdb, 0xab, 0xf2, 0xa2,
+	0x8f, 0x0d, 0x1c, 0x82, 0x5c, 0x70, 0xbf, 0x5a, 0x48, 0x78, 0x04, 0x95, 0x24, 0xce, 0x48, 0xe7,
+	0x3a, 0x2c, 0xe0, 0xa1, 0x6e, 0x63, 0xe4, 0x57, 0x8a, 0x96, 0x98, 0x4a, 0xae, 0x9a, 0x97, 0xc7,
+	0x17, 0x85, 0x01, 0xfc, 0xea, 0x76, 0x03, 0xf6, 0xea, 0xb9, 0xeb, 0xd8, 0x64, 0x8f, 0xf4, 0xfa,
+	0xc4, 0x31, 0xd1, 0x4c, 0x1a, 0xb4, 0x04, 0x45, 0x6c, 0x2a, 0xaa, 0x81, 0x91, 0x97, 0xed, 0x39,
+	0x39, 0x34, 0x85, 0x0a, 0xf0, 0xf1, 0xbc, 0xa1, 0xfe, 0xfa, 0xe7, 0x22, 0xe4, 0xda, 0x54, 0x63,
+	0x2d, 0x60, 0xe3, 0xda, 0x4a, 0x9c, 0x7c, 0x32, 0xc4, 0xd8, 0xe1, 0xc9, 0xd5, 0x1e, 0x0c, 0x8d,
+	0x72, 0x37, 0x84, 0xa5, 0xd8, 0x21, 0xbb, 0x71, 0x6f, 0xa8, 0x11, 0x98, 0x6b, 0xa4, 0x00, 0xc7,
+	0x33, 0xcb, 0x38, 0x05, 0xb3, 0x8c, 0x53, 0x30, 0x4f, 0xce, 0x2c, 0xf6, 0x35, 0x03, 0x6b, 0xd3,
+	0x06, 0x56, 0xfd, 0xde, 0xa0, 0x13, 0x7b, 0xb8, 0x9d, 0xf4, 0x7b, 0x92, 0x6a, 0x10, 0x3d, 0x33,
+	0x0f, 0xa9, 0x41, 0x08, 0xe6, 0x1a, 0x29, 0xc0, 0x11, 0xf3, 0x2b, 0x06, 0x56, 0x93, 0x07, 0xda,
+	0x56, 0x42, 0xc8, 0xc4, 0x1d, 0xdc, 0xff, 0x69, 0x77, 0x44, 0x4a, 0x5e, 0xc0, 0x72, 0xfc, 0x60,
+	0xf9, 0x37, 0x21, 0x64, 0x2c, 0x9a, 0xfb, 0x2f, 0x0d, 0x3a, 0x22, 0x77, 0xe0, 0x97, 0xb8, 0xb9,
+	0xf0, 0x4f, 0x52, 0x4a, 0x27, 0xb1, 0x5c, 0xfd, 0xe1, 0xd8, 0x90, 0xb6, 0x79, 0x78, 0x71, 0xcd,
+	0x33, 0x97, 0xd7, 0x3c, 0xf3, 0xe9, 0x9a, 0x67, 0xde, 0xdc, 0xf0, 0x99, 0xcb, 0x1b, 0x3e, 0xf3,
+	0xe1, 0x86, 0xcf, 0x3c, 0xdb, 0xfe, 0x6a, 0xda, 0x07, 0x71, 0x37, 0x0d, 0x45, 0xa5, 0xa1, 0x21,
+	0x0d, 0x6a, 0x5b, 0xd2, 0x70, 0xec, 0x0f, 0xa6, 0xfb, 0x02, 0xa8, 0x05, 0xef, 0x5f, 0x5d, 0xe3,
+	0xcb, 0x00, 0x53, 0x76, 0x15, 0x80, 0x83, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,12 +913,18 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
+	// Execute superfluid undelegation for part of a lockup
+	SuperfluidPartialUndelegate(ctx context.Context, in *MsgSuperfluidPartialUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidPartialUndelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error)
+	// Enable or disable the auto-compounding of the rewards of a lock
+	SetLockAutoCompound(ctx context.Context, in *MsgSetLockAutoCompound, opts ...grpc.CallOption) (*MsgSetLockAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -621,6 +953,24 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidPartialUndelegate(ctx context.Context, in *MsgSuperfluidPartialUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidPartialUndelegateResponse, error) {
+	out := new(MsgSuperfluidPartialUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidPartialUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -648,18 +998,33 @@ func (c *msgClient) UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhit
 	return out, nil
 }
 
+func (c *msgClient) SetLockAutoCompound(ctx context.Context, in *MsgSetLockAutoCompound, opts ...grpc.CallOption) (*MsgSetLockAutoCompoundResponse, error) {
+	out := new(MsgSetLockAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SetLockAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
+	// Execute superfluid undelegation for part of a lockup
+	SuperfluidPartialUndelegate(context.Context, *MsgSuperfluidPartialUndelegate) (*MsgSuperfluidPartialUndelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(context.Context, *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error)
+	// Enable or disable the auto-compounding of the rewards of a lock
+	SetLockAutoCompound(context.Context, *MsgSetLockAutoCompound) (*MsgSetLockAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -672,6 +1037,12 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidPartialUndelegate(ctx context.Context, req *MsgSuperfluidPartialUndelegate) (*MsgSuperfluidPartialUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidPartialUndelegate not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
@@ -681,6 +1052,9 @@ func (*UnimplementedMsgServer) LockAndSuperfluidDelegate(ctx context.Context, re
 func (*UnimplementedMsgServer) UnPoolWhitelistedPool(ctx context.Context, req *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnPoolWhitelistedPool not implemented")
 }
+func (*UnimplementedMsgServer) SetLockAutoCompound(ctx context.Context, req *MsgSetLockAutoCompound) (*MsgSetLockAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -722,6 +1096,42 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidPartialUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidPartialUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidPartialUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidPartialUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidPartialUndelegate(ctx, req.(*MsgSuperfluidPartialUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -776,6 +1186,24 @@ func _Msg_UnPoolWhitelistedPool_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetLockAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLockAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLockAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SetLockAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLockAutoCompound(ctx, req.(*MsgSetLockAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -788,6 +1216,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
+		{
+			MethodName: "SuperfluidPartialUndelegate",
+			Handler:    _Msg_SuperfluidPartialUndelegate_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -800,6 +1236,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnPoolWhitelistedPool",
 			Handler:    _Msg_UnPoolWhitelistedPool_Handler,
 		},
+		{
+			MethodName: "SetLockAutoCompound",
+			Handler:    _Msg_SetLockAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -986,7 +1426,145 @@ func (m *MsgSuperfluidUnbondLockResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidPartialUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidPartialUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidPartialUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SplitLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplitLockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1121,26 +1699,94 @@ func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if len(m.ExitedLockIds) > 0 {
-		dAtA2 := make([]byte, len(m.ExitedLockIds)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ExitedLockIds)*10)
+		var j2 int
 		for _, num := range m.ExitedLockIds {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetLockAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1231,6 +1877,65 @@ func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.NewValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidPartialUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitLockId != 0 {
+		n += 1 + sovTx(uint64(m.SplitLockId))
+	}
+	return n
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1298,6 +2003,34 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetLockAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetLockAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1437,7 +2170,309 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1460,10 +2495,10 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1487,7 +2522,7 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1510,10 +2545,10 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1567,6 +2602,38 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1588,7 +2655,7 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1611,10 +2678,10 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1638,7 +2705,7 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidPartialUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,10 +2728,10 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1718,6 +2785,39 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1739,7 +2839,7 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidPartialUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1762,12 +2862,31 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitLockId", wireType)
+			}
+			m.SplitLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2233,6 +3352,177 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetLockAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLockAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLockAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLockAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLockAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLockAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0